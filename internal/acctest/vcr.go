@@ -6,23 +6,15 @@ package acctest
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
-	"encoding/json"
-	"encoding/xml"
 	"fmt"
-	"io"
 	"math/rand"
-	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strconv"
 	"strings"
 	"testing"
 
-	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -30,7 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
-	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"gopkg.in/dnaeon/go-vcr.v3/recorder"
 )
 
@@ -97,14 +89,13 @@ func isVCREnabled() bool {
 }
 
 func vcrMode() (recorder.Mode, error) {
-	switch v := os.Getenv(envVarVCRMode); v {
-	case "RECORDING":
-		return recorder.ModeRecordOnce, nil
-	case "REPLAYING":
-		return recorder.ModeReplayOnly, nil
-	default:
-		return recorder.ModePassthrough, fmt.Errorf("unsupported value for %s: %s", envVarVCRMode, v)
+	v := os.Getenv(envVarVCRMode)
+	mode, err := vcr.ParseMode(v)
+	if err != nil {
+		return mode, fmt.Errorf("unsupported value for %s: %s", envVarVCRMode, v)
 	}
+
+	return mode, nil
 }
 
 // vcrEnabledProtoV5ProviderFactories returns ProtoV5ProviderFactories ready for use with VCR.
@@ -151,114 +142,19 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
 
-		// Cribbed from aws-sdk-go-base.
-		httpClient := cleanhttp.DefaultPooledClient()
-		transport := httpClient.Transport.(*http.Transport)
-		transport.MaxIdleConnsPerHost = 10
-		tlsConfig := transport.TLSClientConfig
-		if tlsConfig == nil {
-			tlsConfig = &tls.Config{}
-			transport.TLSClientConfig = tlsConfig
-		}
-		tlsConfig.MinVersion = tls.VersionTLS12
-
 		path := filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(testName))
 
 		// Create a VCR recorder around a default HTTP client.
-		r, err := recorder.NewWithOptions(&recorder.Options{
-			CassetteName:  path,
-			Mode:          vcrMode,
-			RealTransport: httpClient.Transport,
-		})
+		// Sensitive values are redacted from recorded interactions.
+		httpClient, _, err := vcr.NewHTTPClient(ctx, path, vcrMode)
 
 		if err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
 
-		// Remove sensitive HTTP headers.
-		r.AddHook(func(i *cassette.Interaction) error {
-			delete(i.Request.Headers, "Authorization")
-			delete(i.Request.Headers, "X-Amz-Security-Token")
-
-			return nil
-		}, recorder.AfterCaptureHook)
-
-		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
-				return false
-			}
-
-			if r.Body == nil {
-				return true
-			}
-
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
-					"error": err,
-				})
-				return false
-			}
-
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
-			}
-
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
-
-			return false
-		})
-
 		// Use the wrapped HTTP Client for AWS APIs.
 		// As the HTTP client is used in the provider's ConfigureContextFunc
 		// we must do this setup before calling the ConfigureContextFunc.
-		httpClient.Transport = r
 		if v, ok := provider.Meta().(*conns.AWSClient); ok {
 			meta = v
 		} else {
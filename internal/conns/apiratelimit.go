@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+)
+
+// APIRateLimit is the client-side rate limiting configuration for a service's AWS API requests.
+type APIRateLimit struct {
+	// Burst is the maximum number of requests that can be made at once. Defaults to RequestsPerSecond, rounded up.
+	Burst int
+	// MaxInFlight is the maximum number of concurrent requests. Zero means unlimited.
+	MaxInFlight int
+	// RequestsPerSecond is the sustained request rate. Zero means unlimited.
+	RequestsPerSecond float64
+}
+
+// apiRateLimiter limits the rate and concurrency of AWS API requests.
+// A single limiter is shared by all API clients for a service, regardless of Region.
+type apiRateLimiter struct {
+	bucket   *tokenBucket
+	inFlight chan struct{}
+}
+
+func newAPIRateLimiter(v APIRateLimit) *apiRateLimiter {
+	limiter := &apiRateLimiter{}
+
+	if v.RequestsPerSecond > 0 {
+		burst := v.Burst
+		if burst <= 0 {
+			burst = int(math.Ceil(v.RequestsPerSecond))
+		}
+		limiter.bucket = newTokenBucket(v.RequestsPerSecond, burst)
+	}
+
+	if v.MaxInFlight > 0 {
+		limiter.inFlight = make(chan struct{}, v.MaxInFlight)
+	}
+
+	return limiter
+}
+
+// acquire waits until a request may be made.
+// The returned function must be called once the request has completed.
+func (l *apiRateLimiter) acquire(ctx context.Context) (func(), error) {
+	if l.inFlight != nil {
+		select {
+		case l.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	release := func() {
+		if l.inFlight != nil {
+			<-l.inFlight
+		}
+	}
+
+	if l.bucket != nil {
+		if err := l.bucket.wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	return release, nil
+}
+
+// apiOption returns an AWS SDK for Go v2 API option that installs the limiter.
+// The limiter runs after the retry middleware so that every attempt is limited.
+func (l *apiRateLimiter) apiOption() func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Finalize.Add(&apiRateLimitMiddleware{limiter: l}, middleware.After)
+	}
+}
+
+type apiRateLimitMiddleware struct {
+	limiter *apiRateLimiter
+}
+
+func (*apiRateLimitMiddleware) ID() string {
+	return "TerraformAPIRateLimit"
+}
+
+func (m *apiRateLimitMiddleware) HandleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	release, err := m.limiter.acquire(ctx)
+	if err != nil {
+		return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+	}
+	defer release()
+
+	return next.HandleFinalize(ctx, in)
+}
+
+// tokenBucket is a token bucket rate limiter.
+// Tokens are reserved in arrival order, so a waiter never starves.
+type tokenBucket struct {
+	burst  float64
+	lock   sync.Mutex
+	now    func() time.Time
+	rate   float64 // Tokens per second.
+	tokens float64 // Negative when tokens have been reserved ahead of time.
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	b := &tokenBucket{
+		burst:  float64(burst),
+		now:    time.Now,
+		rate:   rate,
+		tokens: float64(burst),
+	}
+	b.last = b.now()
+
+	return b
+}
+
+// reserve takes a token and returns how long the caller must wait before using it.
+func (b *tokenBucket) reserve() time.Duration {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	now := b.now()
+	b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token that was not used.
+func (b *tokenBucket) cancel() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.tokens = min(b.burst, b.tokens+1)
+}
+
+// wait blocks until a token is available or the Context is done.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	d := b.reserve()
+	if d == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTokenBucketReserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	b := newTokenBucket(2, 2)
+	b.now = func() time.Time { return now }
+	b.last = now
+
+	// Burst.
+	for i := range 2 {
+		if got := b.reserve(); got != 0 {
+			t.Errorf("reserve %d: got %s, want 0", i, got)
+		}
+	}
+
+	// Reservations ahead of time.
+	if got, want := b.reserve(), 500*time.Millisecond; got != want {
+		t.Errorf("reserve: got %s, want %s", got, want)
+	}
+	if got, want := b.reserve(), 1*time.Second; got != want {
+		t.Errorf("reserve: got %s, want %s", got, want)
+	}
+
+	// Refill.
+	now = now.Add(1500 * time.Millisecond)
+	if got := b.reserve(); got != 0 {
+		t.Errorf("reserve after refill: got %s, want 0", got)
+	}
+
+	// Refill is capped at burst.
+	now = now.Add(time.Hour)
+	for i := range 2 {
+		if got := b.reserve(); got != 0 {
+			t.Errorf("reserve %d after idle: got %s, want 0", i, got)
+		}
+	}
+	if got := b.reserve(); got == 0 {
+		t.Error("reserve after idle: got 0, want > 0")
+	}
+}
+
+func TestTokenBucketWaitCanceled(t *testing.T) {
+	t.Parallel()
+
+	b := newTokenBucket(0.001, 1)
+	if err := b.wait(context.Background()); err != nil {
+		t.Fatalf("wait: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := b.wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("wait: got %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestAPIRateLimiterMaxInFlight(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	limiter := newAPIRateLimiter(APIRateLimit{MaxInFlight: 2})
+
+	if limiter.bucket != nil {
+		t.Error("unexpected token bucket")
+	}
+
+	release1, err := limiter.acquire(ctx)
+	if err != nil {
+		t.Fatalf("acquire: %s", err)
+	}
+	release2, err := limiter.acquire(ctx)
+	if err != nil {
+		t.Fatalf("acquire: %s", err)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := limiter.acquire(timeoutCtx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("acquire: got %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	release1()
+
+	release3, err := limiter.acquire(ctx)
+	if err != nil {
+		t.Fatalf("acquire after release: %s", err)
+	}
+
+	release2()
+	release3()
+}
+
+func TestNewAPIRateLimiterDefaultBurst(t *testing.T) {
+	t.Parallel()
+
+	limiter := newAPIRateLimiter(APIRateLimit{RequestsPerSecond: 2.5})
+
+	if limiter.bucket == nil {
+		t.Fatal("no token bucket")
+	}
+	if got, want := limiter.bucket.burst, 3.0; got != want {
+		t.Errorf("burst: got %v, want %v", got, want)
+	}
+	if limiter.inFlight != nil {
+		t.Error("unexpected in-flight limit")
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
@@ -29,6 +30,7 @@ type AWSClient struct {
 	ServicePackages map[string]ServicePackage
 
 	accountID                 string
+	apiRateLimiters           map[string]*apiRateLimiter // From provider configuration.
 	awsConfig                 *aws.Config
 	clients                   map[string]any
 	conns                     map[string]any
@@ -39,12 +41,15 @@ type AWSClient struct {
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
+	preflightConfig           *PreflightConfig // From provider configuration.
 	region                    string
 	session                   *session_sdkv1.Session
-	s3ExpressClient           *s3.Client
+	s3ExpressClients          map[string]*s3.Client
 	s3UsePathStyle            bool   // From provider configuration.
 	s3USEast1RegionalEndpoint string // From provider configuration.
 	stsRegion                 string // From provider configuration.
+	tagDiagnosticsConfig      *tftags.DiagnosticsConfig
+	tagPolicyConfig           *tftags.PolicyConfig
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -63,8 +68,31 @@ func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
 
-func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
-	return c.awsConfig.Copy()
+// TagDiagnosticsConfig returns the resource tag conflict and drift diagnostics configuration.
+// A nil value indicates that the diagnostics are disabled.
+func (c *AWSClient) TagDiagnosticsConfig(context.Context) *tftags.DiagnosticsConfig {
+	return c.tagDiagnosticsConfig
+}
+
+// TagPolicyConfig returns the provider's resource tagging policy.
+// A nil value indicates that no policy is enforced.
+func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.PolicyConfig {
+	return c.tagPolicyConfig
+}
+
+// PreflightConfig returns the plan-time preflight checks configuration.
+// A nil value indicates that preflight checks are disabled.
+func (c *AWSClient) PreflightConfig(context.Context) *PreflightConfig {
+	return c.preflightConfig
+}
+
+// AwsConfig returns a copy of the AWS SDK for Go v2 configuration.
+// Any per-resource Region override in effect is applied to the returned configuration.
+func (c *AWSClient) AwsConfig(ctx context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
+	cfg := c.awsConfig.Copy()
+	cfg.Region = c.Region(ctx)
+
+	return cfg
 }
 
 // AwsSession and Endpoints can be removed once the simpledb service is removed.
@@ -87,10 +115,22 @@ func (c *AWSClient) Partition(context.Context) string {
 }
 
 // Region returns the ID of the configured AWS Region.
-func (c *AWSClient) Region(context.Context) string {
+// If a per-resource Region override is in effect, that Region is returned.
+func (c *AWSClient) Region(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok {
+		if v := inContext.OverrideRegion; v != "" {
+			return v
+		}
+	}
+
 	return c.region
 }
 
+// IsRegionInPartition returns whether the specified Region is in the configured AWS partition.
+func (c *AWSClient) IsRegionInPartition(ctx context.Context, region string) bool {
+	return names.PartitionForRegion(region).ID() == c.Partition(ctx)
+}
+
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
 // e.g. PREFIX.amazonaws.com
 // The prefix should not contain a trailing period.
@@ -145,18 +185,25 @@ func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3.Client {
 	c.lock.Lock() // OK since a non-default client is created.
 	defer c.lock.Unlock()
 
-	if c.s3ExpressClient == nil {
+	if c.s3ExpressClients == nil {
+		c.s3ExpressClients = make(map[string]*s3.Client)
+	}
+
+	region := c.Region(ctx)
+	s3ExpressClient, ok := c.s3ExpressClients[region]
+	if !ok {
 		if s3Client.Options().Region == endpoints.AwsGlobalRegionID {
 			// No global endpoint for S3 Express.
-			c.s3ExpressClient = errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
+			s3ExpressClient = errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
 				"s3_us_east_1_regional_endpoint": "regional",
 			}))
 		} else {
-			c.s3ExpressClient = s3Client
+			s3ExpressClient = s3Client
 		}
+		c.s3ExpressClients[region] = s3ExpressClient
 	}
 
-	return c.s3ExpressClient
+	return s3ExpressClient
 }
 
 // S3UsePathStyle returns the s3_force_path_style provider configuration value.
@@ -301,8 +348,21 @@ func convertIPToDashIP(ip string) string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig := c.awsConfig
+	if region := c.Region(ctx); region != c.awsConfig.Region {
+		// Per-resource Region override.
+		cfg := c.awsConfig.Copy()
+		cfg.Region = region
+		awsConfig = &cfg
+	}
+	if limiter, ok := c.apiRateLimiters[servicePackageName]; ok {
+		// Per-service rate limits.
+		cfg := awsConfig.Copy()
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), limiter.apiOption())
+		awsConfig = &cfg
+	}
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 	}
@@ -323,18 +383,19 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 }
 
 // client returns the AWS SDK for Go v2 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty) is cached per Region. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
 	isDefault := len(extra) == 0
+	key := clientCacheKey(servicePackageName, c.Region(ctx))
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.clients[servicePackageName]; ok {
+		if raw, ok := c.clients[key]; ok {
 			if client, ok := raw.(T); ok {
 				return client, nil
 			} else {
@@ -369,8 +430,13 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		c.clients[servicePackageName] = client
+		c.clients[key] = client
 	}
 
 	return client, nil
 }
+
+// clientCacheKey returns the key used to cache the default API client for the specified service and Region.
+func clientCacheKey(servicePackageName, region string) string {
+	return servicePackageName + "@" + region
+}
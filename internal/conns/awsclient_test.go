@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 var (
@@ -99,6 +100,60 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 	}
 }
 
+func TestAWSClientRegionOverride(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		partition: standardPartition,
+		region:    "us-west-2", //lintignore:AWSAT003
+	}
+	testCases := []struct {
+		Name           string
+		Context        context.Context
+		ExpectedRegion string
+		ExpectedHost   string
+	}{
+		{
+			Name:           "no resource context",
+			Context:        context.TODO(),
+			ExpectedRegion: "us-west-2",                    //lintignore:AWSAT003
+			ExpectedHost:   "test.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		{
+			Name:           "resource context without override",
+			Context:        NewResourceContext(context.TODO(), names.SQS, "Queue", ""),
+			ExpectedRegion: "us-west-2",                    //lintignore:AWSAT003
+			ExpectedHost:   "test.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		{
+			Name:           "resource context with override",
+			Context:        NewResourceContext(context.TODO(), names.SQS, "Queue", "eu-west-1"), //lintignore:AWSAT003
+			ExpectedRegion: "eu-west-1",                                                         //lintignore:AWSAT003
+			ExpectedHost:   "test.eu-west-1.amazonaws.com",                                      //lintignore:AWSAT003
+		},
+		{
+			Name:           "data source context with override",
+			Context:        NewDataSourceContext(context.TODO(), names.SQS, "Queue", "ap-southeast-2"), //lintignore:AWSAT003
+			ExpectedRegion: "ap-southeast-2",                                                           //lintignore:AWSAT003
+			ExpectedHost:   "test.ap-southeast-2.amazonaws.com",                                        //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, expected := client.Region(testCase.Context), testCase.ExpectedRegion; got != expected {
+				t.Errorf("Region: got %s, expected %s", got, expected)
+			}
+
+			if got, expected := client.RegionalHostname(testCase.Context, "test"), testCase.ExpectedHost; got != expected {
+				t.Errorf("RegionalHostname: got %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
 func TestAWSClientEC2PrivateDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
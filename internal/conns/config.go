@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/aws/smithy-go/middleware"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
 	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
@@ -29,6 +30,8 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	APIOptions                     []func(*middleware.Stack) error
+	APIRateLimits                  map[string]APIRateLimit
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
@@ -44,6 +47,7 @@ type Config struct {
 	Insecure                       bool
 	MaxRetries                     int
 	NoProxy                        string
+	Preflight                      *PreflightConfig
 	Profile                        string
 	Region                         string
 	RetryMode                      aws.RetryMode
@@ -57,6 +61,8 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagDiagnosticsConfig           *tftags.DiagnosticsConfig
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
@@ -208,13 +214,21 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	client.accountID = accountID
+	client.apiRateLimiters = make(map[string]*apiRateLimiter, len(c.APIRateLimits))
+	for servicePackageName, v := range c.APIRateLimits {
+		client.apiRateLimiters[servicePackageName] = newAPIRateLimiter(v)
+	}
 	client.defaultTagsConfig = c.DefaultTagsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
+	client.preflightConfig = c.Preflight
 	client.region = c.Region
+	client.tagDiagnosticsConfig = c.TagDiagnosticsConfig
+	client.tagPolicyConfig = c.TagPolicyConfig
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
 
 	// Used for lazy-loading AWS API clients.
+	cfg.APIOptions = append(cfg.APIOptions, c.APIOptions...)
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
 	client.conns = make(map[string]any, 0)
@@ -5,8 +5,14 @@ package conns
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // ServicePackage is the minimal interface exported from each AWS service package.
@@ -26,6 +32,13 @@ type ServicePackageWithEphemeralResources interface {
 	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
 }
 
+// ServicePackageWithListResources is an interface that extends ServicePackage with list resources.
+// List resources enumerate existing resources, e.g. for generating `import` blocks.
+type ServicePackageWithListResources interface {
+	ServicePackage
+	ListResources(context.Context) []*types.ServicePackageListResource
+}
+
 type (
 	contextKeyType int
 )
@@ -38,13 +51,15 @@ var (
 type InContext struct {
 	IsDataSource        bool   // Data source?
 	IsEphemeralResource bool   // Ephemeral resource?
+	OverrideRegion      string // Per-resource Region override, if any
 	ResourceName        string // Friendly resource name, e.g. "Subnet"
 	ServicePackageName  string // Canonical name defined as a constant in names package
 }
 
-func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName, overrideRegion string) context.Context {
 	v := InContext{
 		IsDataSource:       true,
+		OverrideRegion:     overrideRegion,
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
 	}
@@ -62,8 +77,9 @@ func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resour
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, overrideRegion string) context.Context {
 	v := InContext{
+		OverrideRegion:     overrideRegion,
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
 	}
@@ -75,3 +91,86 @@ func FromContext(ctx context.Context) (*InContext, bool) {
 	v, ok := ctx.Value(contextKey).(*InContext)
 	return v, ok
 }
+
+// IsRegionOverrideEnabled returns whether the per-resource `region` argument is supported
+// by a resource or data source implemented by the specified service package.
+// Resources in global services do not support Region override unless annotated otherwise.
+func IsRegionOverrideEnabled(servicePackageName string, region *types.ServicePackageResourceRegion) bool {
+	if region != nil {
+		return region.IsOverrideEnabled
+	}
+
+	return !names.IsGlobal(servicePackageName)
+}
+
+// importIDRegionSeparator separates a resource's import ID from an optional Region override,
+// e.g. `terraform import aws_vpc.example vpc-0123456789abcdef0@eu-west-1`.
+const importIDRegionSeparator = "@"
+
+// ParseImportIDRegion splits an import ID of the form `<id>@<region>` into its components.
+// Only a trailing valid Region name is treated as a Region override so that IDs containing `@`,
+// e.g. email addresses, are unaffected.
+func ParseImportIDRegion(id string) (string, string, bool) {
+	i := strings.LastIndex(id, importIDRegionSeparator)
+	if i <= 0 {
+		return id, "", false
+	}
+
+	region := id[i+len(importIDRegionSeparator):]
+	if !types.IsAWSRegion(region) {
+		return id, "", false
+	}
+
+	return id[:i], region, true
+}
+
+// ValidateARNIdentity returns an error if the specified ARN, used as a resource's identity,
+// does not belong to the specified AWS account and, for regional resources, Region.
+func ValidateARNIdentity(v, accountID, region string, isGlobalResource bool) error {
+	arn, err := arn.Parse(v)
+	if err != nil {
+		return fmt.Errorf("parsing ARN (%s): %w", v, err)
+	}
+
+	if accountID != "" && arn.AccountID != "" && arn.AccountID != accountID {
+		return fmt.Errorf("ARN (%s) account ID (%s) does not match the provider's account ID (%s)", v, arn.AccountID, accountID)
+	}
+
+	if !isGlobalResource && region != "" && arn.Region != "" && arn.Region != region {
+		return fmt.Errorf("ARN (%s) Region (%s) does not match the resource's Region (%s)", v, arn.Region, region)
+	}
+
+	return nil
+}
+
+// ImportIDFromIdentity returns the import ID and any Region override of a resource imported by identity.
+// The import ID is the value of the resource's single resource-specific identity attribute.
+func ImportIDFromIdentity(identity *types.ServicePackageResourceIdentity, values map[string]string, accountID string) (string, string, error) {
+	if len(identity.IdentityAttributes) != 1 {
+		return "", "", errors.New("import by identity is only supported for resources identified by a single attribute")
+	}
+
+	if v := values[names.AttrAccountID]; !identity.IsARN && v != "" && accountID != "" && v != accountID {
+		return "", "", fmt.Errorf("identity account ID (%s) does not match the provider's account ID (%s)", v, accountID)
+	}
+
+	name := identity.IdentityAttributes[0].Name
+	id := values[name]
+	if id == "" {
+		return "", "", fmt.Errorf("identity attribute %q is required", name)
+	}
+
+	if identity.IsARN {
+		if err := ValidateARNIdentity(id, accountID, "", identity.IsGlobalResource); err != nil {
+			return "", "", err
+		}
+
+		return id, "", nil
+	}
+
+	if identity.IsGlobalResource {
+		return id, "", nil
+	}
+
+	return id, values[names.AttrRegion], nil
+}
@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestParseImportIDRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id             string
+		expectedID     string
+		expectedRegion string
+		expectedOK     bool
+	}{
+		"empty": {
+			id:         "",
+			expectedID: "",
+		},
+		"no Region": {
+			id:         "vpc-0123456789abcdef0",
+			expectedID: "vpc-0123456789abcdef0",
+		},
+		"Region": {
+			id:             "vpc-0123456789abcdef0@eu-west-1", //lintignore:AWSAT003
+			expectedID:     "vpc-0123456789abcdef0",
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+			expectedOK:     true,
+		},
+		"composite ID with Region": {
+			id:             "arn:aws:iam::123456789012:role/example,rule-1@us-west-2", //lintignore:AWSAT003,AWSAT005
+			expectedID:     "arn:aws:iam::123456789012:role/example,rule-1",           //lintignore:AWSAT005
+			expectedRegion: "us-west-2",                                               //lintignore:AWSAT003
+			expectedOK:     true,
+		},
+		"email address": {
+			id:         "someone@example.com",
+			expectedID: "someone@example.com",
+		},
+		"email address with Region": {
+			id:             "someone@example.com@ap-southeast-2", //lintignore:AWSAT003
+			expectedID:     "someone@example.com",
+			expectedRegion: "ap-southeast-2", //lintignore:AWSAT003
+			expectedOK:     true,
+		},
+		"Region only": {
+			id:         "@eu-west-1", //lintignore:AWSAT003
+			expectedID: "@eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			id, region, ok := ParseImportIDRegion(testCase.id)
+
+			if got, want := id, testCase.expectedID; got != want {
+				t.Errorf("ID = %q, want %q", got, want)
+			}
+			if got, want := region, testCase.expectedRegion; got != want {
+				t.Errorf("Region = %q, want %q", got, want)
+			}
+			if got, want := ok, testCase.expectedOK; got != want {
+				t.Errorf("OK = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestValidateARNIdentity(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn              string
+		accountID        string
+		region           string
+		isGlobalResource bool
+		expectError      bool
+	}{
+		"invalid ARN": {
+			arn:         "topic-1",
+			accountID:   "123456789012",
+			region:      "us-west-2", //lintignore:AWSAT003
+			expectError: true,
+		},
+		"regional": {
+			arn:       "arn:aws:sns:us-west-2:123456789012:topic-1", //lintignore:AWSAT003,AWSAT005
+			accountID: "123456789012",
+			region:    "us-west-2", //lintignore:AWSAT003
+		},
+		"regional account ID mismatch": {
+			arn:         "arn:aws:sns:us-west-2:111122223333:topic-1", //lintignore:AWSAT003,AWSAT005
+			accountID:   "123456789012",
+			region:      "us-west-2", //lintignore:AWSAT003
+			expectError: true,
+		},
+		"regional Region mismatch": {
+			arn:         "arn:aws:sns:eu-west-1:123456789012:topic-1", //lintignore:AWSAT003,AWSAT005
+			accountID:   "123456789012",
+			region:      "us-west-2", //lintignore:AWSAT003
+			expectError: true,
+		},
+		"global": {
+			arn:              "arn:aws:iam::123456789012:role/role-1", //lintignore:AWSAT005
+			accountID:        "123456789012",
+			region:           "us-west-2", //lintignore:AWSAT003
+			isGlobalResource: true,
+		},
+		"global account ID mismatch": {
+			arn:              "arn:aws:iam::111122223333:role/role-1", //lintignore:AWSAT005
+			accountID:        "123456789012",
+			region:           "us-west-2", //lintignore:AWSAT003
+			isGlobalResource: true,
+			expectError:      true,
+		},
+		"no account ID": {
+			arn:    "arn:aws:s3:::bucket-1", //lintignore:AWSAT005
+			region: "us-west-2",             //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateARNIdentity(testCase.arn, testCase.accountID, testCase.region, testCase.isGlobalResource)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expectError %t", err, want)
+			}
+		})
+	}
+}
+
+func TestImportIDFromIdentity(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		identity       *types.ServicePackageResourceIdentity
+		values         map[string]string
+		expectedID     string
+		expectedRegion string
+		expectError    bool
+	}{
+		"Regional parameterized": {
+			identity: types.RegionalSingleParameterIdentity(names.AttrBucket),
+			values: map[string]string{
+				names.AttrBucket: "bucket-1",
+			},
+			expectedID: "bucket-1",
+		},
+		"Regional parameterized with account ID and Region": {
+			identity: types.RegionalSingleParameterIdentity(names.AttrBucket),
+			values: map[string]string{
+				names.AttrAccountID: "123456789012",
+				names.AttrBucket:    "bucket-1",
+				names.AttrRegion:    "eu-west-1", //lintignore:AWSAT003
+			},
+			expectedID:     "bucket-1",
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		"Regional parameterized account ID mismatch": {
+			identity: types.RegionalSingleParameterIdentity(names.AttrBucket),
+			values: map[string]string{
+				names.AttrAccountID: "111122223333",
+				names.AttrBucket:    "bucket-1",
+			},
+			expectError: true,
+		},
+		"Regional parameterized missing attribute": {
+			identity: types.RegionalSingleParameterIdentity(names.AttrBucket),
+			values: map[string]string{
+				names.AttrRegion: "eu-west-1", //lintignore:AWSAT003
+			},
+			expectError: true,
+		},
+		"global parameterized": {
+			identity: types.GlobalSingleParameterIdentity(names.AttrName),
+			values: map[string]string{
+				names.AttrAccountID: "123456789012",
+				names.AttrName:      "role-1",
+			},
+			expectedID: "role-1",
+		},
+		"multiple attributes": {
+			identity: types.RegionalParameterizedIdentity(
+				types.IdentityAttribute{Name: names.AttrName, Required: true},
+				types.IdentityAttribute{Name: names.AttrType, Required: true},
+			),
+			values: map[string]string{
+				names.AttrName: "name-1",
+				names.AttrType: "type-1",
+			},
+			expectError: true,
+		},
+		"Regional ARN": {
+			identity: types.RegionalARNIdentity(names.AttrARN),
+			values: map[string]string{
+				names.AttrARN: "arn:aws:sns:eu-west-1:123456789012:topic-1", //lintignore:AWSAT003,AWSAT005
+			},
+			expectedID: "arn:aws:sns:eu-west-1:123456789012:topic-1", //lintignore:AWSAT003,AWSAT005
+		},
+		"Regional ARN account ID mismatch": {
+			identity: types.RegionalARNIdentity(names.AttrARN),
+			values: map[string]string{
+				names.AttrARN: "arn:aws:sns:eu-west-1:111122223333:topic-1", //lintignore:AWSAT003,AWSAT005
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			id, region, err := ImportIDFromIdentity(testCase.identity, testCase.values, "123456789012")
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expectError %t", err, want)
+			}
+			if got, want := id, testCase.expectedID; got != want {
+				t.Errorf("ID = %q, want %q", got, want)
+			}
+			if got, want := region, testCase.expectedRegion; got != want {
+				t.Errorf("Region = %q, want %q", got, want)
+			}
+		})
+	}
+}
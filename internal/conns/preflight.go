@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"slices"
+)
+
+// PreflightConfig is the plan-time preflight checks configuration.
+type PreflightConfig struct {
+	// SkipChecks are the names of preflight checks that are not run.
+	SkipChecks []string
+}
+
+// IsCheckEnabled returns whether the named preflight check is run.
+func (c *PreflightConfig) IsCheckEnabled(name string) bool {
+	if c == nil {
+		return false
+	}
+
+	return !slices.Contains(c.SkipChecks, name)
+}
@@ -16,3 +16,13 @@ func SetDefaultTagsConfig(client *AWSClient, d *tftags.DefaultConfig) {
 func SetIgnoreTagsConfig(client *AWSClient, i *tftags.IgnoreConfig) {
 	client.ignoreTagsConfig = i
 }
+
+// SetTagDiagnosticsConfig is only intended for use in tests
+func SetTagDiagnosticsConfig(client *AWSClient, c *tftags.DiagnosticsConfig) {
+	client.tagDiagnosticsConfig = c
+}
+
+// SetTagPolicyConfig is only intended for use in tests
+func SetTagPolicyConfig(client *AWSClient, c *tftags.PolicyConfig) {
+	client.tagPolicyConfig = c
+}
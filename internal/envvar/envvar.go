@@ -17,6 +17,9 @@ const (
 	// See also AWS_SECRET_ACCESS_KEY and AWS_PROFILE
 	AccessKeyId = "AWS_ACCESS_KEY_ID"
 
+	// Path of a custom certificate bundle used for AWS API calls
+	CABundle = "AWS_CA_BUNDLE"
+
 	// Container credentials endpoint
 	// See also AWS_ACCESS_KEY_ID and AWS_PROFILE
 	ContainerCredentialsFullURI = "AWS_CONTAINER_CREDENTIALS_FULL_URI"
@@ -84,6 +87,31 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to configure resource sweepers
+const (
+	// The maximum number of resources of a single type deleted concurrently.
+	// Defaults to unbounded.
+	SweepConcurrency = "TF_AWS_SWEEP_CONCURRENCY"
+
+	// If set to a true value, list the resources that would be deleted without deleting them
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Only sweep resources created at least this long ago, e.g. "24h"
+	SweepMinimumAge = "TF_AWS_SWEEP_MINIMUM_AGE"
+
+	// Only sweep resources whose name starts with this prefix
+	SweepNamePrefix = "TF_AWS_SWEEP_NAME_PREFIX"
+
+	// Path of a file to which a JSON report of swept resources is written
+	SweepReport = "TF_AWS_SWEEP_REPORT"
+
+	// Only sweep resources with this tag key
+	SweepTagKey = "TF_AWS_SWEEP_TAG_KEY"
+
+	// Only sweep resources whose tag (see TF_AWS_SWEEP_TAG_KEY) has this value
+	SweepTagValue = "TF_AWS_SWEEP_TAG_VALUE"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fakeaws provides an in-process fake of AWS APIs for testing resource CRUD logic without an AWS account.
+//
+// A Backend is an http.RoundTripper that intercepts requests made by AWS SDK for Go v2 API clients
+// and routes them, by service ID and operation name, to scriptable handlers.
+// Requests for operations with no handler fail without being retried.
+package fakeaws
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+)
+
+const (
+	// DefaultAccountID is the AWS account ID returned by the default STS GetCallerIdentity handler.
+	DefaultAccountID = "123456789012"
+)
+
+// Handler returns the response to an API request.
+type Handler func(*Request) *Response
+
+// Request is an API request received by a Backend.
+type Request struct {
+	// HTTPRequest is the underlying HTTP request. Its body has already been read.
+	HTTPRequest *http.Request
+	// ServiceID is the AWS SDK for Go v2 service ID, e.g. "SQS" or "S3".
+	ServiceID string
+	// Operation is the API operation name, e.g. "CreateQueue".
+	Operation string
+	// Body is the request payload.
+	Body []byte
+}
+
+// DecodeJSON decodes a JSON protocol request payload.
+func (r *Request) DecodeJSON(v any) error {
+	return json.Unmarshal(r.Body, v)
+}
+
+// DecodeXML decodes a REST-XML protocol request payload.
+func (r *Request) DecodeXML(v any) error {
+	return xml.Unmarshal(r.Body, v)
+}
+
+// Form returns the parameters of a Query protocol request payload.
+func (r *Request) Form() (url.Values, error) {
+	return url.ParseQuery(string(r.Body))
+}
+
+// Call records an API request received by a Backend.
+type Call struct {
+	ServiceID string
+	Operation string
+	Body      []byte
+}
+
+type operationKey struct {
+	serviceID string
+	operation string
+}
+
+// Backend is an in-process fake AWS backend.
+// It is safe for concurrent use. Handlers may be called concurrently and must synchronize access to any shared state.
+type Backend struct {
+	mutex    sync.Mutex
+	calls    []Call
+	handlers map[operationKey]Handler
+}
+
+// New returns a new Backend.
+// STS GetCallerIdentity is handled by default, returning DefaultAccountID, so that the provider can be configured.
+func New() *Backend {
+	b := &Backend{
+		handlers: make(map[operationKey]Handler),
+	}
+
+	b.Handle("STS", "GetCallerIdentity", func(*Request) *Response {
+		return QueryResponse("GetCallerIdentity", fmt.Sprintf(`<Account>%[1]s</Account><Arn>arn:aws:iam::%[1]s:user/fakeaws</Arn><UserId>AIDACKCEVSQ6C2EXAMPLE</UserId>`, DefaultAccountID))
+	})
+
+	return b
+}
+
+// Handle registers the handler for the specified service ID and operation, replacing any existing handler.
+func (b *Backend) Handle(serviceID, operation string, handler Handler) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.handlers[operationKey{serviceID: serviceID, operation: operation}] = handler
+}
+
+// Calls returns the API requests received, in order.
+func (b *Backend) Calls() []Call {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return append([]Call(nil), b.calls...)
+}
+
+// CallCount returns the number of API requests received for the specified service ID and operation.
+func (b *Backend) CallCount(serviceID, operation string) int {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	var n int
+	for _, call := range b.calls {
+		if call.ServiceID == serviceID && call.Operation == operation {
+			n++
+		}
+	}
+
+	return n
+}
+
+// HTTPClient returns an HTTP client that sends all requests to the backend,
+// for use with AWSClient.SetHTTPClient.
+func (b *Backend) HTTPClient() *http.Client {
+	return &http.Client{Transport: b}
+}
+
+// RoundTrip implements http.RoundTripper.
+// The service ID and operation name are read from the AWS SDK for Go v2 middleware metadata in the request's context.
+func (b *Backend) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	serviceID, operation := awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx)
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	b.mutex.Lock()
+	b.calls = append(b.calls, Call{ServiceID: serviceID, Operation: operation, Body: body})
+	handler, ok := b.handlers[operationKey{serviceID: serviceID, operation: operation}]
+	b.mutex.Unlock()
+
+	if !ok {
+		return nil, &unhandledOperationError{method: req.Method, url: req.URL.String(), serviceID: serviceID, operation: operation}
+	}
+
+	resp := handler(&Request{
+		HTTPRequest: req,
+		ServiceID:   serviceID,
+		Operation:   operation,
+		Body:        body,
+	})
+
+	if resp == nil {
+		return nil, &unhandledOperationError{method: req.Method, url: req.URL.String(), serviceID: serviceID, operation: operation}
+	}
+
+	return resp.httpResponse(req), nil
+}
+
+// unhandledOperationError is returned when a request has no handler.
+// It is treated as a canceled request by AWS SDK for Go v2 retryers so that it is not retried.
+type unhandledOperationError struct {
+	method    string
+	url       string
+	serviceID string
+	operation string
+}
+
+func (e *unhandledOperationError) Error() string {
+	return fmt.Sprintf("%s %s: fakeaws: no handler for %s %s", e.method, e.url, e.serviceID, e.operation)
+}
+
+func (e *unhandledOperationError) CanceledError() bool {
+	return true
+}
+
+// Sequence returns a handler that returns the specified responses in order, repeating the last one.
+// It is useful for exercising waiters.
+func Sequence(responses ...*Response) Handler {
+	var mutex sync.Mutex
+	var i int
+
+	return func(*Request) *Response {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if len(responses) == 0 {
+			return nil
+		}
+
+		resp := responses[min(i, len(responses)-1)]
+		i++
+
+		return resp
+	}
+}
+
+// Response is an API response returned by a Handler.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// WithHeader returns a copy of the response with the specified header set.
+func (r *Response) WithHeader(key, value string) *Response {
+	resp := *r
+	resp.Header = r.Header.Clone()
+	if resp.Header == nil {
+		resp.Header = make(http.Header)
+	}
+	resp.Header.Set(key, value)
+
+	return &resp
+}
+
+func (r *Response) httpResponse(req *http.Request) *http.Response {
+	header := r.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	if header.Get("X-Amzn-Requestid") == "" {
+		header.Set("X-Amzn-Requestid", "fakeaws")
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
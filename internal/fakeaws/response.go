@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+)
+
+// JSONResponse returns a successful AWS JSON protocol response, e.g. for Amazon SQS or Amazon DynamoDB.
+// v is marshaled using encoding/json and must use the API's member names.
+func JSONResponse(v any) *Response {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return JSONErrorResponse(http.StatusInternalServerError, "InternalFailure", fmt.Sprintf("fakeaws: marshaling response: %s", err))
+	}
+
+	return &Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/x-amz-json-1.0"}},
+		Body:       body,
+	}
+}
+
+// JSONErrorResponse returns an AWS JSON protocol error response.
+// AWS Query-compatible services such as Amazon SQS also expect the legacy error code in an `x-amzn-query-error` header,
+// which can be added with WithHeader.
+func JSONErrorResponse(statusCode int, code, message string) *Response {
+	body, _ := json.Marshal(map[string]string{
+		"__type":  code,
+		"message": message,
+	})
+
+	return &Response{
+		StatusCode: statusCode,
+		Header: http.Header{
+			"Content-Type":     []string{"application/x-amz-json-1.0"},
+			"X-Amzn-Errortype": []string{code},
+		},
+		Body: body,
+	}
+}
+
+// QueryResponse returns a successful AWS Query protocol response, e.g. for AWS STS or Amazon SNS.
+// result is the XML content of the operation's `<OperationResult>` element.
+func QueryResponse(operation, result string) *Response {
+	return &Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"text/xml"}},
+		Body:       fmt.Appendf(nil, `<%[1]sResponse><%[1]sResult>%[2]s</%[1]sResult><ResponseMetadata><RequestId>fakeaws</RequestId></ResponseMetadata></%[1]sResponse>`, operation, result),
+	}
+}
+
+// QueryErrorResponse returns an AWS Query protocol error response.
+func QueryErrorResponse(statusCode int, code, message string) *Response {
+	return &Response{
+		StatusCode: statusCode,
+		Header:     http.Header{"Content-Type": []string{"text/xml"}},
+		Body:       fmt.Appendf(nil, `<ErrorResponse><Error><Type>Sender</Type><Code>%s</Code><Message>%s</Message></Error><RequestId>fakeaws</RequestId></ErrorResponse>`, escapeXML(code), escapeXML(message)),
+	}
+}
+
+// RESTXMLResponse returns a successful REST-XML protocol response, e.g. for Amazon S3 or Amazon Route 53.
+// body is the XML response payload, if any. Values bound to response headers can be added with WithHeader.
+func RESTXMLResponse(statusCode int, body string) *Response {
+	return &Response{
+		StatusCode: statusCode,
+		Header:     http.Header{"Content-Type": []string{"application/xml"}},
+		Body:       []byte(body),
+	}
+}
+
+// RESTXMLErrorResponse returns a REST-XML protocol error response.
+func RESTXMLErrorResponse(statusCode int, code, message string) *Response {
+	return &Response{
+		StatusCode: statusCode,
+		Header:     http.Header{"Content-Type": []string{"application/xml"}},
+		Body:       fmt.Appendf(nil, `<Error><Code>%s</Code><Message>%s</Message><RequestId>fakeaws</RequestId></Error>`, escapeXML(code), escapeXML(message)),
+	}
+}
+
+func escapeXML(s string) string {
+	var buf bytes.Buffer
+	xml.EscapeText(&buf, []byte(s))
+
+	return buf.String()
+}
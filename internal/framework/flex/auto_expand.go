@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -30,6 +31,15 @@ type TypedExpander interface {
 	ExpandTo(ctx context.Context, targetType reflect.Type) (any, diag.Diagnostics)
 }
 
+// Union is implemented by models of AWS API union (tagged union) types.
+// The model has a field, typically a nested block, for each union member, and exactly one must be set.
+// Expanding the model creates the member whose name matches the set field.
+type Union interface {
+	// UnionMembers returns a pointer to a zero value of each member type of the union(s)
+	// that the model expands to, e.g. &awstypes.DestinationMemberS3Bucket{}.
+	UnionMembers() []any
+}
+
 // Expand "expands" a resource's "business logic" data structure,
 // implemented using Terraform Plugin Framework data types, into
 // an AWS SDK for Go v2 API data structure.
@@ -1260,6 +1270,73 @@ func expandTypedExpander(ctx context.Context, fromTypedExpander TypedExpander, t
 	return diags
 }
 
+// expandUnion copies a Plugin Framework union model to a compatible AWS API union (tagged union) interface value.
+func expandUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, fromUnion Union, targetPath path.Path, valTo reflect.Value, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	typeFrom, typeTo := valFrom.Type(), valTo.Type()
+
+	var names []string
+	var set []reflect.StructField
+	for i := 0; i < typeFrom.NumField(); i++ {
+		field := typeFrom.Field(i)
+		if !field.IsExported() {
+			continue // Skip unexported fields.
+		}
+
+		v, ok := valFrom.Field(i).Interface().(attr.Value)
+		if !ok {
+			continue // Skip non-attr.Value fields.
+		}
+
+		names = append(names, field.Tag.Get("tfsdk"))
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		// Unconfigured nested blocks are empty collections rather than null.
+		if v, ok := v.(interface{ Elements() []attr.Value }); ok && len(v.Elements()) == 0 {
+			continue
+		}
+		set = append(set, field)
+	}
+
+	if len(set) != 1 {
+		diags.Append(diagExpandingUnionNotExactlyOne(names))
+		return diags
+	}
+	fromField := set[0]
+
+	for _, member := range fromUnion.UnionMembers() {
+		typeMember := reflect.TypeOf(member)
+		name, ok := unionMemberName(typeMember, typeTo)
+		if !ok {
+			continue
+		}
+
+		if field, ok := findFieldFuzzy(ctx, name, typeMember.Elem(), typeFrom, flexer); !ok || field.Name != fromField.Name {
+			continue
+		}
+
+		tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+			logAttrKeySourceFieldname: fromField.Name,
+			logAttrKeyTargetFieldname: name,
+		})
+
+		to := reflect.New(typeMember.Elem())
+		diags.Append(flexer.convert(ctx, sourcePath.AtName(fromField.Name), valFrom.FieldByIndex(fromField.Index), targetPath.AtName(unionMemberValueFieldName), to.Elem().FieldByName(unionMemberValueFieldName), fieldOpts{})...)
+		if diags.HasError() {
+			return diags
+		}
+
+		valTo.Set(to)
+
+		return diags
+	}
+
+	diags.Append(diagExpandingNoUnionMember(typeFrom, fromField.Name, typeTo))
+	return diags
+}
+
 func diagExpandingSourceIsNil(sourceType reflect.Type) diag.ErrorDiagnostic {
 	return diag.NewErrorDiagnostic(
 		"Incompatible Types",
@@ -1329,3 +1406,20 @@ func diagExpandingIncompatibleTypes(sourceType, targetType reflect.Type) diag.Er
 			fmt.Sprintf("Source type %q cannot be expanded to target type %q.", fullTypeName(sourceType), fullTypeName(targetType)),
 	)
 }
+
+func diagExpandingUnionNotExactlyOne(names []string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Combination",
+		fmt.Sprintf("Exactly one of these attributes must be configured: [%s]", strings.Join(names, ",")),
+	)
+}
+
+func diagExpandingNoUnionMember(sourceType reflect.Type, fieldName string, targetType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Source type %q field %q does not correspond to a member of union %q.", fullTypeName(sourceType), fieldName, fullTypeName(targetType)),
+	)
+}
@@ -4607,6 +4607,111 @@ func TestExpandIgnoreStructTag(t *testing.T) {
 	runAutoExpandTestCases(t, testCases)
 }
 
+func TestExpandRenameStructTag(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"to value": {
+			Source: tfSingleStringFieldRename{
+				Field1: types.StringValue("value1"),
+			},
+			Target: &awsTwoStringValues{},
+			WantTarget: &awsTwoStringValues{
+				Field2: "value1",
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfSingleStringFieldRename](), reflect.TypeFor[*awsTwoStringValues]()),
+				infoConverting(reflect.TypeFor[tfSingleStringFieldRename](), reflect.TypeFor[*awsTwoStringValues]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfSingleStringFieldRename](), "Field2", reflect.TypeFor[*awsTwoStringValues]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field2", reflect.TypeFor[string]()),
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases)
+}
+
+func TestExpandFieldMappings(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"renamed field": {
+			Source: tfSingleStringFieldRename{
+				Field1: types.StringValue("value1"),
+			},
+			Target: &awsTwoStringValues{},
+			WantTarget: &awsTwoStringValues{
+				Field2: "value1",
+			},
+			fieldMappingsModels: []string{"tfSingleStringFieldRename"},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfSingleStringFieldRename](), reflect.TypeFor[*awsTwoStringValues]()),
+				infoConverting(reflect.TypeFor[tfSingleStringFieldRename](), reflect.TypeFor[*awsTwoStringValues]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfSingleStringFieldRename](), "Field2", reflect.TypeFor[*awsTwoStringValues]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field2", reflect.TypeFor[string]()),
+				debugFieldMappings(reflect.TypeFor[tfSingleStringFieldRename](), reflect.TypeFor[*awsTwoStringValues](),
+					`Field1 -> Field2 (source tag "Field2")`,
+					"-> Field1: not set, no corresponding field",
+				),
+			},
+		},
+		"ignored field": {
+			Source: tfSingleStringFieldIgnore{
+				Field1: types.StringValue("value1"),
+			},
+			Target:              &awsSingleStringValue{},
+			WantTarget:          &awsSingleStringValue{},
+			fieldMappingsModels: []string{"awsSingleStringValue"},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfSingleStringFieldIgnore](), reflect.TypeFor[*awsSingleStringValue]()),
+				infoConverting(reflect.TypeFor[tfSingleStringFieldIgnore](), reflect.TypeFor[*awsSingleStringValue]()),
+				traceSkipIgnoredSourceField(reflect.TypeFor[tfSingleStringFieldIgnore](), "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				debugFieldMappings(reflect.TypeFor[tfSingleStringFieldIgnore](), reflect.TypeFor[*awsSingleStringValue](),
+					`Field1: skipped, tag "-"`,
+					"-> Field1: not set, no corresponding field",
+				),
+			},
+		},
+		"legacy field": {
+			Source: tfSingleStringFieldLegacy{
+				Field1: types.StringValue("value1"),
+			},
+			Target: &awsSingleStringValue{},
+			WantTarget: &awsSingleStringValue{
+				Field1: "value1",
+			},
+			fieldMappingsModels: []string{"*"},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfSingleStringFieldLegacy](), reflect.TypeFor[*awsSingleStringValue]()),
+				infoConverting(reflect.TypeFor[tfSingleStringFieldLegacy](), reflect.TypeFor[*awsSingleStringValue]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfSingleStringFieldLegacy](), "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[string]()),
+				debugFieldMappings(reflect.TypeFor[tfSingleStringFieldLegacy](), reflect.TypeFor[*awsSingleStringValue](),
+					"Field1 -> Field1 (legacy)",
+				),
+			},
+		},
+		"other model": {
+			Source: tfSingleStringFieldRename{
+				Field1: types.StringValue("value1"),
+			},
+			Target: &awsTwoStringValues{},
+			WantTarget: &awsTwoStringValues{
+				Field2: "value1",
+			},
+			fieldMappingsModels: []string{"tfSingleStringField"},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfSingleStringFieldRename](), reflect.TypeFor[*awsTwoStringValues]()),
+				infoConverting(reflect.TypeFor[tfSingleStringFieldRename](), reflect.TypeFor[*awsTwoStringValues]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfSingleStringFieldRename](), "Field2", reflect.TypeFor[*awsTwoStringValues]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field2", reflect.TypeFor[string]()),
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases)
+}
+
 func TestExpandInterface(t *testing.T) {
 	t.Parallel()
 
@@ -4868,6 +4973,170 @@ func testFlexAWSInterfaceInterfacePtr(v awsInterfaceInterface) *awsInterfaceInte
 	return &v
 }
 
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"nested object member": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Bucket: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+						Field1: types.StringValue("value1"),
+					}),
+					Name: types.StringNull(),
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberBucket{
+					Value: awsSingleStringValue{
+						Field1: "value1",
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoSourceImplementsFlexUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+				traceMatchedUnionMember("Field1[0]", "Bucket", reflect.TypeFor[tfUnion](), "Field1", "Bucket", reflect.TypeFor[*awsUnion]()),
+				infoConvertingWithPath("Field1[0].Bucket", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]](), "Field1.Value", reflect.TypeFor[awsSingleStringValue]()),
+				traceMatchedFieldsWithPath("Field1[0].Bucket[0]", "Field1", reflect.TypeFor[tfSingleStringField](), "Field1.Value", "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				infoConvertingWithPath("Field1[0].Bucket[0].Field1", reflect.TypeFor[types.String](), "Field1.Value.Field1", reflect.TypeFor[string]()),
+			},
+		},
+		"primitive member": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Bucket: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					Name:   types.StringValue("value1"),
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberName{
+					Value: "value1",
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoSourceImplementsFlexUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+				traceMatchedUnionMember("Field1[0]", "Name", reflect.TypeFor[tfUnion](), "Field1", "Name", reflect.TypeFor[*awsUnion]()),
+				infoConvertingWithPath("Field1[0].Name", reflect.TypeFor[types.String](), "Field1.Value", reflect.TypeFor[string]()),
+			},
+		},
+		"empty nested object member": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Bucket: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{}),
+					Name:   types.StringValue("value1"),
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberName{
+					Value: "value1",
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoSourceImplementsFlexUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+				traceMatchedUnionMember("Field1[0]", "Name", reflect.TypeFor[tfUnion](), "Field1", "Name", reflect.TypeFor[*awsUnion]()),
+				infoConvertingWithPath("Field1[0].Name", reflect.TypeFor[types.String](), "Field1.Value", reflect.TypeFor[string]()),
+			},
+		},
+		"list of members": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Bucket: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						Name:   types.StringValue("value1"),
+					},
+					{
+						Bucket: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						Name:   types.StringValue("value2"),
+					},
+				}),
+			},
+			Target: &awsUnionSlice{},
+			WantTarget: &awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberName{
+						Value: "value1",
+					},
+					&awsUnionMemberName{
+						Value: "value2",
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSlice]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSlice]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSlice]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[[]awsUnion]()),
+				traceExpandingNestedObjectCollection("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), 2, "Field1", reflect.TypeFor[[]awsUnion]()),
+				infoSourceImplementsFlexUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1[0]", reflect.TypeFor[*awsUnion]()),
+				traceMatchedUnionMember("Field1[0]", "Name", reflect.TypeFor[tfUnion](), "Field1[0]", "Name", reflect.TypeFor[*awsUnion]()),
+				infoConvertingWithPath("Field1[0].Name", reflect.TypeFor[types.String](), "Field1[0].Value", reflect.TypeFor[string]()),
+				infoSourceImplementsFlexUnion("Field1[1]", reflect.TypeFor[tfUnion](), "Field1[1]", reflect.TypeFor[*awsUnion]()),
+				traceMatchedUnionMember("Field1[1]", "Name", reflect.TypeFor[tfUnion](), "Field1[1]", "Name", reflect.TypeFor[*awsUnion]()),
+				infoConvertingWithPath("Field1[1].Name", reflect.TypeFor[types.String](), "Field1[1].Value", reflect.TypeFor[string]()),
+			},
+		},
+		"no member set": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Bucket: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					Name:   types.StringNull(),
+				}),
+			},
+			Target: &awsUnionSingle{},
+			expectedDiags: diag.Diagnostics{
+				diagExpandingUnionNotExactlyOne([]string{"bucket", "name"}),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoSourceImplementsFlexUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+			},
+		},
+		"multiple members set": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Bucket: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+						Field1: types.StringValue("value1"),
+					}),
+					Name: types.StringValue("value2"),
+				}),
+			},
+			Target: &awsUnionSingle{},
+			expectedDiags: diag.Diagnostics{
+				diagExpandingUnionNotExactlyOne([]string{"bucket", "name"}),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoSourceImplementsFlexUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases)
+}
+
 func TestExpandExpander(t *testing.T) {
 	t.Parallel()
 
@@ -6005,6 +6274,9 @@ type autoFlexTestCase struct {
 	expectedLogLines []map[string]any
 	WantTarget       any
 	WantDiff         bool
+
+	// fieldMappingsModels are the model type names for which field mapping decisions are logged.
+	fieldMappingsModels []string
 }
 
 type autoFlexTestCases map[string]autoFlexTestCase
@@ -6022,6 +6294,9 @@ func runAutoExpandTestCases(t *testing.T, testCases autoFlexTestCases) {
 			ctx = tflogtest.RootLogger(ctx, &buf)
 
 			ctx = registerTestingLogger(ctx)
+			if len(testCase.fieldMappingsModels) > 0 {
+				ctx = withFieldMappings(ctx, testCase.fieldMappingsModels...)
+			}
 
 			diags := Expand(ctx, testCase.Source, testCase.Target, testCase.Options...)
 
@@ -190,7 +190,7 @@ func (flattener autoFlattener) convert(ctx context.Context, sourcePath path.Path
 		return diags
 
 	case reflect.Interface:
-		diags.Append(flattener.interface_(ctx, vFrom, tTo, vTo)...)
+		diags.Append(flattener.interface_(ctx, sourcePath, vFrom, targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -609,7 +609,7 @@ func (flattener autoFlattener) pointer(ctx context.Context, sourcePath path.Path
 	return diags
 }
 
-func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interface_(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	switch tTo := tTo.(type) {
@@ -653,7 +653,7 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 		//
 		// interface -> types.List(OfObject) or types.Object.
 		//
-		diags.Append(flattener.interfaceToNestedObject(ctx, vFrom, vFrom.IsNil(), tTo, vTo)...)
+		diags.Append(flattener.interfaceToNestedObject(ctx, sourcePath, vFrom, vFrom.IsNil(), targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -1215,7 +1215,7 @@ func (flattener autoFlattener) structToNestedObject(ctx context.Context, sourceP
 }
 
 // interfaceToNestedObject copies an AWS API interface value to a compatible Plugin Framework NestedObjectValue value.
-func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, isNullFrom bool, targetPath path.Path, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if isNullFrom {
@@ -1237,6 +1237,23 @@ func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFro
 
 	toFlattener, ok := to.(Flattener)
 	if !ok {
+		if name, ok := unionMemberName(vFrom.Elem().Type(), vFrom.Type()); ok {
+			tflog.SubsystemInfo(ctx, subsystemName, "Source is union member")
+			diags.Append(flattener.unionMember(ctx, sourcePath, vFrom.Elem(), name, targetPath, to)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			val, d := tTo.ValueFromObjectPtr(ctx, to)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			vTo.Set(reflect.ValueOf(val))
+			return diags
+		}
+
 		val, d := tTo.NullValue(ctx)
 		diags.Append(d...)
 		if diags.HasError() {
@@ -1468,6 +1485,21 @@ func (flattener autoFlattener) sliceOfStructToNestedObjectCollection(ctx context
 			return diags
 		}
 
+		if _, ok := target.(Flattener); !ok && vFrom.Type().Elem().Kind() == reflect.Interface && !vFrom.Index(i).IsNil() {
+			if name, ok := unionMemberName(vFrom.Index(i).Elem().Type(), vFrom.Type().Elem()); ok {
+				ctx := tflog.SubsystemSetField(ctx, subsystemName, logAttrKeySourceType, fullTypeName(vFrom.Type().Elem()))
+				ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeyTargetType, fullTypeName(reflect.TypeOf(target)))
+				tflog.SubsystemInfo(ctx, subsystemName, "Source is union member")
+				diags.Append(flattener.unionMember(ctx, sourcePath, vFrom.Index(i).Elem(), name, targetPath, target)...)
+				if diags.HasError() {
+					return diags
+				}
+
+				t.Index(i).Set(reflect.ValueOf(target))
+				continue
+			}
+		}
+
 		diags.Append(autoFlexConvertStruct(ctx, sourcePath, vFrom.Index(i).Interface(), targetPath, target, flattener)...)
 		if diags.HasError() {
 			return diags
@@ -1567,6 +1599,38 @@ func newStringValueFromReflectPointerValue(v reflect.Value) (attr.Value, diag.Di
 	return newStringValueFromReflectValue(v.Elem())
 }
 
+// unionMember copies an AWS API union (tagged union) member value to the corresponding field of a Plugin Framework union model.
+// All other fields of the model are set to null.
+func (flattener autoFlattener) unionMember(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, memberName string, targetPath path.Path, to any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	valTo := reflect.ValueOf(to)
+	diags.Append(flattenPrePopulate(ctx, valTo)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if vFrom.Kind() == reflect.Pointer {
+		vFrom = vFrom.Elem()
+	}
+	valTo = valTo.Elem()
+
+	toField, ok := findFieldFuzzy(ctx, memberName, vFrom.Type(), valTo.Type(), flattener)
+	if !ok {
+		diags.Append(diagFlatteningNoUnionMemberField(vFrom.Type(), memberName, valTo.Type()))
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+		logAttrKeySourceFieldname: memberName,
+		logAttrKeyTargetFieldname: toField.Name,
+	})
+
+	diags.Append(flattener.convert(ctx, sourcePath.AtName(unionMemberValueFieldName), vFrom.FieldByName(unionMemberValueFieldName), targetPath.AtName(toField.Name), valTo.FieldByIndex(toField.Index), fieldOpts{})...)
+
+	return diags
+}
+
 func flattenFlattener(ctx context.Context, fromVal reflect.Value, toFlattener Flattener) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -1680,3 +1744,13 @@ func diagFlatteningIncompatibleTypes(sourceType, targetType reflect.Type) diag.E
 			fmt.Sprintf("Source type %q cannot be flattened to target type %q.", fullTypeName(sourceType), fullTypeName(targetType)),
 	)
 }
+
+func diagFlatteningNoUnionMemberField(sourceType reflect.Type, memberName string, targetType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while flattening configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Union member %q of type %q does not correspond to a field of %q.", memberName, fullTypeName(sourceType), fullTypeName(targetType)),
+	)
+}
@@ -4335,6 +4335,54 @@ func TestFlattenIgnoreStructTag(t *testing.T) {
 	runAutoExpandTestCases(t, testCases)
 }
 
+func TestFlattenRenameStructTag(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"from value": {
+			Source: awsTwoStringValues{
+				Field1: "value1",
+				Field2: "value2",
+			},
+			Target: &tfSingleStringFieldRename{},
+			WantTarget: &tfSingleStringFieldRename{
+				Field1: types.StringValue("value2"),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsTwoStringValues](), reflect.TypeFor[*tfSingleStringFieldRename]()),
+				infoConverting(reflect.TypeFor[awsTwoStringValues](), reflect.TypeFor[*tfSingleStringFieldRename]()),
+				debugNoCorrespondingField(reflect.TypeFor[awsTwoStringValues](), "Field1", reflect.TypeFor[*tfSingleStringFieldRename]()),
+				traceMatchedFields("Field2", reflect.TypeFor[awsTwoStringValues](), "Field1", reflect.TypeFor[*tfSingleStringFieldRename]()),
+				infoConvertingWithPath("Field2", reflect.TypeFor[string](), "Field1", reflect.TypeFor[types.String]()),
+			},
+		},
+		"field mappings": {
+			Source: awsTwoStringValues{
+				Field1: "value1",
+				Field2: "value2",
+			},
+			Target: &tfSingleStringFieldRename{},
+			WantTarget: &tfSingleStringFieldRename{
+				Field1: types.StringValue("value2"),
+			},
+			fieldMappingsModels: []string{"tfSingleStringFieldRename"},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsTwoStringValues](), reflect.TypeFor[*tfSingleStringFieldRename]()),
+				infoConverting(reflect.TypeFor[awsTwoStringValues](), reflect.TypeFor[*tfSingleStringFieldRename]()),
+				debugNoCorrespondingField(reflect.TypeFor[awsTwoStringValues](), "Field1", reflect.TypeFor[*tfSingleStringFieldRename]()),
+				traceMatchedFields("Field2", reflect.TypeFor[awsTwoStringValues](), "Field1", reflect.TypeFor[*tfSingleStringFieldRename]()),
+				infoConvertingWithPath("Field2", reflect.TypeFor[string](), "Field1", reflect.TypeFor[types.String]()),
+				debugFieldMappings(reflect.TypeFor[awsTwoStringValues](), reflect.TypeFor[*tfSingleStringFieldRename](),
+					"Field1: skipped, no corresponding field",
+					`Field2 -> Field1 (target tag "Field2")`,
+				),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases)
+}
+
 func TestFlattenInterfaceToStringTypable(t *testing.T) {
 	t.Parallel()
 
@@ -4802,6 +4850,128 @@ func TestFlattenInterface(t *testing.T) {
 	runAutoFlattenTestCases(t, testCases)
 }
 
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"nil member": {
+			Source: awsUnionSingle{
+				Field1: nil,
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+			},
+		},
+		"nested object member": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberBucket{
+					Value: awsSingleStringValue{
+						Field1: "value1",
+					},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Bucket: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+						Field1: types.StringValue("value1"),
+					}),
+					Name: types.StringNull(),
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoSourceIsUnionMember("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				traceMatchedUnionMember("Field1", "Bucket", reflect.TypeFor[awsUnion](), "Field1", "Bucket", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoConvertingWithPath("Field1.Value", reflect.TypeFor[awsSingleStringValue](), "Field1.Bucket", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]]()),
+				traceMatchedFieldsWithPath("Field1.Value", "Field1", reflect.TypeFor[awsSingleStringValue](), "Field1.Bucket", "Field1", reflect.TypeFor[*tfSingleStringField]()),
+				infoConvertingWithPath("Field1.Value.Field1", reflect.TypeFor[string](), "Field1.Bucket.Field1", reflect.TypeFor[types.String]()),
+			},
+		},
+		"primitive member": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberName{
+					Value: "value1",
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Bucket: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					Name:   types.StringValue("value1"),
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoSourceIsUnionMember("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				traceMatchedUnionMember("Field1", "Name", reflect.TypeFor[awsUnion](), "Field1", "Name", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoConvertingWithPath("Field1.Value", reflect.TypeFor[string](), "Field1.Name", reflect.TypeFor[types.String]()),
+			},
+		},
+		"list of members": {
+			Source: awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberName{
+						Value: "value1",
+					},
+					&awsUnionMemberBucket{
+						Value: awsSingleStringValue{
+							Field1: "value2",
+						},
+					},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Bucket: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						Name:   types.StringValue("value1"),
+					},
+					{
+						Bucket: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+							Field1: types.StringValue("value2"),
+						}),
+						Name: types.StringNull(),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSlice](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSlice](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSlice](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[[]awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				traceFlatteningNestedObjectCollection("Field1", reflect.TypeFor[[]awsUnion](), 2, "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoSourceIsUnionMember("Field1[0]", reflect.TypeFor[awsUnion](), "Field1[0]", reflect.TypeFor[*tfUnion]()),
+				traceMatchedUnionMember("Field1[0]", "Name", reflect.TypeFor[awsUnion](), "Field1[0]", "Name", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1[0].Value", reflect.TypeFor[string](), "Field1[0].Name", reflect.TypeFor[types.String]()),
+				infoSourceIsUnionMember("Field1[1]", reflect.TypeFor[awsUnion](), "Field1[1]", reflect.TypeFor[*tfUnion]()),
+				traceMatchedUnionMember("Field1[1]", "Bucket", reflect.TypeFor[awsUnion](), "Field1[1]", "Bucket", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1[1].Value", reflect.TypeFor[awsSingleStringValue](), "Field1[1].Bucket", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]]()),
+				traceMatchedFieldsWithPath("Field1[1].Value", "Field1", reflect.TypeFor[awsSingleStringValue](), "Field1[1].Bucket", "Field1", reflect.TypeFor[*tfSingleStringField]()),
+				infoConvertingWithPath("Field1[1].Value.Field1", reflect.TypeFor[string](), "Field1[1].Bucket.Field1", reflect.TypeFor[types.String]()),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases)
+}
+
 func TestFlattenFlattener(t *testing.T) {
 	t.Parallel()
 
@@ -5385,6 +5555,9 @@ func runAutoFlattenTestCases(t *testing.T, testCases autoFlexTestCases) {
 			ctx = tflogtest.RootLogger(ctx, &buf)
 
 			ctx = registerTestingLogger(ctx)
+			if len(testCase.fieldMappingsModels) > 0 {
+				ctx = withFieldMappings(ctx, testCase.fieldMappingsModels...)
+			}
 
 			diags := Flatten(ctx, testCase.Source, testCase.Target, testCase.Options...)
 
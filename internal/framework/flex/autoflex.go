@@ -23,6 +23,8 @@ const (
 	fieldNameSuffixRecurse fieldNamePrefixCtxKey = "FIELD_NAME_SUFFIX_RECURSE"
 
 	mapBlockKeyFieldName = "MapBlockKey"
+
+	unionMemberValueFieldName = "Value"
 )
 
 // Expand  = TF -->  AWS
@@ -102,6 +104,12 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 
 	// TODO: this only applies when Expanding
 	if valTo.Kind() == reflect.Interface {
+		if fromUnion, ok := valFrom.Interface().(Union); ok {
+			tflog.SubsystemInfo(ctx, subsystemName, "Source implements flex.Union")
+			diags.Append(expandUnion(ctx, sourcePath, valFrom, fromUnion, targetPath, valTo, flexer)...)
+			return diags
+		}
+
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
 			"to":   valTo.Kind(),
@@ -119,6 +127,12 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 	typeFrom := valFrom.Type()
 	typeTo := valTo.Type()
 
+	var mappings *fieldMappings
+	if fieldMappingsEnabled(ctx, typeFrom, typeTo) {
+		mappings = &fieldMappings{}
+		defer mappings.log(ctx, typeTo)
+	}
+
 	opts := flexer.getOptions()
 	for i := 0; i < typeFrom.NumField(); i++ {
 		fromField := typeFrom.Field(i)
@@ -131,6 +145,7 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 			tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored source field", map[string]any{
 				logAttrKeySourceFieldname: fieldName,
 			})
+			mappings.skipSource(fieldName, "ignored field name")
 			continue
 		}
 		// TODO: this only applies when Expanding
@@ -138,21 +153,30 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 			tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored source field", map[string]any{
 				logAttrKeySourceFieldname: fieldName,
 			})
+			mappings.skipSource(fieldName, `tag "-"`)
 			continue
 		}
 		if fieldName == mapBlockKeyFieldName {
 			tflog.SubsystemTrace(ctx, subsystemName, "Skipping map block key", map[string]any{
 				logAttrKeySourceFieldname: mapBlockKeyFieldName,
 			})
+			mappings.skipSource(fieldName, "map block key")
 			continue
 		}
 
-		toField, ok := findFieldFuzzy(ctx, fieldName, typeFrom, typeTo, flexer)
+		// A name set in the source field's tag takes precedence over the field name.
+		lookupName := fieldName
+		if fromNameOverride != "" {
+			lookupName = fromNameOverride
+		}
+
+		toField, ok := findFieldFuzzy(ctx, lookupName, typeFrom, typeTo, flexer)
 		if !ok {
 			// Corresponding field not found in to.
 			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
 				logAttrKeySourceFieldname: fieldName,
 			})
+			mappings.skipSource(fieldName, "no corresponding field")
 			continue
 		}
 		toFieldName := toField.Name
@@ -164,6 +188,7 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 				logAttrKeySourceFieldname: fieldName,
 				logAttrKeyTargetFieldname: toFieldName,
 			})
+			mappings.skip(fieldName, toFieldName, `target tag "-"`)
 			continue
 		}
 		if toOpts.NoFlatten() {
@@ -171,6 +196,7 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 				logAttrKeySourceFieldname: fieldName,
 				logAttrKeyTargetFieldname: toFieldName,
 			})
+			mappings.skip(fieldName, toFieldName, "noflatten")
 			continue
 		}
 		if !toFieldVal.CanSet() {
@@ -179,6 +205,7 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 				logAttrKeySourceFieldname: fieldName,
 				logAttrKeyTargetFieldname: toFieldName,
 			})
+			mappings.skip(fieldName, toFieldName, "cannot be set")
 			continue
 		}
 
@@ -191,6 +218,7 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 			legacy:    fromOpts.Legacy() || toOpts.Legacy(),
 			omitempty: toOpts.OmitEmpty(),
 		}
+		mappings.match(fieldName, fromNameOverride, toField, opts, flexer)
 
 		diags.Append(flexer.convert(ctx, sourcePath.AtName(fieldName), valFrom.Field(i), targetPath.AtName(toFieldName), toFieldVal, opts)...)
 		if diags.HasError() {
@@ -202,8 +230,13 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 }
 
 func findFieldFuzzy(ctx context.Context, fieldNameFrom string, typeFrom reflect.Type, typeTo reflect.Type, flexer autoFlexer) (reflect.StructField, bool) {
-	// first precedence is exact match (case sensitive)
-	if fieldTo, ok := typeTo.FieldByName(fieldNameFrom); ok {
+	// first precedence is a field whose name is set in its tag, e.g. `autoflex:"FieldName"`
+	if fieldTo, ok := fieldByNameOverride(typeTo, fieldNameFrom); ok {
+		return fieldTo, true
+	}
+
+	// second precedence is exact match (case sensitive)
+	if fieldTo, ok := fieldByName(typeTo, fieldNameFrom); ok {
 		return fieldTo, true
 	}
 
@@ -213,7 +246,7 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, typeFrom reflect.
 	// fuzzy match "Value" in "to" since "from" also has "Value". We check "from"
 	// to make sure fuzzy matches are not in "from".
 
-	// second precedence is exact match (case insensitive)
+	// third precedence is exact match (case insensitive)
 	opts := flexer.getOptions()
 	for i := 0; i < typeTo.NumField(); i++ {
 		field := typeTo.Field(i)
@@ -224,28 +257,28 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, typeFrom reflect.
 		if opts.isIgnoredField(fieldNameTo) {
 			continue
 		}
-		if fieldTo, ok := typeTo.FieldByName(fieldNameTo); ok && strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, typeFrom) {
+		if fieldTo, ok := fieldByName(typeTo, fieldNameTo); ok && strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, typeFrom) {
 			// probably could assume validity here since reflect gave the field name
 			return fieldTo, true
 		}
 	}
 
-	// third precedence is singular/plural
+	// fourth precedence is singular/plural
 	fieldNameTo := plural.Plural(fieldNameFrom)
 	if plural.IsSingular(fieldNameFrom) && !fieldExistsInStruct(fieldNameTo, typeFrom) {
-		if fieldTo, ok := typeTo.FieldByName(fieldNameTo); ok {
+		if fieldTo, ok := fieldByName(typeTo, fieldNameTo); ok {
 			return fieldTo, true
 		}
 	}
 
 	fieldNameTo = plural.Singular(fieldNameFrom)
 	if plural.IsPlural(fieldNameFrom) && !fieldExistsInStruct(fieldNameTo, typeFrom) {
-		if fieldTo, ok := typeTo.FieldByName(fieldNameTo); ok {
+		if fieldTo, ok := fieldByName(typeTo, fieldNameTo); ok {
 			return fieldTo, true
 		}
 	}
 
-	// fourth precedence is using field name prefix
+	// fifth precedence is using field name prefix
 	if v := opts.fieldNamePrefix; v != "" {
 		v = strings.ReplaceAll(v, " ", "")
 		if ctx.Value(fieldNamePrefixRecurse) == nil {
@@ -258,7 +291,7 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, typeFrom reflect.
 		}
 	}
 
-	// fifth precedence is using field name suffix
+	// sixth precedence is using field name suffix
 	if v := opts.fieldNameSuffix; v != "" {
 		v = strings.ReplaceAll(v, " ", "")
 		if ctx.Value(fieldNameSuffixRecurse) == nil {
@@ -280,6 +313,62 @@ func fieldExistsInStruct(field string, structType reflect.Type) bool {
 	return ok
 }
 
+// fieldByName returns the struct field with the specified name.
+// Fields whose name is overridden in their tag only match the overriding name.
+func fieldByName(structType reflect.Type, name string) (reflect.StructField, bool) {
+	field, ok := structType.FieldByName(name)
+	if !ok || hasNameOverride(field) {
+		return reflect.StructField{}, false
+	}
+
+	return field, true
+}
+
+// fieldByNameOverride returns the exported struct field whose tag overrides its name with the specified name.
+func fieldByNameOverride(structType reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+		if v, _ := autoflexTags(field); v == name && hasNameOverride(field) {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+func hasNameOverride(field reflect.StructField) bool {
+	name, _ := autoflexTags(field)
+	return name != "" && name != "-"
+}
+
+// unionMemberName returns the member name of an AWS API union (tagged union) member type, e.g. "S3Bucket" for
+// DestinationMemberS3Bucket, or false if the type is not a member of the specified union interface type.
+func unionMemberName(tMember, tUnion reflect.Type) (string, bool) {
+	if tUnion.Kind() != reflect.Interface || !tMember.Implements(tUnion) {
+		return "", false
+	}
+
+	if tMember.Kind() == reflect.Pointer {
+		tMember = tMember.Elem()
+	}
+	if tMember.Kind() != reflect.Struct {
+		return "", false
+	}
+	if _, ok := tMember.FieldByName(unionMemberValueFieldName); !ok {
+		return "", false
+	}
+
+	name, ok := strings.CutPrefix(tMember.Name(), tUnion.Name()+"Member")
+	if !ok || name == "" {
+		return "", false
+	}
+
+	return name, true
+}
+
 func autoflexTags(field reflect.StructField) (string, tagOptions) {
 	return parseTag(field.Tag.Get("autoflex"))
 }
@@ -29,6 +29,10 @@ type tfSingleStringFieldIgnore struct {
 	Field1 types.String `tfsdk:"field1" autoflex:"-"`
 }
 
+type tfSingleStringFieldRename struct {
+	Field1 types.String `tfsdk:"field1" autoflex:"Field2"`
+}
+
 type tfSingleStringFieldOmitEmpty struct {
 	Field1 types.String `tfsdk:"field1" autoflex:",omitempty"`
 }
@@ -238,6 +242,11 @@ type awsSingleStringValue struct {
 	Field1 string
 }
 
+type awsTwoStringValues struct {
+	Field1 string
+	Field2 string
+}
+
 type awsSingleStringPointer struct {
 	Field1 *string
 }
@@ -558,6 +567,44 @@ var _ awsInterfaceInterface = &awsInterfaceInterfaceImpl{}
 
 func (t *awsInterfaceInterfaceImpl) isAWSInterfaceInterface() {} // nosemgrep:ci.aws-in-func-name
 
+type tfUnion struct {
+	Bucket fwtypes.ListNestedObjectValueOf[tfSingleStringField] `tfsdk:"bucket"`
+	Name   types.String                                         `tfsdk:"name"`
+}
+
+var _ Union = tfUnion{}
+
+func (tfUnion) UnionMembers() []any {
+	return []any{
+		&awsUnionMemberBucket{},
+		&awsUnionMemberName{},
+	}
+}
+
+type awsUnionSingle struct {
+	Field1 awsUnion
+}
+
+type awsUnionSlice struct {
+	Field1 []awsUnion
+}
+
+type awsUnion interface {
+	isAWSUnion()
+}
+
+type awsUnionMemberBucket struct {
+	Value awsSingleStringValue
+}
+
+func (*awsUnionMemberBucket) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionMemberName struct {
+	Value string
+}
+
+func (*awsUnionMemberName) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
 type tfFlexer struct {
 	Field1 types.String `tfsdk:"field1"`
 }
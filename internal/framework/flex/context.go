@@ -6,11 +6,18 @@ package flex
 import (
 	"context"
 	"os"
+	"strings"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+type fieldMappingsCtxKey string
+
+const (
+	fieldMappingsModels fieldMappingsCtxKey = "FIELD_MAPPINGS_MODELS"
+)
+
 func RegisterLogger(ctx context.Context) context.Context {
 	// tflog.WithLevelFromEnv() does not accommodate a custom default level,
 	// so manage it ourselves here
@@ -19,9 +26,18 @@ func RegisterLogger(ctx context.Context) context.Context {
 		level = l
 	}
 
+	// Field mapping decisions are logged for a comma-separated list of model type names, or "*" for all models.
+	if v := os.Getenv(envvarFieldMappings); v != "" {
+		ctx = withFieldMappings(ctx, strings.Split(v, ",")...)
+	}
+
 	return registerLogger(ctx, level)
 }
 
+func withFieldMappings(ctx context.Context, models ...string) context.Context {
+	return context.WithValue(ctx, fieldMappingsModels, models)
+}
+
 func registerTestingLogger(ctx context.Context) context.Context {
 	return registerLogger(ctx, hclog.NoLevel)
 }
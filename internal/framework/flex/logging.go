@@ -4,10 +4,14 @@
 package flex
 
 import (
+	"context"
 	"fmt"
 	"reflect"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const (
@@ -24,12 +28,15 @@ const (
 	logAttrKeyTargetFieldname = "autoflex.target.fieldname"
 	logAttrKeyTargetPath      = "autoflex.target.path"
 
+	logAttrKeyFieldMappings = "autoflex.field_mappings"
+
 	logAttrKeyError = "error"
 )
 
 const (
-	defaultLogLevel = hclog.Error
-	envvar          = "TF_LOG_AWS_AUTOFLEX"
+	defaultLogLevel     = hclog.Error
+	envvar              = "TF_LOG_AWS_AUTOFLEX"
+	envvarFieldMappings = "TF_LOG_AWS_AUTOFLEX_FIELD_MAPPINGS"
 )
 
 func fullTypeName(t reflect.Type) string {
@@ -50,3 +57,87 @@ func fullTypeName(t reflect.Type) string {
 	}
 	return t.Name()
 }
+
+// fieldMappings records the field mapping decisions made while converting a source struct to a target struct.
+// A nil *fieldMappings records nothing.
+type fieldMappings struct {
+	decisions     []string
+	matchedFields []string
+}
+
+// fieldMappingsEnabled returns whether field mapping decisions are logged for the specified source and target types.
+func fieldMappingsEnabled(ctx context.Context, typeFrom, typeTo reflect.Type) bool {
+	models, ok := ctx.Value(fieldMappingsModels).([]string)
+	if !ok {
+		return false
+	}
+
+	return slices.Contains(models, "*") || slices.Contains(models, typeFrom.Name()) || slices.Contains(models, typeTo.Name())
+}
+
+func (m *fieldMappings) match(fieldName, nameOverride string, toField reflect.StructField, opts fieldOpts, flexer autoFlexer) {
+	if m == nil {
+		return
+	}
+
+	var details []string
+	toNameOverride, _ := autoflexTags(toField)
+	switch toFieldName := toField.Name; {
+	case nameOverride != "":
+		details = append(details, fmt.Sprintf("source tag %q", nameOverride))
+	case toNameOverride != "":
+		details = append(details, fmt.Sprintf("target tag %q", toNameOverride))
+	case fieldName == toFieldName:
+	case strings.EqualFold(fieldName, toFieldName):
+		details = append(details, "case-insensitive")
+	case plural.Plural(fieldName) == toFieldName || plural.Singular(fieldName) == toFieldName:
+		details = append(details, "singular/plural")
+	default:
+		opts := flexer.getOptions()
+		details = append(details, fmt.Sprintf("field name prefix %q or suffix %q", opts.fieldNamePrefix, opts.fieldNameSuffix))
+	}
+	if opts.legacy {
+		details = append(details, "legacy")
+	}
+	if opts.omitempty {
+		details = append(details, "omitempty")
+	}
+
+	decision := fmt.Sprintf("%s -> %s", fieldName, toField.Name)
+	if len(details) > 0 {
+		decision += fmt.Sprintf(" (%s)", strings.Join(details, ", "))
+	}
+	m.decisions = append(m.decisions, decision)
+	m.matchedFields = append(m.matchedFields, toField.Name)
+}
+
+func (m *fieldMappings) skip(fieldName, toFieldName, reason string) {
+	if m == nil {
+		return
+	}
+
+	m.decisions = append(m.decisions, fmt.Sprintf("%s -> %s: skipped, %s", fieldName, toFieldName, reason))
+	m.matchedFields = append(m.matchedFields, toFieldName)
+}
+
+func (m *fieldMappings) skipSource(fieldName, reason string) {
+	if m == nil {
+		return
+	}
+
+	m.decisions = append(m.decisions, fmt.Sprintf("%s: skipped, %s", fieldName, reason))
+}
+
+// log logs the recorded decisions, together with the target fields that no source field was mapped to.
+func (m *fieldMappings) log(ctx context.Context, typeTo reflect.Type) {
+	decisions := m.decisions
+	for i := 0; i < typeTo.NumField(); i++ {
+		if field := typeTo.Field(i); field.PkgPath == "" && !slices.Contains(m.matchedFields, field.Name) {
+			decisions = append(decisions, fmt.Sprintf("-> %s: not set, no corresponding field", field.Name))
+		}
+	}
+
+	tflog.SubsystemDebug(ctx, subsystemName, "Field mappings", map[string]any{
+		logAttrKeyFieldMappings: decisions,
+	})
+}
@@ -445,6 +445,24 @@ func traceFlatteningWithNewMapValueOf(sourcePath string, sourceType reflect.Type
 	}
 }
 
+func debugFieldMappings(sourceType reflect.Type, targetType reflect.Type, mappings ...string) map[string]any {
+	decisions := make([]any, len(mappings)) // arrays are deserialized from JSON as []any
+	for i, v := range mappings {
+		decisions[i] = v
+	}
+
+	return map[string]any{
+		"@level":                hclog.Debug.String(),
+		"@module":               logModule,
+		"@message":              "Field mappings",
+		logAttrKeySourcePath:    "",
+		logAttrKeySourceType:    fullTypeName(sourceType),
+		logAttrKeyTargetPath:    "",
+		logAttrKeyTargetType:    fullTypeName(targetType),
+		logAttrKeyFieldMappings: decisions,
+	}
+}
+
 func infoSourceImplementsFlexExpander(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type) map[string]any {
 	return map[string]any{
 		"@level":             hclog.Info.String(),
@@ -481,6 +499,44 @@ func infoTargetImplementsFlexFlattener(sourcePath string, sourceType reflect.Typ
 	}
 }
 
+func infoSourceImplementsFlexUnion(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type) map[string]any {
+	return map[string]any{
+		"@level":             hclog.Info.String(),
+		"@module":            logModule,
+		"@message":           "Source implements flex.Union",
+		logAttrKeySourcePath: sourcePath,
+		logAttrKeySourceType: fullTypeName(sourceType),
+		logAttrKeyTargetPath: targetPath,
+		logAttrKeyTargetType: fullTypeName(targetType),
+	}
+}
+
+func infoSourceIsUnionMember(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type) map[string]any {
+	return map[string]any{
+		"@level":             hclog.Info.String(),
+		"@module":            logModule,
+		"@message":           "Source is union member",
+		logAttrKeySourcePath: sourcePath,
+		logAttrKeySourceType: fullTypeName(sourceType),
+		logAttrKeyTargetPath: targetPath,
+		logAttrKeyTargetType: fullTypeName(targetType),
+	}
+}
+
+func traceMatchedUnionMember(sourcePath, sourceFieldName string, sourceType reflect.Type, targetPath, targetFieldName string, targetType reflect.Type) map[string]any {
+	return map[string]any{
+		"@level":                  hclog.Trace.String(),
+		"@module":                 logModule,
+		"@message":                "Matched union member",
+		logAttrKeySourcePath:      sourcePath,
+		logAttrKeySourceType:      fullTypeName(sourceType),
+		logAttrKeySourceFieldname: sourceFieldName,
+		logAttrKeyTargetPath:      targetPath,
+		logAttrKeyTargetType:      fullTypeName(targetType),
+		logAttrKeyTargetFieldname: targetFieldName,
+	}
+}
+
 func infoSourceImplementsJSONStringer(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type) map[string]any {
 	return map[string]any{
 		"@level":             hclog.Info.String(),
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+)
+
+// Write-only arguments are sent to the AWS API on create and update but are never persisted in Terraform plan or state.
+// Their values are only available from configuration and may be ephemeral. They require Terraform v1.11.0 or later.
+// A write-only argument named `<name>_wo` is paired with a `<name>_wo_version` argument whose value
+// must be changed to send a new value of the write-only argument to the AWS API.
+
+// WriteOnlyStringAttribute returns the schema for a write-only string argument.
+func WriteOnlyStringAttribute(writeOnlyAttributeName string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:  true,
+		Sensitive: true,
+		WriteOnly: true,
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRoot(writeOnlyAttributeName + sdkv2.WriteOnlyVersionAttributeSuffix)),
+		},
+	}
+}
+
+// WriteOnlyVersionAttribute returns the schema for the argument that triggers an update of the specified write-only argument.
+func WriteOnlyVersionAttribute(writeOnlyAttributeName string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRoot(writeOnlyAttributeName)),
+		},
+	}
+}
+
+// GetWriteOnlyString returns the configured value of the write-only string argument at the specified path.
+func GetWriteOnlyString(ctx context.Context, config tfsdk.Config, p path.Path) (types.String, diag.Diagnostics) {
+	var v types.String
+	diags := config.GetAttribute(ctx, p, &v)
+
+	return v, diags
+}
+
+// HasWriteOnlyChange returns whether a new value of the specified top-level write-only argument must be sent to the AWS API.
+func HasWriteOnlyChange(ctx context.Context, plan tfsdk.Plan, state tfsdk.State, writeOnlyAttributeName string) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	p := path.Root(writeOnlyAttributeName + sdkv2.WriteOnlyVersionAttributeSuffix)
+
+	var planned, current types.Int64
+	diags.Append(plan.GetAttribute(ctx, p, &planned)...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	if state.Raw.IsNull() {
+		return true, diags
+	}
+
+	diags.Append(state.GetAttribute(ctx, p, &current)...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return !planned.Equal(current), diags
+}
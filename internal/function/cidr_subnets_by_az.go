@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = cidrSubnetsByAZFunction{}
+
+func NewCIDRSubnetsByAZFunction() function.Function {
+	return &cidrSubnetsByAZFunction{}
+}
+
+type cidrSubnetsByAZFunction struct{}
+
+func (f cidrSubnetsByAZFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_subnets_by_az"
+}
+
+func (f cidrSubnetsByAZFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_subnets_by_az Function",
+		MarkdownDescription: "Divides a CIDR block into consecutive, equally sized subnets, one per Availability Zone. " +
+			"The result is a map of Availability Zone name to subnet CIDR block.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "prefix",
+				MarkdownDescription: "CIDR block to divide, e.g. a VPC's CIDR block",
+			},
+			function.Int64Parameter{
+				Name:                "newbits",
+				MarkdownDescription: "Number of additional bits with which to extend the prefix",
+			},
+			function.ListParameter{
+				Name:                "availability_zones",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Availability Zone names",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f cidrSubnetsByAZFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var prefix string
+	var newbits int64
+	var azs []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &prefix, &newbits, &azs))
+	if resp.Error != nil {
+		return
+	}
+
+	parent, err := netip.ParsePrefix(prefix)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result := make(map[string]string, len(azs))
+	for i, az := range azs {
+		if _, ok := result[az]; ok {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, fmt.Sprintf("duplicate Availability Zone: %s", az)))
+			return
+		}
+
+		subnet, err := cidrSubnet(parent, int(newbits), int64(i))
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+			return
+		}
+
+		result[az] = subnet.String()
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// cidrSubnet calculates a subnet address within the specified parent prefix.
+// It has the same semantics as Terraform's built-in cidrsubnet function.
+func cidrSubnet(parent netip.Prefix, newbits int, netnum int64) (netip.Prefix, error) {
+	parent = parent.Masked()
+	bits := parent.Bits() + newbits
+	addrLen := parent.Addr().BitLen()
+
+	if newbits < 0 {
+		return netip.Prefix{}, fmt.Errorf("newbits (%d) must not be negative", newbits)
+	}
+	if bits > addrLen {
+		return netip.Prefix{}, fmt.Errorf("insufficient address space to extend prefix of %d by %d", parent.Bits(), newbits)
+	}
+	if maxNetnum := new(big.Int).Lsh(big.NewInt(1), uint(newbits)); big.NewInt(netnum).Cmp(maxNetnum) >= 0 {
+		return netip.Prefix{}, fmt.Errorf("prefix extension of %d does not accommodate a subnet numbered %d", newbits, netnum)
+	}
+
+	addr := new(big.Int).SetBytes(parent.Addr().AsSlice())
+	addr.Or(addr, new(big.Int).Lsh(big.NewInt(netnum), uint(addrLen-bits)))
+
+	b := make([]byte, addrLen/8)
+	addr.FillBytes(b)
+	v, ok := netip.AddrFromSlice(b)
+	if !ok {
+		return netip.Prefix{}, fmt.Errorf("invalid address: %v", b)
+	}
+
+	return netip.PrefixFrom(v, bits), nil
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRSubnetsByAZFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsByAZFunctionConfig(`"10.0.0.0/16", 8, ["us-west-2a", "us-west-2b", "us-west-2c"]`), //lintignore:AWSAT003
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test_a", "10.0.0.0/24"),
+					resource.TestCheckOutput("test_b", "10.0.1.0/24"),
+					resource.TestCheckOutput("test_c", "10.0.2.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsByAZFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsByAZFunctionConfig(`"10.0.0.0/30", 1, ["us-west-2a", "us-west-2b", "us-west-2c"]`), //lintignore:AWSAT003
+				ExpectError: regexache.MustCompile(`does[\s\n]*not[\s\n]*accommodate`),
+			},
+		},
+	})
+}
+
+func testCIDRSubnetsByAZFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  result = provider::aws::cidr_subnets_by_az(%[1]s)
+}
+
+output "test_a" {
+  value = local.result["us-west-2a"]
+}
+
+output "test_b" {
+  value = local.result["us-west-2b"]
+}
+
+output "test_c" {
+  value = local.result["us-west-2c"]
+}
+`, arg) //lintignore:AWSAT003
+}
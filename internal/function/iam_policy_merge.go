@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// policyVersion is the current version of the IAM policy language
+	policyVersion = "2012-10-17"
+)
+
+var _ function.Function = iamPolicyMergeFunction{}
+
+func NewIAMPolicyMergeFunction() function.Function {
+	return &iamPolicyMergeFunction{}
+}
+
+type iamPolicyMergeFunction struct{}
+
+func (f iamPolicyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f iamPolicyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_merge Function",
+		MarkdownDescription: "Merges IAM policy documents into a single normalized policy document. " +
+			"Statements are combined in order, and a statement with the same `Sid` as an earlier statement replaces it.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "policies",
+				ElementType:         types.StringType,
+				MarkdownDescription: "IAM policy documents, in JSON format, to merge",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policies []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policies))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := mergePolicies(policies)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// mergePolicies merges the specified IAM policy documents.
+// Statements with a Sid override any earlier statement with the same Sid.
+func mergePolicies(policies []string) (string, error) {
+	merged := map[string]any{
+		"Version": policyVersion,
+	}
+	var statements []any
+	sids := make(map[string]int)
+
+	for i, policy := range policies {
+		if policy == "" {
+			continue
+		}
+
+		var doc map[string]any
+		if err := tfjson.DecodeFromString(policy, &doc); err != nil {
+			return "", fmt.Errorf("policy %d is invalid JSON: %w", i, err)
+		}
+
+		if v, ok := doc["Version"].(string); ok && v != "" {
+			merged["Version"] = v
+		}
+		if v, ok := doc["Id"].(string); ok && v != "" {
+			merged["Id"] = v
+		}
+
+		var docStatements []any
+		switch v := doc["Statement"].(type) {
+		case nil:
+		case []any:
+			docStatements = v
+		case map[string]any:
+			docStatements = []any{v}
+		default:
+			return "", fmt.Errorf("policy %d: Statement must be an object or an array of objects", i)
+		}
+
+		for _, statement := range docStatements {
+			if m, ok := statement.(map[string]any); ok {
+				if sid, ok := m["Sid"].(string); ok && sid != "" {
+					if j, ok := sids[sid]; ok {
+						statements[j] = statement
+						continue
+					}
+					sids[sid] = len(statements)
+				}
+			}
+
+			statements = append(statements, statement)
+		}
+	}
+
+	merged["Statement"] = statements
+	if len(statements) == 0 {
+		merged["Statement"] = []any{}
+	}
+
+	result, err := tfjson.EncodeToString(merged)
+	if err != nil {
+		return "", err
+	}
+
+	return verify.PolicyToSet("", result)
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = iamPolicyNormalizeFunction{}
+
+func NewIAMPolicyNormalizeFunction() function.Function {
+	return &iamPolicyNormalizeFunction{}
+}
+
+type iamPolicyNormalizeFunction struct{}
+
+func (f iamPolicyNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_normalize"
+}
+
+func (f iamPolicyNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_normalize Function",
+		MarkdownDescription: "Normalizes an IAM policy document. The result is in the same form as the provider " +
+			"stores policy documents in state, so can be compared with resource attribute values.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy",
+				MarkdownDescription: "IAM policy document, in JSON format",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policy string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policy))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := verify.PolicyToSet("", policy)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyNormalizeFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyNormalizeFunctionConfig(`"{\"Statement\":[{\"Effect\":\"Allow\",\"Action\":\"s3:GetObject\",\"Resource\":\"*\"}],\"Version\":\"2012-10-17\"}"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}],"Version":"2012-10-17"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyNormalizeFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyNormalizeFunctionConfig(`"{"`),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*JSON`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyNormalizeFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_normalize(%[1]s)
+}
+`, arg)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"cmp"
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/semver"
+)
+
+var _ function.Function = rdsEngineVersionCompareFunction{}
+
+func NewRDSEngineVersionCompareFunction() function.Function {
+	return &rdsEngineVersionCompareFunction{}
+}
+
+type rdsEngineVersionCompareFunction struct{}
+
+func (f rdsEngineVersionCompareFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "rds_engine_version_compare"
+}
+
+func (f rdsEngineVersionCompareFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "rds_engine_version_compare Function",
+		MarkdownDescription: "Compares two RDS database engine versions, e.g. `8.0.32` or `8.0.mysql_aurora.3.05.2`. " +
+			"Returns -1 if the first version is lower than the second, 0 if they are equal and 1 if the first version is higher than the second.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "version1",
+				MarkdownDescription: "First engine version",
+			},
+			function.StringParameter{
+				Name:                "version2",
+				MarkdownDescription: "Second engine version",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f rdsEngineVersionCompareFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var version1, version2 string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &version1, &version2))
+	if resp.Error != nil {
+		return
+	}
+
+	if version1 == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, "version must not be empty"))
+		return
+	}
+	if version2 == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, "version must not be empty"))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, int64(compareEngineVersions(version1, version2))))
+}
+
+// compareEngineVersions compares two RDS engine versions.
+// Versions that aren't valid semantic versions, e.g. Aurora, Oracle and SQL Server engine versions,
+// are compared segment by segment, numerically where both segments are numbers.
+func compareEngineVersions(v1, v2 string) int {
+	if n, err := semver.Compare(v1, v2); err == nil {
+		return n
+	}
+
+	s1, s2 := strings.Split(v1, "."), strings.Split(v2, ".")
+	for i := 0; i < len(s1) && i < len(s2); i++ {
+		n1, err1 := strconv.ParseInt(s1[i], 10, 64)
+		n2, err2 := strconv.ParseInt(s2[i], 10, 64)
+
+		var n int
+		if err1 == nil && err2 == nil {
+			n = cmp.Compare(n1, n2)
+		} else {
+			n = cmp.Compare(s1[i], s2[i])
+		}
+
+		if n != 0 {
+			return n
+		}
+	}
+
+	return cmp.Compare(len(s1), len(s2))
+}
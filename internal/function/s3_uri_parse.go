@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// s3URIScheme is the scheme of an S3 URI
+	s3URIScheme = "s3://"
+)
+
+var s3URIParseResultAttrTypes = map[string]attr.Type{
+	"bucket": types.StringType,
+	"key":    types.StringType,
+}
+
+var _ function.Function = s3URIParseFunction{}
+
+func NewS3URIParseFunction() function.Function {
+	return &s3URIParseFunction{}
+}
+
+type s3URIParseFunction struct{}
+
+func (f s3URIParseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "s3_uri_parse"
+}
+
+func (f s3URIParseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "s3_uri_parse Function",
+		MarkdownDescription: "Parses an S3 URI, e.g. `s3://bucket/key`, into its bucket name and object key",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "uri",
+				MarkdownDescription: "S3 URI to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: s3URIParseResultAttrTypes,
+		},
+	}
+}
+
+func (f s3URIParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	bucket, key, err := parseS3URI(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"bucket": types.StringValue(bucket),
+		"key":    types.StringValue(key),
+	}
+
+	result, d := types.ObjectValue(s3URIParseResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// parseS3URI splits an S3 URI into its bucket name and (possibly empty) object key
+func parseS3URI(s string) (string, string, error) {
+	rest, ok := strings.CutPrefix(s, s3URIScheme)
+	if !ok {
+		return "", "", errors.New(`URI must begin with "` + s3URIScheme + `"`)
+	}
+
+	bucket, key, _ := strings.Cut(rest, "/")
+	if bucket == "" {
+		return "", "", errors.New("bucket name must not be empty")
+	}
+
+	return bucket, key, nil
+}
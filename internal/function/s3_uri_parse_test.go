@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestS3URIParseFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig(`"s3://example-bucket/path/to/object.txt"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("bucket", "example-bucket"),
+					resource.TestCheckOutput("key", "path/to/object.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testS3URIParseFunctionConfig(`"https://example-bucket.s3.amazonaws.com/object.txt"`),
+				ExpectError: regexache.MustCompile(`URI[\s\n]*must[\s\n]*begin`),
+			},
+		},
+	})
+}
+
+func testS3URIParseFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  result = provider::aws::s3_uri_parse(%[1]s)
+}
+
+output "bucket" {
+  value = local.result.bucket
+}
+
+output "key" {
+  value = local.result.key
+}
+`, arg)
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+var _ function.Function = tagsMergeFunction{}
+
+func NewTagsMergeFunction() function.Function {
+	return &tagsMergeFunction{}
+}
+
+type tagsMergeFunction struct{}
+
+func (f tagsMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_merge"
+}
+
+func (f tagsMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "tags_merge Function",
+		MarkdownDescription: "Merges resource tags with default tags in the same way as the provider's `default_tags` " +
+			"configuration block. The result is the same as a resource's `tags_all` attribute.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "default_tags",
+				ElementType:         types.StringType,
+				AllowNullValue:      true,
+				MarkdownDescription: "Default tags",
+			},
+			function.MapParameter{
+				Name:                "tags",
+				ElementType:         types.StringType,
+				AllowNullValue:      true,
+				MarkdownDescription: "Resource tags. Resource tags override default tags with the same key",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f tagsMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var defaultTags, tags map[string]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &defaultTags, &tags))
+	if resp.Error != nil {
+		return
+	}
+
+	defaultConfig := &tftags.DefaultConfig{
+		Tags: tftags.New(ctx, defaultTags),
+	}
+	result := defaultConfig.MergeTags(tftags.New(ctx, tags)).Map()
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestTagsMergeFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsMergeFunctionConfig(`{Environment = "test", Owner = "platform"}, {Owner = "app", Name = "example"}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Environment":"test","Name":"example","Owner":"app"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestTagsMergeFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testTagsMergeFunctionConfig(`{Environment = "test"}, "invalid"`),
+				ExpectError: regexache.MustCompile(`Invalid[\s\n]*function[\s\n]*argument`),
+			},
+		},
+	})
+}
+
+func testTagsMergeFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = jsonencode(provider::aws::tags_merge(%[1]s))
+}
+`, arg)
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var userDataPartAttrTypes = map[string]attr.Type{
+	"content":      types.StringType,
+	"content_type": types.StringType,
+}
+
+type userDataPart struct {
+	Content     string `tfsdk:"content"`
+	ContentType string `tfsdk:"content_type"`
+}
+
+var _ function.Function = userDataEncodeFunction{}
+
+func NewUserDataEncodeFunction() function.Function {
+	return &userDataEncodeFunction{}
+}
+
+type userDataEncodeFunction struct{}
+
+func (f userDataEncodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "user_data_encode"
+}
+
+func (f userDataEncodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "user_data_encode Function",
+		MarkdownDescription: "Encodes parts, e.g. cloud-init configuration and shell scripts, as a gzip-compressed, " +
+			"base64-encoded multipart MIME document suitable for an EC2 instance's or launch template's `user_data_base64` argument.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name: "parts",
+				ElementType: types.ObjectType{
+					AttrTypes: userDataPartAttrTypes,
+				},
+				MarkdownDescription: "Parts to encode. Each part is an object with `content_type`, e.g. `text/cloud-config`, and `content` attributes",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f userDataEncodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var parts []userDataPart
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &parts))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := encodeUserData(parts)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// encodeUserData returns the gzip-compressed, base64-encoded multipart MIME document containing the specified parts
+func encodeUserData(parts []userDataPart) (string, error) {
+	if len(parts) == 0 {
+		return "", errors.New("at least one part is required")
+	}
+
+	headers := make([]textproto.MIMEHeader, len(parts))
+	bodies := make([][]byte, len(parts))
+	for i, part := range parts {
+		if part.ContentType == "" {
+			return "", fmt.Errorf("part %d: content_type must not be empty", i)
+		}
+
+		encoding, body := encodeUserDataPartContent(part.Content)
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Transfer-Encoding", encoding)
+		header.Set("Content-Type", part.ContentType)
+		header.Set("Mime-Version", "1.0")
+
+		headers[i] = header
+		bodies[i] = body
+	}
+
+	boundary := userDataMIMEBoundary(bodies)
+
+	var mime bytes.Buffer
+	fmt.Fprintf(&mime, "Content-Type: multipart/mixed; boundary=%q\r\n", boundary)
+	fmt.Fprint(&mime, "MIME-Version: 1.0\r\n\r\n")
+
+	w := multipart.NewWriter(&mime)
+	if err := w.SetBoundary(boundary); err != nil {
+		return "", err
+	}
+
+	for i, header := range headers {
+		pw, err := w.CreatePart(header)
+		if err != nil {
+			return "", err
+		}
+
+		if _, err := pw.Write(bodies[i]); err != nil {
+			return "", err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	var compressed bytes.Buffer
+	gw := gzip.NewWriter(&compressed)
+	if _, err := gw.Write(mime.Bytes()); err != nil {
+		return "", err
+	}
+	if err := gw.Close(); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(compressed.Bytes()), nil
+}
+
+// encodeUserDataPartContent returns the Content-Transfer-Encoding and encoded body of a part's content.
+// 7bit is only valid for US-ASCII content with lines of at most 998 octets, so any other content is base64-encoded.
+func encodeUserDataPartContent(content string) (string, []byte) {
+	is7bit := !slices.ContainsFunc([]byte(content), func(b byte) bool {
+		return b >= 0x80 || b == 0
+	}) && !slices.ContainsFunc(bytes.Split([]byte(content), []byte("\n")), func(line []byte) bool {
+		return len(line) > 998
+	})
+
+	if is7bit {
+		return "7bit", []byte(content)
+	}
+
+	// Base64-encoded lines must be no more than 76 characters long.
+	const lineLength = 76
+	encoded := base64.StdEncoding.EncodeToString([]byte(content))
+	var body bytes.Buffer
+	for len(encoded) > lineLength {
+		body.WriteString(encoded[:lineLength] + "\r\n")
+		encoded = encoded[lineLength:]
+	}
+	body.WriteString(encoded)
+
+	return "base64", body.Bytes()
+}
+
+// userDataMIMEBoundary returns a random-looking multipart boundary that doesn't occur in any of the encoded part bodies.
+// The boundary is derived from a hash of the bodies rather than read from crypto/rand
+// because provider functions must return the same result for the same arguments.
+func userDataMIMEBoundary(bodies [][]byte) string {
+	h := sha256.New()
+	for _, body := range bodies {
+		h.Write([]byte(strconv.Itoa(len(body)) + ":"))
+		h.Write(body)
+	}
+	seed := h.Sum(nil)
+
+	for i := 0; ; i++ {
+		h := sha256.New()
+		h.Write(seed)
+		h.Write([]byte(strconv.Itoa(i)))
+		boundary := hex.EncodeToString(h.Sum(nil))
+
+		if !slices.ContainsFunc(bodies, func(body []byte) bool {
+			return bytes.Contains(body, []byte(boundary))
+		}) {
+			return boundary
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestUserDataEncodeFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testUserDataEncodeFunctionConfig(`[{content_type = "text/x-shellscript", content = "#!/bin/bash\necho hello"}]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "H4sIAAAAAAAA/6yPMU/EIBiGdxL+A54zFmhpezVO5gaHbsadDz4sSQsNcEnv3xsdjImj7u/75HmeU6wYK3+97Tix7brWsJtcmy0c6B4ZpGt0Jt+eTho1KpDWDXC2QrZ9r0bRDdi7TvsOlAbTKnA4dvose++1R1CuFVqIYXStPFEyv8wX/oa5hBQnJh8EJZRw/lcyJd8R2cTiMfNLtMmF+D6xAUL9MfiqrHjU5uBlwXUtNoe9UjKHDX+53d81EGIDpiwE7ZLY5yP9hzPnlHwMAF9mEAR7AQAA"),
+				),
+			},
+			// Non-ASCII content is sent with Content-Transfer-Encoding: base64.
+			{
+				Config: testUserDataEncodeFunctionConfig(`[{content_type = "text/cloud-config", content = "#cloud-config\nfqdn: h\u00e9llo.example.com"}]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "H4sIAAAAAAAA/6zPQUvDMBjG8Xuh36HsHpdkWWwmu0yGDNwElW309iZ5s0XXpDSp27694EEEj3p/ePj/7mPIGDJ5vXY4q9rhlH0HfR63/oL2rtJxCBb663zk6JQhZxqsshOojaCc1Q7AUSWsATmVXHHLlATkQjoUjNMJIqhbBcLWelQW69V6SbbYJx/DrGI3tCzKgpC/PpfFN6KHkBz2ZBlMtD4cZpWGhFL8mHw5M17y2JziYImJwflDWax9i7/iVnyT9GT7/rjbfOi26Zpz15rd8/DkF/EsT0k/1EOzPx71fpGal+mb5nT+HyRCyuJzAAk35XmaAQAA"),
+				),
+			},
+			// The boundary is derived from the content and never appears in it.
+			{
+				Config: testUserDataEncodeFunctionConfig(`[{content_type = "text/x-shellscript", content = "#!/bin/bash\necho '--MIMEBOUNDARY'"}, {content_type = "text/cloud-config", content = "#cloud-config"}]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "H4sIAAAAAAAA/8TPP0vEMBjH8T2Q91DP4abHNm1zTSsO/rnBoQqigmOTPLkG2qSkKfTeveggijjd4P7jx+d7611EF+H5OGGTjMsQ7dSFmI52RX2ZSL843YXj1YZzWWG560Ql6xJzlaPZISslZwKruqqNFqVhRgguMlaUTBWcc2FyrkSmM1VsKGnv2z28Ypitd03CLjJKKAE49ZmSr4jQudlggL1TXlt3aJJK2vht8FkZcY3pCnOPwzCrYKdISWtH/GU7P0uldans5p6g6n2yBfiIuHl8ebi7fnrb/h9fDX7RoLwz9vCX/ufmdCkAJe8DAHVSLNMwAgAA"),
+				),
+			},
+		},
+	})
+}
+
+func TestUserDataEncodeFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testUserDataEncodeFunctionConfig(`[{content_type = "", content = "#!/bin/bash"}]`),
+				ExpectError: regexache.MustCompile(`content_type[\s\n]*must[\s\n]*not[\s\n]*be[\s\n]*empty`),
+			},
+		},
+	})
+}
+
+func testUserDataEncodeFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::user_data_encode(%[1]s)
+}
+`, arg)
+}
@@ -14,6 +14,7 @@ import (
 	"go/parser"
 	"go/token"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/YakDriver/regexache"
@@ -59,8 +60,11 @@ func main() {
 			ephemeralResources:   make(map[string]ResourceDatum, 0),
 			frameworkDataSources: make(map[string]ResourceDatum, 0),
 			frameworkResources:   make(map[string]ResourceDatum, 0),
+			listResources:        make(map[string]ResourceDatum, 0),
 			sdkDataSources:       make(map[string]ResourceDatum),
 			sdkResources:         make(map[string]ResourceDatum),
+
+			isGlobalService: l.IsGlobal(),
 		}
 
 		v.processDir(".")
@@ -78,6 +82,7 @@ func main() {
 			EphemeralResources:   v.ephemeralResources,
 			FrameworkDataSources: v.frameworkDataSources,
 			FrameworkResources:   v.frameworkResources,
+			ListResources:        v.listResources,
 			SDKDataSources:       v.sdkDataSources,
 			SDKResources:         v.sdkResources,
 		}
@@ -116,6 +121,17 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	RegionAnnotated         bool
+	IsGlobal                bool
+	IsRegionOverrideEnabled bool
+	ARNIdentity             string
+	IdentityAttributes      []IdentityAttribute
+	Identity                string // Go expression for the resource's identity
+}
+
+type IdentityAttribute struct {
+	Name     string
+	Required bool
 }
 
 type ServiceDatum struct {
@@ -127,6 +143,7 @@ type ServiceDatum struct {
 	EphemeralResources   map[string]ResourceDatum
 	FrameworkDataSources map[string]ResourceDatum
 	FrameworkResources   map[string]ResourceDatum
+	ListResources        map[string]ResourceDatum
 	SDKDataSources       map[string]ResourceDatum
 	SDKResources         map[string]ResourceDatum
 }
@@ -154,8 +171,11 @@ type visitor struct {
 	ephemeralResources   map[string]ResourceDatum
 	frameworkDataSources map[string]ResourceDatum
 	frameworkResources   map[string]ResourceDatum
+	listResources        map[string]ResourceDatum
 	sdkDataSources       map[string]ResourceDatum
 	sdkResources         map[string]ResourceDatum
+
+	isGlobalService bool
 }
 
 // processDir scans a single service package directory and processes contained Go sources files.
@@ -197,12 +217,77 @@ func (v *visitor) processFile(file *ast.File) {
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
-	// Look first for tagging annotations.
+	// Look first for tagging, Region and identity annotations.
 	d := ResourceDatum{}
 
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Region" {
+			args := common.ParseArgs(m[3])
+
+			if d.RegionAnnotated {
+				v.errs = append(v.errs, fmt.Errorf("multiple Region annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			d.RegionAnnotated = true
+			d.IsRegionOverrideEnabled = true
+
+			if attr, ok := args.Keyword["global"]; ok {
+				if global, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid Region/global value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else {
+					d.IsGlobal = global
+					d.IsRegionOverrideEnabled = !global
+				}
+			}
+
+			if attr, ok := args.Keyword["overrideEnabled"]; ok {
+				if enabled, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid Region/overrideEnabled value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else {
+					d.IsRegionOverrideEnabled = enabled
+				}
+			}
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "ArnIdentity" {
+			args := common.ParseArgs(m[3])
+
+			if d.ARNIdentity != "" {
+				v.errs = append(v.errs, fmt.Errorf("multiple ArnIdentity annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			d.ARNIdentity = "arn"
+			if len(args.Positional) > 0 {
+				d.ARNIdentity = args.Positional[0]
+			}
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "IdentityAttribute" {
+			args := common.ParseArgs(m[3])
+
+			if len(args.Positional) == 0 {
+				v.errs = append(v.errs, fmt.Errorf("no IdentityAttribute name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			identityAttribute := IdentityAttribute{
+				Name:     args.Positional[0],
+				Required: true,
+			}
+
+			if attr, ok := args.Keyword["required"]; ok {
+				if required, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid IdentityAttribute/required value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else {
+					identityAttribute.Required = required
+				}
+			}
+
+			d.IdentityAttributes = append(d.IdentityAttributes, identityAttribute)
+		}
+
 		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Tags" {
 			args := common.ParseArgs(m[3])
 
@@ -222,6 +307,12 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 		}
 	}
 
+	if d.ARNIdentity != "" && len(d.IdentityAttributes) > 0 {
+		v.errs = append(v.errs, fmt.Errorf("both ArnIdentity and IdentityAttribute annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+	}
+
+	d.Identity = v.identity(d)
+
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
@@ -304,6 +395,29 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.frameworkResources[typeName] = d
 				}
+			case "ListResource":
+				if len(args.Positional) == 0 {
+					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				typeName := args.Positional[0]
+
+				if !validTypeName.MatchString(typeName) {
+					v.errs = append(v.errs, fmt.Errorf("invalid type name (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				if d.Name == "" {
+					v.errs = append(v.errs, fmt.Errorf("no friendly name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				if _, ok := v.listResources[typeName]; ok {
+					v.errs = append(v.errs, fmt.Errorf("duplicate List Resource (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.listResources[typeName] = d
+				}
 			case "SDKDataSource":
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -350,7 +464,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "ArnIdentity", "IdentityAttribute", "Region", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
@@ -363,6 +477,29 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = ""
 }
 
+// identity returns the Go expression for the specified resource's identity, if any.
+func (v *visitor) identity(d ResourceDatum) string {
+	scope := "Regional"
+	if d.RegionAnnotated && d.IsGlobal || !d.RegionAnnotated && v.isGlobalService {
+		scope = "Global"
+	}
+
+	switch {
+	case d.ARNIdentity != "":
+		return fmt.Sprintf("types.%sARNIdentity(%s)", scope, namesgen.ConstOrQuote(d.ARNIdentity))
+	case len(d.IdentityAttributes) == 1 && d.IdentityAttributes[0].Required:
+		return fmt.Sprintf("types.%sSingleParameterIdentity(%s)", scope, namesgen.ConstOrQuote(d.IdentityAttributes[0].Name))
+	case len(d.IdentityAttributes) > 0:
+		var attributes []string
+		for _, attr := range d.IdentityAttributes {
+			attributes = append(attributes, fmt.Sprintf("types.IdentityAttribute{Name: %s, Required: %t}", namesgen.ConstOrQuote(attr.Name), attr.Required))
+		}
+		return fmt.Sprintf("types.%sParameterizedIdentity(%s)", scope, strings.Join(attributes, ", "))
+	default:
+		return ""
+	}
+}
+
 // Visit is called for each node visited by ast.Walk.
 func (v *visitor) Visit(node ast.Node) ast.Visitor {
 	// Look at functions (not methods) with comments.
@@ -10,6 +10,7 @@ import (
 	"cmp"
 	_ "embed"
 	"errors"
+	"flag"
 	"fmt"
 	"io/fs"
 	"os"
@@ -29,9 +30,14 @@ type TemplateData struct {
 }
 
 func main() {
-	const (
-		filename = `register_gen_test.go`
-	)
+	filename := `register_gen_test.go`
+
+	flag.Parse()
+	args := flag.Args()
+	if len(args) > 0 {
+		filename = args[0]
+	}
+
 	g := common.NewGenerator()
 
 	packageName := os.Getenv("GOPACKAGE")
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package list
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// WriteImportBlocks writes a Terraform `import` block for each of the specified resources.
+// Resource names are derived from each resource's display name, falling back to its import ID.
+// Duplicate names are made unique with a numeric suffix that does not clash with any other resource's name.
+func WriteImportBlocks(w io.Writer, typeName string, results []Result) error {
+	labels := make([]string, len(results))
+	taken := make(map[string]bool, len(results))
+	for i, result := range results {
+		labels[i] = resourceLabel(result)
+		taken[labels[i]] = true
+	}
+
+	used := make(map[string]bool, len(results))
+	for i, result := range results {
+		label := labels[i]
+		if used[label] {
+			for n := 2; ; n++ {
+				if v := fmt.Sprintf("%s_%d", labels[i], n); !taken[v] && !used[v] {
+					label = v
+					break
+				}
+			}
+		}
+		used[label] = true
+
+		if i > 0 {
+			if _, err := io.WriteString(w, "\n"); err != nil {
+				return err
+			}
+		}
+
+		if _, err := fmt.Fprintf(w, "import {\n  to = %s.%s\n  id = %s\n}\n", typeName, label, quote(result.ImportID)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceLabel returns a valid Terraform resource name for the specified resource.
+func resourceLabel(result Result) string {
+	name := result.DisplayName
+	if name == "" {
+		name = result.ImportID
+	}
+
+	var sb strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r <= unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)), r == '_', r == '-':
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune('_')
+		}
+	}
+
+	label := sb.String()
+	if label == "" {
+		return "this"
+	}
+	// Names must begin with a letter or underscore.
+	if r := rune(label[0]); !unicode.IsLetter(r) && r != '_' {
+		label = "_" + label
+	}
+
+	return label
+}
+
+// quote returns the specified value as an HCL quoted string literal.
+func quote(s string) string {
+	s = strconv.Quote(s)
+	// Escape template sequences.
+	s = strings.ReplaceAll(s, "${", "$${")
+	s = strings.ReplaceAll(s, "%{", "%%{")
+
+	return s
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package list implements discovery of existing resources, e.g. for generating `import` blocks
+// when adopting resources created outside of Terraform.
+package list
+
+import (
+	"context"
+	"iter"
+	"strings"
+)
+
+// Filter restricts the resources returned by a list operation.
+// The zero value matches all resources.
+type Filter struct {
+	// NamePrefix matches resources whose name begins with the specified value.
+	NamePrefix string
+	// Tags matches resources having all the specified tags.
+	// An empty tag value matches any value.
+	Tags map[string]string
+}
+
+// MatchName returns whether the specified resource name matches the filter.
+func (f Filter) MatchName(name string) bool {
+	return strings.HasPrefix(name, f.NamePrefix)
+}
+
+// MatchTags returns whether the specified resource tags match the filter.
+func (f Filter) MatchTags(tags map[string]string) bool {
+	for k, v := range f.Tags {
+		tag, ok := tags[k]
+		if !ok {
+			return false
+		}
+		if v != "" && tag != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HasTags returns whether the filter matches on tags.
+// Listers use this to avoid per-resource API calls to retrieve tags when they aren't needed.
+func (f Filter) HasTags() bool {
+	return len(f.Tags) > 0
+}
+
+// Result represents an existing resource discovered by a list operation.
+type Result struct {
+	// DisplayName is a human-readable name for the resource, e.g. the value of its `Name` tag.
+	DisplayName string `json:"display_name"`
+	// Identity contains the values of the resource's identity attributes.
+	Identity map[string]string `json:"identity"`
+	// ImportID is the ID used to import the resource.
+	ImportID string `json:"import_id"`
+	// State is the resource's full state in flatmap format.
+	// It is only populated when requested.
+	State map[string]string `json:"state,omitempty"`
+}
+
+// ListFunc enumerates existing resources of a single type in the Region of the specified provider meta-data.
+// Errors are returned in the sequence, and iteration stops at the first error.
+type ListFunc func(ctx context.Context, meta any, filter Filter) iter.Seq2[Result, error]
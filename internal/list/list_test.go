@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package list
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFilterMatchName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		filter   Filter
+		name     string
+		expected bool
+	}{
+		"no filter": {
+			name:     "bucket-1",
+			expected: true,
+		},
+		"prefix match": {
+			filter:   Filter{NamePrefix: "bucket-"},
+			name:     "bucket-1",
+			expected: true,
+		},
+		"prefix no match": {
+			filter: Filter{NamePrefix: "topic-"},
+			name:   "bucket-1",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.filter.MatchName(testCase.name), testCase.expected; got != want {
+				t.Errorf("MatchName(%q) = %t, want %t", testCase.name, got, want)
+			}
+		})
+	}
+}
+
+func TestFilterMatchTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		filter   Filter
+		tags     map[string]string
+		expected bool
+	}{
+		"no filter": {
+			tags:     map[string]string{"Name": "web"},
+			expected: true,
+		},
+		"value match": {
+			filter:   Filter{Tags: map[string]string{"Name": "web"}},
+			tags:     map[string]string{"Name": "web", "Env": "prod"},
+			expected: true,
+		},
+		"value no match": {
+			filter: Filter{Tags: map[string]string{"Name": "web"}},
+			tags:   map[string]string{"Name": "db"},
+		},
+		"key only match": {
+			filter:   Filter{Tags: map[string]string{"Env": ""}},
+			tags:     map[string]string{"Env": "prod"},
+			expected: true,
+		},
+		"key missing": {
+			filter: Filter{Tags: map[string]string{"Env": ""}},
+			tags:   map[string]string{"Name": "web"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.filter.MatchTags(testCase.tags), testCase.expected; got != want {
+				t.Errorf("MatchTags(%v) = %t, want %t", testCase.tags, got, want)
+			}
+		})
+	}
+}
+
+func TestWriteImportBlocks(t *testing.T) {
+	t.Parallel()
+
+	results := []Result{
+		{DisplayName: "Web Server", ImportID: "i-0123456789abcdef0"},
+		{ImportID: "i-0123456789abcdef1"},
+		{DisplayName: "web server", ImportID: "i-0123456789abcdef2"},
+		{DisplayName: "${var}", ImportID: "${var}"},
+	}
+
+	var sb strings.Builder
+	if err := WriteImportBlocks(&sb, "aws_instance", results); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `import {
+  to = aws_instance.web_server
+  id = "i-0123456789abcdef0"
+}
+
+import {
+  to = aws_instance.i-0123456789abcdef1
+  id = "i-0123456789abcdef1"
+}
+
+import {
+  to = aws_instance.web_server_2
+  id = "i-0123456789abcdef2"
+}
+
+import {
+  to = aws_instance.__var_
+  id = "$${var}"
+}
+`
+
+	if got, want := sb.String(), expected; got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestWriteImportBlocksDuplicateNames(t *testing.T) {
+	t.Parallel()
+
+	results := []Result{
+		{DisplayName: "web_server", ImportID: "i-0123456789abcdef0"},
+		{DisplayName: "web_server", ImportID: "i-0123456789abcdef1"},
+		{DisplayName: "web_server_2", ImportID: "i-0123456789abcdef2"},
+		{DisplayName: "web_server", ImportID: "i-0123456789abcdef3"},
+	}
+
+	var sb strings.Builder
+	if err := WriteImportBlocks(&sb, "aws_instance", results); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `import {
+  to = aws_instance.web_server
+  id = "i-0123456789abcdef0"
+}
+
+import {
+  to = aws_instance.web_server_3
+  id = "i-0123456789abcdef1"
+}
+
+import {
+  to = aws_instance.web_server_2
+  id = "i-0123456789abcdef2"
+}
+
+import {
+  to = aws_instance.web_server_4
+  id = "i-0123456789abcdef3"
+}
+`
+
+	if got, want := sb.String(), expected; got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
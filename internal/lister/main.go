@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// lister discovers existing resources of a single type using the provider's list resources
+// and writes Terraform `import` blocks for them, e.g. when adopting resources created outside of Terraform.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/list"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+const (
+	formatHCL  = "hcl"
+	formatJSON = "json"
+)
+
+var (
+	format       = flag.String("format", formatHCL, "Output format, either hcl (import blocks) or json")
+	includeState = flag.Bool("include-state", false, "Read each resource's full state (json format only)")
+	namePrefix   = flag.String("name-prefix", "", "Only list resources whose name begins with this value")
+	out          = flag.String("out", "", "Path of the file to which output is written (default stdout)")
+	profile      = flag.String("profile", "", "Named AWS shared configuration profile of the account to list")
+	region       = flag.String("region", "", "AWS Region to list (default from the AWS configuration)")
+	resourceType = flag.String("resource-type", "", "Resource type to list, e.g. aws_instance (required)")
+	tags         = flag.String("tags", "", "Comma-separated list of tags that resources must have, as key=value or key for any value")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tlister -resource-type <type> [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if *resourceType == "" || flag.NArg() > 0 || (*format != formatHCL && *format != formatJSON) {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if *includeState && *format != formatJSON {
+		fmt.Fprintln(os.Stderr, "error: -include-state requires -format json")
+		os.Exit(2)
+	}
+
+	if err := run(context.Background()); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context) error {
+	p, err := provider.New(ctx)
+	if err != nil {
+		return err
+	}
+
+	config := make(map[string]any)
+	if *profile != "" {
+		config["profile"] = *profile
+	}
+	if *region != "" {
+		config["region"] = *region
+	}
+
+	if err := sdkdiag.DiagnosticsError(p.Configure(ctx, terraform.NewResourceConfigRaw(config))); err != nil {
+		return fmt.Errorf("configuring provider: %w", err)
+	}
+
+	filter := list.Filter{
+		NamePrefix: *namePrefix,
+		Tags:       parseTags(*tags),
+	}
+
+	var results []list.Result
+	for result, err := range provider.ListResources(ctx, p, *resourceType, filter, *includeState) {
+		if err != nil {
+			return err
+		}
+
+		results = append(results, result)
+	}
+
+	fmt.Fprintf(os.Stderr, "Found %d %s resources\n", len(results), *resourceType)
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		w = f
+	}
+
+	return write(w, *format, *resourceType, results)
+}
+
+// write writes the results in the specified format.
+func write(w io.Writer, format, typeName string, results []list.Result) error {
+	if format == formatJSON {
+		if results == nil {
+			results = []list.Result{}
+		}
+
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(results)
+	}
+
+	return list.WriteImportBlocks(w, typeName, results)
+}
+
+// parseTags parses a comma-separated list of key=value pairs.
+// A key without a value matches any tag value.
+func parseTags(s string) map[string]string {
+	if s == "" {
+		return nil
+	}
+
+	tags := make(map[string]string)
+	for _, v := range strings.Split(s, ",") {
+		k, v, _ := strings.Cut(strings.TrimSpace(v), "=")
+		if k == "" {
+			continue
+		}
+		tags[k] = v
+	}
+
+	return tags
+}
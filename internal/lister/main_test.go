@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/list"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestParseTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected map[string]string
+	}{
+		"empty": {},
+		"key only": {
+			input:    "Environment",
+			expected: map[string]string{"Environment": ""},
+		},
+		"key value": {
+			input:    "Environment=prod, Team=web",
+			expected: map[string]string{"Environment": "prod", "Team": "web"},
+		},
+		"value containing equals sign": {
+			input:    "Query=a=b",
+			expected: map[string]string{"Query": "a=b"},
+		},
+		"empty key": {
+			input:    "=prod,Team",
+			expected: map[string]string{"Team": ""},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(parseTags(testCase.input), testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	t.Parallel()
+
+	results := []list.Result{
+		{DisplayName: "web", Identity: map[string]string{names.AttrID: "i-0123456789abcdef0"}, ImportID: "i-0123456789abcdef0"},
+	}
+
+	testCases := map[string]struct {
+		format   string
+		results  []list.Result
+		expected string
+	}{
+		"hcl": {
+			format:  formatHCL,
+			results: results,
+			expected: `import {
+  to = aws_instance.web
+  id = "i-0123456789abcdef0"
+}
+`,
+		},
+		"json": {
+			format:  formatJSON,
+			results: results,
+			expected: `[
+  {
+    "display_name": "web",
+    "identity": {
+      "id": "i-0123456789abcdef0"
+    },
+    "import_id": "i-0123456789abcdef0"
+  }
+]
+`,
+		},
+		"json empty": {
+			format:   formatJSON,
+			expected: "[]\n",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var sb strings.Builder
+			if err := write(&sb, testCase.format, "aws_instance", testCase.results); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := sb.String(), testCase.expected; got != want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
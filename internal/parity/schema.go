@@ -0,0 +1,403 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package parity checks that a resource migrated from the Terraform Plugin SDK V2 to the Terraform Plugin Framework
+// is compatible with the original implementation's schema and state.
+package parity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Difference is an incompatibility between the Plugin SDK V2 and Plugin Framework implementations of a resource.
+type Difference struct {
+	Path   string // Attribute or block path, empty for the whole resource.
+	Detail string
+}
+
+func (d Difference) String() string {
+	if d.Path == "" {
+		return d.Detail
+	}
+
+	return fmt.Sprintf("%s: %s", d.Path, d.Detail)
+}
+
+// CompareSchemas compares the schema of a Plugin SDK V2 resource with the schema of its Plugin Framework replacement.
+// Attribute types, Optional, Required, Computed and Sensitive flags, block nesting modes and attribute defaults are compared.
+// A nil result means that the schemas are compatible.
+func CompareSchemas(ctx context.Context, sdkResource *schema.Resource, fwResource resource.Resource) ([]Difference, error) {
+	sdkSchema, err := sdkV2ProtoSchema(ctx, sdkResource)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Plugin SDK V2 schema: %w", err)
+	}
+
+	fwSchema, err := frameworkProtoSchema(ctx, fwResource)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Plugin Framework schema: %w", err)
+	}
+
+	var diffs []Difference
+
+	if sdkSchema.Version > fwSchema.Version {
+		diffs = append(diffs, Difference{Detail: fmt.Sprintf("schema version: Plugin SDK V2 %d, Plugin Framework %d", sdkSchema.Version, fwSchema.Version)})
+	}
+
+	var response resource.SchemaResponse
+	fwResource.Schema(ctx, resource.SchemaRequest{}, &response)
+
+	if response.Diagnostics.HasError() {
+		return nil, fmt.Errorf("reading Plugin Framework schema: %v", response.Diagnostics)
+	}
+
+	sdkDefaults := sdkV2Defaults(nil, sdkResource.SchemaMap())
+	c := &comparer{
+		sdkDefaults: sdkDefaults,
+	}
+	// The Plugin SDK V2 adds an Optional+Computed "id" attribute if one isn't defined.
+	_, c.explicitID = sdkResource.SchemaMap()[names.AttrID]
+
+	diffs = append(diffs, c.compareBlocks(nil, sdkSchema.Block, fwSchema.Block)...)
+	diffs = append(diffs, compareDefaults(sdkDefaults, frameworkDefaults(ctx, nil, response.Schema.Attributes, response.Schema.Blocks))...)
+
+	slices.SortStableFunc(diffs, func(a, b Difference) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return diffs, nil
+}
+
+type comparer struct {
+	explicitID  bool           // Whether the Plugin SDK V2 resource defines an "id" attribute.
+	sdkDefaults map[string]any // Plugin SDK V2 attribute defaults, keyed by path.
+}
+
+func (c *comparer) compareBlocks(path []string, sdk, fw *tfprotov5.SchemaBlock) []Difference {
+	var diffs []Difference
+
+	sdkAttributes := make(map[string]*tfprotov5.SchemaAttribute)
+	for _, v := range sdk.Attributes {
+		sdkAttributes[v.Name] = v
+	}
+	fwAttributes := make(map[string]*tfprotov5.SchemaAttribute)
+	for _, v := range fw.Attributes {
+		fwAttributes[v.Name] = v
+	}
+
+	for _, name := range sortedKeys(sdkAttributes, fwAttributes) {
+		attributePath := pathString(path, name)
+		sdkAttribute, fwAttribute := sdkAttributes[name], fwAttributes[name]
+
+		switch {
+		case fwAttribute == nil:
+			diffs = append(diffs, Difference{Path: attributePath, Detail: "attribute not in Plugin Framework schema"})
+			continue
+		case sdkAttribute == nil:
+			diffs = append(diffs, Difference{Path: attributePath, Detail: "attribute not in Plugin SDK V2 schema"})
+			continue
+		}
+
+		if !sdkAttribute.Type.Equal(fwAttribute.Type) {
+			diffs = append(diffs, Difference{Path: attributePath, Detail: fmt.Sprintf("type: Plugin SDK V2 %s, Plugin Framework %s", sdkAttribute.Type, fwAttribute.Type)})
+		}
+
+		if len(path) == 0 && name == names.AttrID && !c.explicitID {
+			continue
+		}
+
+		// Plugin Framework attributes with a default value must be Computed.
+		_, hasDefault := c.sdkDefaults[attributePath]
+
+		for _, v := range []struct {
+			property string
+			sdk, fw  bool
+		}{
+			{"Optional", sdkAttribute.Optional, fwAttribute.Optional},
+			{"Required", sdkAttribute.Required, fwAttribute.Required},
+			{"Computed", sdkAttribute.Computed || hasDefault, fwAttribute.Computed},
+			{"Sensitive", sdkAttribute.Sensitive, fwAttribute.Sensitive},
+		} {
+			if v.sdk != v.fw {
+				diffs = append(diffs, Difference{Path: attributePath, Detail: fmt.Sprintf("%s: Plugin SDK V2 %t, Plugin Framework %t", v.property, v.sdk, v.fw)})
+			}
+		}
+	}
+
+	sdkBlocks := make(map[string]*tfprotov5.SchemaNestedBlock)
+	for _, v := range sdk.BlockTypes {
+		sdkBlocks[v.TypeName] = v
+	}
+	fwBlocks := make(map[string]*tfprotov5.SchemaNestedBlock)
+	for _, v := range fw.BlockTypes {
+		fwBlocks[v.TypeName] = v
+	}
+
+	for _, name := range sortedKeys(sdkBlocks, fwBlocks) {
+		sdkBlock, fwBlock := sdkBlocks[name], fwBlocks[name]
+
+		switch {
+		case fwBlock == nil:
+			diffs = append(diffs, Difference{Path: pathString(path, name), Detail: "block not in Plugin Framework schema"})
+			continue
+		case sdkBlock == nil:
+			diffs = append(diffs, Difference{Path: pathString(path, name), Detail: "block not in Plugin SDK V2 schema"})
+			continue
+		}
+
+		// Block MinItems and MaxItems are implemented by validators in the Plugin Framework.
+		if sdkBlock.Nesting != fwBlock.Nesting {
+			diffs = append(diffs, Difference{Path: pathString(path, name), Detail: fmt.Sprintf("nesting mode: Plugin SDK V2 %s, Plugin Framework %s", sdkBlock.Nesting, fwBlock.Nesting)})
+		}
+
+		diffs = append(diffs, c.compareBlocks(append(path, name), sdkBlock.Block, fwBlock.Block)...)
+	}
+
+	return diffs
+}
+
+func compareDefaults(sdk, fw map[string]any) []Difference {
+	var diffs []Difference
+
+	for _, path := range sortedKeys(sdk, fw) {
+		sdkDefault, sdkOK := sdk[path]
+		fwDefault, fwOK := fw[path]
+
+		switch {
+		case !fwOK:
+			diffs = append(diffs, Difference{Path: path, Detail: fmt.Sprintf("default: Plugin SDK V2 %#v, Plugin Framework none", sdkDefault)})
+		case !sdkOK:
+			diffs = append(diffs, Difference{Path: path, Detail: fmt.Sprintf("default: Plugin SDK V2 none, Plugin Framework %#v", fwDefault)})
+		case sdkDefault != fwDefault:
+			diffs = append(diffs, Difference{Path: path, Detail: fmt.Sprintf("default: Plugin SDK V2 %#v, Plugin Framework %#v", sdkDefault, fwDefault)})
+		}
+	}
+
+	return diffs
+}
+
+// sdkV2Defaults returns the default values of Plugin SDK V2 attributes, keyed by path.
+func sdkV2Defaults(path []string, s map[string]*schema.Schema) map[string]any {
+	values := make(map[string]any)
+
+	for name, v := range s {
+		if elem, ok := v.Elem.(*schema.Resource); ok {
+			maps.Copy(values, sdkV2Defaults(append(path, name), elem.SchemaMap()))
+			continue
+		}
+
+		switch v := v.Default.(type) {
+		case nil:
+		case int:
+			values[pathString(path, name)] = int64(v)
+		default:
+			values[pathString(path, name)] = v
+		}
+	}
+
+	return values
+}
+
+// frameworkDefaults returns the default values of Plugin Framework attributes, keyed by path.
+func frameworkDefaults(ctx context.Context, path []string, attributes map[string]fwschema.Attribute, blocks map[string]fwschema.Block) map[string]any {
+	values := make(map[string]any)
+
+	for name, v := range attributes {
+		if v, ok := frameworkDefault(ctx, v); ok {
+			values[pathString(path, name)] = v
+		}
+	}
+
+	for name, v := range blocks {
+		var nestedAttributes map[string]fwschema.Attribute
+		var nestedBlocks map[string]fwschema.Block
+
+		switch v := v.(type) {
+		case fwschema.ListNestedBlock:
+			nestedAttributes, nestedBlocks = v.NestedObject.Attributes, v.NestedObject.Blocks
+		case fwschema.SetNestedBlock:
+			nestedAttributes, nestedBlocks = v.NestedObject.Attributes, v.NestedObject.Blocks
+		case fwschema.SingleNestedBlock:
+			nestedAttributes, nestedBlocks = v.Attributes, v.Blocks
+		}
+
+		maps.Copy(values, frameworkDefaults(ctx, append(path, name), nestedAttributes, nestedBlocks))
+	}
+
+	return values
+}
+
+// frameworkDefault returns the default value of a Plugin Framework primitive attribute.
+func frameworkDefault(ctx context.Context, attribute fwschema.Attribute) (any, bool) {
+	switch v := attribute.(type) {
+	case fwschema.BoolAttribute:
+		if v.Default == nil {
+			return nil, false
+		}
+		var response defaults.BoolResponse
+		v.Default.DefaultBool(ctx, defaults.BoolRequest{}, &response)
+		return primitiveValue(response.PlanValue)
+
+	case fwschema.Float64Attribute:
+		if v.Default == nil {
+			return nil, false
+		}
+		var response defaults.Float64Response
+		v.Default.DefaultFloat64(ctx, defaults.Float64Request{}, &response)
+		return primitiveValue(response.PlanValue)
+
+	case fwschema.Int64Attribute:
+		if v.Default == nil {
+			return nil, false
+		}
+		var response defaults.Int64Response
+		v.Default.DefaultInt64(ctx, defaults.Int64Request{}, &response)
+		return primitiveValue(response.PlanValue)
+
+	case fwschema.StringAttribute:
+		if v.Default == nil {
+			return nil, false
+		}
+		var response defaults.StringResponse
+		v.Default.DefaultString(ctx, defaults.StringRequest{}, &response)
+		return primitiveValue(response.PlanValue)
+	}
+
+	return nil, false
+}
+
+func primitiveValue(v interface {
+	IsNull() bool
+	IsUnknown() bool
+}) (any, bool) {
+	if v.IsNull() || v.IsUnknown() {
+		return nil, false
+	}
+
+	switch v := v.(type) {
+	case types.Bool:
+		return v.ValueBool(), true
+	case types.Float64:
+		return v.ValueFloat64(), true
+	case types.Int64:
+		return v.ValueInt64(), true
+	case types.String:
+		return v.ValueString(), true
+	}
+
+	return nil, false
+}
+
+// sdkV2ProtoSchema returns the protocol version 5 schema of a Plugin SDK V2 resource.
+func sdkV2ProtoSchema(ctx context.Context, r *schema.Resource) (*tfprotov5.Schema, error) {
+	const typeName = "aws_parity"
+	server := schema.NewGRPCProviderServer(&schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			typeName: r,
+		},
+	})
+
+	return resourceSchema(ctx, server, typeName)
+}
+
+// frameworkProtoSchema returns the protocol version 5 schema of a Plugin Framework resource.
+func frameworkProtoSchema(ctx context.Context, r resource.Resource) (*tfprotov5.Schema, error) {
+	var response resource.MetadataResponse
+	r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "aws"}, &response)
+
+	return resourceSchema(ctx, NewProtoV5ProviderServer(r), response.TypeName)
+}
+
+func resourceSchema(ctx context.Context, server tfprotov5.ProviderServer, typeName string) (*tfprotov5.Schema, error) {
+	response, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := diagnosticsError(response.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	s, ok := response.ResourceSchemas[typeName]
+
+	if !ok {
+		return nil, fmt.Errorf("resource type %s not found", typeName)
+	}
+
+	return s, nil
+}
+
+// resourceProvider is a minimal Plugin Framework provider serving a single resource.
+type resourceProvider struct {
+	resource resource.Resource
+}
+
+func (*resourceProvider) Metadata(_ context.Context, request provider.MetadataRequest, response *provider.MetadataResponse) {
+	response.TypeName = "aws"
+}
+
+func (*resourceProvider) Schema(context.Context, provider.SchemaRequest, *provider.SchemaResponse) {}
+
+func (*resourceProvider) Configure(context.Context, provider.ConfigureRequest, *provider.ConfigureResponse) {
+}
+
+func (*resourceProvider) DataSources(context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *resourceProvider) Resources(context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		func() resource.Resource {
+			return p.resource
+		},
+	}
+}
+
+func diagnosticsError(diags []*tfprotov5.Diagnostic) error {
+	var errs []string
+
+	for _, v := range diags {
+		if v.Severity == tfprotov5.DiagnosticSeverityError {
+			errs = append(errs, fmt.Sprintf("%s: %s", v.Summary, v.Detail))
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(errs, "; "))
+}
+
+func pathString(path []string, name string) string {
+	return strings.Join(append(slices.Clone(path), name), ".")
+}
+
+func sortedKeys[V any](m1, m2 map[string]V) []string {
+	keys := slices.Collect(maps.Keys(m1))
+	for k := range m2 {
+		if _, ok := m1[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	return keys
+}
@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parity_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/parity"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestCompareSchemas(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sdkResource := &sdkschema.Resource{
+		SchemaVersion: 1,
+		Schema: map[string]*sdkschema.Schema{
+			names.AttrName: {
+				Type:     sdkschema.TypeString,
+				Required: true,
+			},
+			names.AttrMode: {
+				Type:     sdkschema.TypeString,
+				Optional: true,
+				Default:  "standard",
+			},
+			"secret": {
+				Type:      sdkschema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"setting": {
+				Type:     sdkschema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &sdkschema.Resource{
+					Schema: map[string]*sdkschema.Schema{
+						names.AttrValue: {
+							Type:     sdkschema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		schema        schema.Schema
+		expectedDiffs []string
+	}{
+		"compatible": {
+			schema: testResourceSchema(2),
+		},
+		"incompatible": {
+			schema: schema.Schema{
+				Version: 0,
+				Attributes: map[string]schema.Attribute{
+					names.AttrID: schema.StringAttribute{
+						Computed: true,
+					},
+					names.AttrName: schema.StringAttribute{
+						Optional: true,
+					},
+					names.AttrMode: schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString("advanced"),
+					},
+					"secret": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"setting": schema.SetNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								names.AttrValue: schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedDiffs: []string{
+				"schema version: Plugin SDK V2 1, Plugin Framework 0",
+				`mode: default: Plugin SDK V2 "standard", Plugin Framework "advanced"`,
+				"name: Optional: Plugin SDK V2 false, Plugin Framework true",
+				"name: Required: Plugin SDK V2 true, Plugin Framework false",
+				"secret: Sensitive: Plugin SDK V2 true, Plugin Framework false",
+				"setting: nesting mode: Plugin SDK V2 LIST, Plugin Framework SET",
+				"setting.value: type: Plugin SDK V2 tftypes.Number, Plugin Framework tftypes.String",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diffs, err := parity.CompareSchemas(ctx, sdkResource, &testResource{schema: testCase.schema})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got []string
+			for _, v := range diffs {
+				got = append(got, v.String())
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedDiffs); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func testResourceSchema(version int64) schema.Schema {
+	return schema.Schema{
+		Version: version,
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrMode: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("standard"),
+			},
+			"secret": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"setting": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrValue: schema.Int64Attribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type testResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Mode    types.String `tfsdk:"mode"`
+	Name    types.String `tfsdk:"name"`
+	Secret  types.String `tfsdk:"secret"`
+	Setting types.List   `tfsdk:"setting"`
+}
+
+// testResource is a minimal Plugin Framework resource.
+type testResource struct {
+	schema   schema.Schema
+	upgrader func(context.Context, resource.UpgradeStateRequest, *resource.UpgradeStateResponse) // Upgrades state from schema version 1.
+	read     func(*testResourceModel)
+}
+
+func (*testResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_parity_test"
+}
+
+func (r *testResource) Schema(_ context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = r.schema
+}
+
+func (*testResource) Create(context.Context, resource.CreateRequest, *resource.CreateResponse) {}
+
+func (r *testResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data testResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if r.read != nil {
+		r.read(&data)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (*testResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {}
+
+func (*testResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {}
+
+func (r *testResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	if r.upgrader == nil {
+		return nil
+	}
+
+	schemaV1 := testResourceSchema(1)
+
+	return map[int64]resource.StateUpgrader{
+		1: {
+			PriorSchema:   &schemaV1,
+			StateUpgrader: r.upgrader,
+		},
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// State is a recorded Plugin SDK V2 resource instance state.
+type State struct {
+	// Attributes is the JSON-encoded state, as in the "attributes" of a resource instance in a Terraform state file.
+	Attributes string
+	// SchemaVersion is the Plugin SDK V2 schema version the state was recorded with.
+	SchemaVersion int64
+}
+
+// ReplayState upgrades a recorded Plugin SDK V2 state with the state upgraders of the specified resource type
+// and then refreshes the upgraded state with the resource's Read method.
+// Differences are returned for recorded attributes that are not in the current schema,
+// for attributes whose upgraded value differs from the recorded value and for attributes whose value changes on Read.
+// Differences between null and zero values, which the Plugin SDK V2 doesn't distinguish, are ignored.
+// The provider server must have been configured (see ConfigureProvider) if the resource's Read method makes AWS API calls.
+func ReplayState(ctx context.Context, server tfprotov5.ProviderServer, typeName string, state State) ([]Difference, error) {
+	s, err := resourceSchema(ctx, server, typeName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	typ := s.ValueType()
+	diffs, err := undefinedAttributes(state, typ)
+
+	if err != nil {
+		return nil, err
+	}
+
+	recorded, err := (tfprotov5.RawState{JSON: []byte(state.Attributes)}).UnmarshalWithOpts(typ, tfprotov5.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("decoding recorded state: %w", err)
+	}
+
+	upgradeResponse, err := server.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: typeName,
+		Version:  state.SchemaVersion,
+		RawState: &tfprotov5.RawState{JSON: []byte(state.Attributes)},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("upgrading state: %w", err)
+	}
+
+	if err := diagnosticsError(upgradeResponse.Diagnostics); err != nil {
+		return nil, fmt.Errorf("upgrading state: %w", err)
+	}
+
+	upgraded, err := upgradeResponse.UpgradedState.Unmarshal(typ)
+
+	if err != nil {
+		return nil, fmt.Errorf("decoding upgraded state: %w", err)
+	}
+
+	v, err := compareValues(recorded, upgraded, "recorded", "upgraded")
+
+	if err != nil {
+		return nil, err
+	}
+
+	diffs = append(diffs, v...)
+
+	readResponse, err := server.ReadResource(ctx, &tfprotov5.ReadResourceRequest{
+		TypeName:     typeName,
+		CurrentState: upgradeResponse.UpgradedState,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading resource: %w", err)
+	}
+
+	if err := diagnosticsError(readResponse.Diagnostics); err != nil {
+		return nil, fmt.Errorf("reading resource: %w", err)
+	}
+
+	var read tftypes.Value
+	if readResponse.NewState != nil {
+		if read, err = readResponse.NewState.Unmarshal(typ); err != nil {
+			return nil, fmt.Errorf("decoding refreshed state: %w", err)
+		}
+	}
+
+	if readResponse.NewState == nil || read.IsNull() {
+		return append(diffs, Difference{Detail: "resource removed from state on Read"}), nil
+	}
+
+	v, err = compareValues(upgraded, read, "upgraded", "refreshed")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append(diffs, v...), nil
+}
+
+// NewProtoV5ProviderServer returns a protocol version 5 provider server serving only the specified Plugin Framework resource.
+// The resource is not configured, so its methods must not make AWS API calls.
+func NewProtoV5ProviderServer(r resource.Resource) tfprotov5.ProviderServer {
+	return providerserver.NewProtocol5(&resourceProvider{resource: r})()
+}
+
+// ConfigureProvider configures a provider server with an empty provider configuration.
+func ConfigureProvider(ctx context.Context, server tfprotov5.ProviderServer) error {
+	response, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		return err
+	}
+
+	if err := diagnosticsError(response.Diagnostics); err != nil {
+		return err
+	}
+
+	typ := response.Provider.ValueType().(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for k, v := range typ.AttributeTypes {
+		attributes[k] = tftypes.NewValue(v, nil)
+	}
+
+	config, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, attributes))
+
+	if err != nil {
+		return err
+	}
+
+	configureResponse, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{
+		Config: &config,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return diagnosticsError(configureResponse.Diagnostics)
+}
+
+// undefinedAttributes returns differences for top-level recorded attributes that are not in the specified state type.
+func undefinedAttributes(state State, typ tftypes.Type) ([]Difference, error) {
+	var attributes map[string]json.RawMessage
+
+	if err := json.Unmarshal([]byte(state.Attributes), &attributes); err != nil {
+		return nil, fmt.Errorf("decoding recorded state: %w", err)
+	}
+
+	var diffs []Difference
+	attributeTypes := typ.(tftypes.Object).AttributeTypes
+
+	for _, k := range sortedKeys(attributes, nil) {
+		if _, ok := attributeTypes[k]; !ok {
+			diffs = append(diffs, Difference{Path: k, Detail: "recorded attribute not in current schema"})
+		}
+	}
+
+	return diffs, nil
+}
+
+// compareValues returns the differences between two values of the same type, ignoring differences between null and zero values.
+// Only the innermost difference is returned for nested values.
+func compareValues(v1, v2 tftypes.Value, name1, name2 string) ([]Difference, error) {
+	v1, err := normalize(v1)
+
+	if err != nil {
+		return nil, err
+	}
+
+	v2, err = normalize(v2)
+
+	if err != nil {
+		return nil, err
+	}
+
+	valueDiffs, err := v1.Diff(v2)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []Difference
+	for _, v := range valueDiffs {
+		// Skip differences that contain another difference.
+		if slices.ContainsFunc(valueDiffs, func(o tftypes.ValueDiff) bool {
+			n := len(v.Path.Steps())
+			return len(o.Path.Steps()) > n && tftypes.NewAttributePathWithSteps(o.Path.Steps()[:n]).Equal(v.Path)
+		}) {
+			continue
+		}
+
+		diffs = append(diffs, Difference{Path: pathFromAttributePath(v.Path), Detail: fmt.Sprintf("%s %s, %s %s", name1, valueString(v.Value1), name2, valueString(v.Value2))})
+	}
+
+	slices.SortStableFunc(diffs, func(a, b Difference) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return diffs, nil
+}
+
+// normalize replaces zero values with null values.
+func normalize(v tftypes.Value) (tftypes.Value, error) {
+	return tftypes.Transform(v, func(_ *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if isZero(v) {
+			return tftypes.NewValue(v.Type(), nil), nil
+		}
+
+		return v, nil
+	})
+}
+
+func isZero(v tftypes.Value) bool {
+	if !v.IsKnown() || v.IsNull() {
+		return false
+	}
+
+	switch typ := v.Type(); {
+	case typ.Is(tftypes.Bool):
+		var b bool
+		return v.As(&b) == nil && !b
+	case typ.Is(tftypes.Number):
+		var f big.Float
+		return v.As(&f) == nil && f.Sign() == 0
+	case typ.Is(tftypes.String):
+		var s string
+		return v.As(&s) == nil && s == ""
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var l []tftypes.Value
+		return v.As(&l) == nil && len(l) == 0
+	case typ.Is(tftypes.Map{}):
+		var m map[string]tftypes.Value
+		return v.As(&m) == nil && len(m) == 0
+	}
+
+	return false
+}
+
+func pathFromAttributePath(path *tftypes.AttributePath) string {
+	var sb strings.Builder
+
+	for _, step := range path.Steps() {
+		switch step := step.(type) {
+		case tftypes.AttributeName:
+			if sb.Len() > 0 {
+				sb.WriteString(".")
+			}
+			sb.WriteString(string(step))
+		case tftypes.ElementKeyInt:
+			fmt.Fprintf(&sb, "[%d]", step)
+		case tftypes.ElementKeyString:
+			fmt.Fprintf(&sb, "[%s]", strconv.Quote(string(step)))
+		case tftypes.ElementKeyValue:
+			fmt.Fprintf(&sb, "[%s]", tftypes.Value(step))
+		}
+	}
+
+	return sb.String()
+}
+
+func valueString(v *tftypes.Value) string {
+	if v == nil || v.IsNull() {
+		return "null"
+	}
+
+	return v.String()
+}
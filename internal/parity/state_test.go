@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parity_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/parity"
+)
+
+func TestReplayState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	copyState := func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+		var data testResourceModel
+		response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+	}
+
+	testCases := map[string]struct {
+		attributes    string
+		upgrader      func(context.Context, resource.UpgradeStateRequest, *resource.UpgradeStateResponse)
+		read          func(*testResourceModel)
+		expectedDiffs []string
+		expectedError bool
+	}{
+		"compatible": {
+			attributes: `{"id":"test-id","mode":"standard","name":"test","secret":"","setting":[]}`,
+			upgrader:   copyState,
+		},
+		"null to zero value": {
+			attributes: `{"id":"test-id","mode":"standard","name":"test","secret":"","setting":[]}`,
+			upgrader:   copyState,
+			read: func(data *testResourceModel) {
+				data.Secret = types.StringNull()
+			},
+		},
+		"incompatible": {
+			attributes: `{"id":"test-id","legacy":"old","mode":"standard","name":"test","secret":"s3cr3t","setting":[{"value":1}]}`,
+			upgrader: func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+				var data testResourceModel
+				response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+				if response.Diagnostics.HasError() {
+					return
+				}
+
+				data.Mode = types.StringNull()
+
+				response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+			},
+			read: func(data *testResourceModel) {
+				data.Name = types.StringValue("renamed")
+			},
+			expectedDiffs: []string{
+				"legacy: recorded attribute not in current schema",
+				`mode: recorded tftypes.String<"standard">, upgraded null`,
+				`name: upgraded tftypes.String<"test">, refreshed tftypes.String<"renamed">`,
+			},
+		},
+		"no state upgrader": {
+			attributes:    `{"id":"test-id","mode":"standard","name":"test","secret":"","setting":[]}`,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := parity.NewProtoV5ProviderServer(&testResource{
+				schema:   testResourceSchema(2),
+				upgrader: testCase.upgrader,
+				read:     testCase.read,
+			})
+			diffs, err := parity.ReplayState(ctx, server, "aws_parity_test", parity.State{
+				Attributes:    testCase.attributes,
+				SchemaVersion: 1,
+			})
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("ReplayState() err %t, want %t: %v", got, want, err)
+			}
+
+			var got []string
+			for _, v := range diffs {
+				got = append(got, v.String())
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedDiffs); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// Preflight checks are read-only checks of a resource's planned values against live account state.
+// They are run during planning when enabled in the provider configuration and surface predictable
+// apply-time failures, e.g. a KMS key that is pending deletion, as plan-time warnings or errors.
+
+// CheckFunc is the signature of a preflight check.
+// Diagnostics returned with error severity fail the plan.
+// A returned error indicates that the check could not be completed, e.g. due to missing IAM permissions,
+// and is reported as a warning.
+type CheckFunc func(context.Context, *conns.AWSClient, Request) (diag.Diagnostics, error)
+
+// Check is a preflight check for a single resource type.
+type Check struct {
+	// Name identifies the check in the provider's `preflight` configuration block, e.g. `kms_key_state`.
+	Name string
+	// TypeName is the resource type that the check applies to, e.g. `aws_sns_topic`.
+	TypeName string
+	Func     CheckFunc
+}
+
+// Request contains the planned values of the resource being checked.
+type Request struct {
+	TypeName string
+	// IsCreate is true if the resource is being created.
+	IsCreate bool
+	Plan     Values
+}
+
+// Values provides access to a resource's planned top-level attribute values.
+type Values interface {
+	// GetInt64 returns the planned value of the specified integer attribute and whether the value is known and not null.
+	GetInt64(name string) (int64, bool)
+	// GetString returns the planned value of the specified string attribute and whether the value is known and not null.
+	GetString(name string) (string, bool)
+	// HasChange returns whether the resource is being created or the specified attribute's value is changing.
+	HasChange(name string) bool
+}
+
+// ServicePackageWithChecks is implemented by service packages that contribute preflight checks.
+type ServicePackageWithChecks interface {
+	PreflightChecks(context.Context) []*Check
+}
+
+// Registry holds preflight checks by resource type.
+type Registry struct {
+	checks map[string][]*Check
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		checks: make(map[string][]*Check),
+	}
+}
+
+// NewRegistryFromServicePackages returns a registry containing the preflight checks contributed by the specified service packages.
+func NewRegistryFromServicePackages(ctx context.Context, servicePackages []conns.ServicePackage) (*Registry, error) {
+	registry := NewRegistry()
+
+	// Register in a stable order so that checks are run deterministically.
+	servicePackages = slices.SortedFunc(slices.Values(servicePackages), func(a, b conns.ServicePackage) int {
+		return strings.Compare(a.ServicePackageName(), b.ServicePackageName())
+	})
+	for _, sp := range servicePackages {
+		if v, ok := sp.(ServicePackageWithChecks); ok {
+			if err := registry.Register(v.PreflightChecks(ctx)...); err != nil {
+				return nil, fmt.Errorf("registering %s preflight checks: %w", sp.ServicePackageName(), err)
+			}
+		}
+	}
+
+	return registry, nil
+}
+
+// Register adds the specified checks to the registry.
+func (r *Registry) Register(checks ...*Check) error {
+	for _, check := range checks {
+		if check.Name == "" || check.TypeName == "" || check.Func == nil {
+			return fmt.Errorf("invalid preflight check: %+v", check)
+		}
+
+		if slices.ContainsFunc(r.checks[check.TypeName], func(v *Check) bool {
+			return v.Name == check.Name
+		}) {
+			return fmt.Errorf("duplicate preflight check %q for %s", check.Name, check.TypeName)
+		}
+
+		r.checks[check.TypeName] = append(r.checks[check.TypeName], check)
+	}
+
+	return nil
+}
+
+// Checks returns the checks registered for the specified resource type.
+func (r *Registry) Checks(typeName string) []*Check {
+	if r == nil {
+		return nil
+	}
+
+	return r.checks[typeName]
+}
+
+// Run runs the specified checks that are enabled in the provider configuration.
+func Run(ctx context.Context, meta *conns.AWSClient, checks []*Check, request Request) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if meta == nil {
+		return diags
+	}
+
+	return run(ctx, meta, meta.PreflightConfig(ctx), checks, request)
+}
+
+func run(ctx context.Context, meta *conns.AWSClient, config *conns.PreflightConfig, checks []*Check, request Request) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, check := range checks {
+		if !config.IsCheckEnabled(check.Name) {
+			continue
+		}
+
+		tflog.Debug(ctx, "Running preflight check", map[string]any{
+			"check":     check.Name,
+			"type_name": request.TypeName,
+		})
+
+		d, err := check.Func(ctx, meta, request)
+		diags.Append(d...)
+
+		if err != nil {
+			diags.AddWarning(
+				fmt.Sprintf("Preflight check %q not completed", check.Name),
+				fmt.Sprintf("The %s preflight check could not be completed: %s", check.Name, err),
+			)
+		}
+	}
+
+	return diags
+}
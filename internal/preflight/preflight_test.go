@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package preflight
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func testCheck(name, typeName string, f CheckFunc) *Check {
+	return &Check{
+		Name:     name,
+		TypeName: typeName,
+		Func:     f,
+	}
+}
+
+func TestRegistryRegister(t *testing.T) {
+	t.Parallel()
+
+	noop := func(context.Context, *conns.AWSClient, Request) (diag.Diagnostics, error) {
+		return nil, nil
+	}
+
+	testCases := map[string]struct {
+		checks      []*Check
+		expectError bool
+		expected    map[string][]string
+	}{
+		"empty": {
+			expected: map[string][]string{},
+		},
+		"multiple types": {
+			checks: []*Check{
+				testCheck("check1", "aws_test1", noop),
+				testCheck("check2", "aws_test1", noop),
+				testCheck("check1", "aws_test2", noop),
+			},
+			expected: map[string][]string{
+				"aws_test1": {"check1", "check2"},
+				"aws_test2": {"check1"},
+			},
+		},
+		"duplicate": {
+			checks: []*Check{
+				testCheck("check1", "aws_test1", noop),
+				testCheck("check1", "aws_test1", noop),
+			},
+			expectError: true,
+		},
+		"no name": {
+			checks: []*Check{
+				testCheck("", "aws_test1", noop),
+			},
+			expectError: true,
+		},
+		"no func": {
+			checks: []*Check{
+				testCheck("check1", "aws_test1", nil),
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			registry := NewRegistry()
+			err := registry.Register(testCase.checks...)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("Register error = %v, expectError = %t", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			got := make(map[string][]string)
+			for typeName, checks := range registry.checks {
+				for _, check := range checks {
+					got[typeName] = append(got[typeName], check.Name)
+				}
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	checks := []*Check{
+		testCheck("error", "aws_test", func(context.Context, *conns.AWSClient, Request) (diag.Diagnostics, error) {
+			var diags diag.Diagnostics
+			diags.AddError("check failed", "")
+			return diags, nil
+		}),
+		testCheck("incomplete", "aws_test", func(context.Context, *conns.AWSClient, Request) (diag.Diagnostics, error) {
+			return nil, errors.New("AccessDenied")
+		}),
+	}
+
+	testCases := map[string]struct {
+		config           *conns.PreflightConfig
+		expectedErrors   int
+		expectedWarnings int
+	}{
+		"disabled": {},
+		"enabled": {
+			config:           &conns.PreflightConfig{},
+			expectedErrors:   1,
+			expectedWarnings: 1,
+		},
+		"skip checks": {
+			config: &conns.PreflightConfig{
+				SkipChecks: []string{"error"},
+			},
+			expectedWarnings: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := run(ctx, new(conns.AWSClient), testCase.config, checks, Request{TypeName: "aws_test"})
+
+			if got, want := diags.ErrorsCount(), testCase.expectedErrors; got != want {
+				t.Errorf("errors = %d, want %d", got, want)
+			}
+			if got, want := diags.WarningsCount(), testCase.expectedWarnings; got != want {
+				t.Errorf("warnings = %d, want %d", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package preflight
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceDiffValues provides access to a Plugin SDK v2 resource's planned values.
+type resourceDiffValues struct {
+	d *schema.ResourceDiff
+}
+
+// NewResourceDiffValues returns the planned values of a Plugin SDK v2 resource.
+func NewResourceDiffValues(d *schema.ResourceDiff) Values {
+	return resourceDiffValues{d: d}
+}
+
+func (v resourceDiffValues) GetInt64(name string) (int64, bool) {
+	if !v.d.NewValueKnown(name) {
+		return 0, false
+	}
+
+	// GetOk cannot distinguish a zero value from a null value.
+	n, ok := v.d.Get(name).(int)
+	if !ok {
+		return 0, false
+	}
+
+	return int64(n), true
+}
+
+func (v resourceDiffValues) GetString(name string) (string, bool) {
+	if !v.d.NewValueKnown(name) {
+		return "", false
+	}
+
+	s, ok := v.d.GetOk(name)
+	if !ok {
+		return "", false
+	}
+
+	s2, ok := s.(string)
+
+	return s2, ok
+}
+
+func (v resourceDiffValues) HasChange(name string) bool {
+	return v.d.Id() == "" || v.d.HasChange(name)
+}
+
+// planValues provides access to a Plugin Framework resource's planned values.
+type planValues struct {
+	ctx   context.Context
+	plan  tfsdk.Plan
+	state tfsdk.State
+}
+
+// NewPlanValues returns the planned values of a Plugin Framework resource.
+func NewPlanValues(ctx context.Context, plan tfsdk.Plan, state tfsdk.State) Values {
+	return planValues{
+		ctx:   ctx,
+		plan:  plan,
+		state: state,
+	}
+}
+
+func (v planValues) GetInt64(name string) (int64, bool) {
+	var n types.Int64
+	if diags := v.plan.GetAttribute(v.ctx, path.Root(name), &n); diags.HasError() {
+		return 0, false
+	}
+
+	if n.IsNull() || n.IsUnknown() {
+		return 0, false
+	}
+
+	return n.ValueInt64(), true
+}
+
+func (v planValues) GetString(name string) (string, bool) {
+	var s types.String
+	if diags := v.plan.GetAttribute(v.ctx, path.Root(name), &s); diags.HasError() {
+		return "", false
+	}
+
+	if s.IsNull() || s.IsUnknown() {
+		return "", false
+	}
+
+	return s.ValueString(), true
+}
+
+func (v planValues) HasChange(name string) bool {
+	if v.state.Raw.IsNull() {
+		return true
+	}
+
+	var planned, current attr.Value
+	if diags := v.plan.GetAttribute(v.ctx, path.Root(name), &planned); diags.HasError() {
+		return false
+	}
+	if diags := v.state.GetAttribute(v.ctx, path.Root(name), &current); diags.HasError() {
+		return false
+	}
+
+	return !planned.Equal(current)
+}
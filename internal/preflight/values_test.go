@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package preflight
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestPlanValues(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"key":  schema.StringAttribute{Optional: true},
+			"name": schema.StringAttribute{Optional: true},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"key":  tftypes.String,
+		"name": tftypes.String,
+	}}
+	plan := tfsdk.Plan{
+		Schema: s,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"key":  tftypes.NewValue(tftypes.String, nil),
+			"name": tftypes.NewValue(tftypes.String, "new"),
+		}),
+	}
+
+	testCases := map[string]struct {
+		state             tfsdk.State
+		expectedKeyChange bool
+	}{
+		"create": {
+			state: tfsdk.State{
+				Schema: s,
+				Raw:    tftypes.NewValue(objectType, nil),
+			},
+			expectedKeyChange: true,
+		},
+		"update": {
+			state: tfsdk.State{
+				Schema: s,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"key":  tftypes.NewValue(tftypes.String, nil),
+					"name": tftypes.NewValue(tftypes.String, "old"),
+				}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			values := NewPlanValues(ctx, plan, testCase.state)
+
+			if _, ok := values.GetString("key"); ok {
+				t.Error("GetString(key) ok = true, want false")
+			}
+			if got, ok := values.GetString("name"); !ok || got != "new" {
+				t.Errorf("GetString(name) = %q, %t, want %q, true", got, ok, "new")
+			}
+			if got, want := values.HasChange("key"), testCase.expectedKeyChange; got != want {
+				t.Errorf("HasChange(key) = %t, want %t", got, want)
+			}
+			if !values.HasChange("name") {
+				t.Error("HasChange(name) = false, want true")
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// newIdentitySchema returns the identity schema of a resource with the specified identity.
+func newIdentitySchema(identity *types.ServicePackageResourceIdentity) identityschema.Schema {
+	attributes := make(map[string]identityschema.Attribute)
+	for _, attr := range identity.Attributes() {
+		attributes[attr.Name] = identityschema.StringAttribute{
+			RequiredForImport: attr.Required,
+			OptionalForImport: !attr.Required,
+		}
+	}
+
+	return identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// identityResourceInterceptor sets a resource's identity and verifies that it does not change once the resource has been created.
+type identityResourceInterceptor struct {
+	identity *types.ServicePackageResourceIdentity
+}
+
+func (r identityResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		diags.Append(r.validateARN(ctx, response.State, meta)...)
+		diags.Append(r.setIdentity(ctx, response.State, response.Identity, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+		if response.State.Raw.IsNull() {
+			return ctx, diags
+		}
+
+		for _, name := range r.identity.AttributeNames() {
+			var old, new *string
+			if d := request.State.GetAttribute(ctx, path.Root(name), &old); d.HasError() {
+				continue
+			}
+			if d := response.State.GetAttribute(ctx, path.Root(name), &new); d.HasError() {
+				continue
+			}
+
+			if old != nil && *old != "" && (new == nil || *new != *old) {
+				var v string
+				if new != nil {
+					v = *new
+				}
+				diags.AddError("Unexpected Identity Change", fmt.Sprintf("%s changed from %q to %q", name, *old, v))
+
+				return ctx, diags
+			}
+		}
+
+		diags.Append(r.validateARN(ctx, response.State, meta)...)
+		diags.Append(r.setIdentity(ctx, response.State, response.Identity, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		diags.Append(r.validateARN(ctx, response.State, meta)...)
+		diags.Append(r.setIdentity(ctx, response.State, response.Identity, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+// validateARN checks that an ARN identity belongs to the provider's account and the resource's Region.
+func (r identityResourceInterceptor) validateARN(ctx context.Context, state tfsdk.State, meta *conns.AWSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !r.identity.IsARN || meta == nil || state.Raw.IsNull() {
+		return diags
+	}
+
+	var v *string
+	if d := state.GetAttribute(ctx, path.Root(r.identity.IdentityAttributes[0].Name), &v); d.HasError() || v == nil || *v == "" {
+		return diags
+	}
+
+	if err := conns.ValidateARNIdentity(*v, meta.AccountID(ctx), meta.Region(ctx), r.identity.IsGlobalResource); err != nil {
+		diags.AddError("Unexpected Identity Change", err.Error())
+	}
+
+	return diags
+}
+
+// setIdentity sets a resource's identity from its state.
+func (r identityResourceInterceptor) setIdentity(ctx context.Context, state tfsdk.State, identity *tfsdk.ResourceIdentity, meta *conns.AWSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if identity == nil || meta == nil || state.Raw.IsNull() {
+		return diags
+	}
+
+	if !r.identity.IsARN {
+		diags.Append(identity.SetAttribute(ctx, path.Root(names.AttrAccountID), meta.AccountID(ctx))...)
+		if !r.identity.IsGlobalResource {
+			diags.Append(identity.SetAttribute(ctx, path.Root(names.AttrRegion), meta.Region(ctx))...)
+		}
+	}
+	for _, name := range r.identity.AttributeNames() {
+		var v *string
+		diags.Append(state.GetAttribute(ctx, path.Root(name), &v)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(identity.SetAttribute(ctx, path.Root(name), v)...)
+	}
+
+	return diags
+}
+
+// importIDFromIdentity returns the import ID and any Region override of a resource imported by identity.
+func importIDFromIdentity(ctx context.Context, identity *types.ServicePackageResourceIdentity, data *tfsdk.ResourceIdentity, meta *conns.AWSClient) (string, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := make(map[string]string)
+	for _, attr := range identity.Attributes() {
+		var v *string
+		diags.Append(data.GetAttribute(ctx, path.Root(attr.Name), &v)...)
+		if diags.HasError() {
+			return "", "", diags
+		}
+
+		if v != nil {
+			values[attr.Name] = *v
+		}
+	}
+
+	id, region, err := conns.ImportIDFromIdentity(identity, values, meta.AccountID(ctx))
+	if err != nil {
+		diags.AddError("importing resource", err.Error())
+	}
+
+	return id, region, diags
+}
+
+// importIDARNRegion returns the Region of an ARN import ID, if any.
+func importIDARNRegion(identity *types.ServicePackageResourceIdentity, id string) (string, bool) {
+	if identity == nil || !identity.IsARN || identity.IsGlobalResource {
+		return "", false
+	}
+
+	arn, err := arn.Parse(id)
+	if err != nil || arn.Region == "" {
+		return "", false
+	}
+
+	return arn.Region, true
+}
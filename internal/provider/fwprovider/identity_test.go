@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestImportIDARNRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		identity       *types.ServicePackageResourceIdentity
+		id             string
+		expectedRegion string
+		expectedOK     bool
+	}{
+		"no identity": {
+			id: "arn:aws:sns:eu-west-1:123456789012:topic-1", //lintignore:AWSAT003,AWSAT005
+		},
+		"parameterized identity": {
+			identity: types.RegionalSingleParameterIdentity(names.AttrName),
+			id:       "arn:aws:sns:eu-west-1:123456789012:topic-1", //lintignore:AWSAT003,AWSAT005
+		},
+		"global ARN identity": {
+			identity: types.GlobalARNIdentity(names.AttrARN),
+			id:       "arn:aws:iam::123456789012:role/role-1", //lintignore:AWSAT005
+		},
+		"Regional ARN identity not ARN": {
+			identity: types.RegionalARNIdentity(names.AttrARN),
+			id:       "topic-1",
+		},
+		"Regional ARN identity": {
+			identity:       types.RegionalARNIdentity(names.AttrARN),
+			id:             "arn:aws:sns:eu-west-1:123456789012:topic-1", //lintignore:AWSAT003,AWSAT005
+			expectedRegion: "eu-west-1",                                  //lintignore:AWSAT003
+			expectedOK:     true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			region, ok := importIDARNRegion(testCase.identity, testCase.id)
+
+			if got, want := region, testCase.expectedRegion; got != want {
+				t.Errorf("Region = %q, want %q", got, want)
+			}
+			if got, want := ok, testCase.expectedOK; got != want {
+				t.Errorf("OK = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestNewIdentitySchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		identity                  *types.ServicePackageResourceIdentity
+		expectedRequiredForImport map[string]bool
+	}{
+		"Regional parameterized identity": {
+			identity: types.RegionalSingleParameterIdentity(names.AttrBucket),
+			expectedRequiredForImport: map[string]bool{
+				names.AttrAccountID: false,
+				names.AttrBucket:    true,
+				names.AttrRegion:    false,
+			},
+		},
+		"global parameterized identity": {
+			identity: types.GlobalSingleParameterIdentity(names.AttrName),
+			expectedRequiredForImport: map[string]bool{
+				names.AttrAccountID: false,
+				names.AttrName:      true,
+			},
+		},
+		"Regional ARN identity": {
+			identity: types.RegionalARNIdentity(names.AttrARN),
+			expectedRequiredForImport: map[string]bool{
+				names.AttrARN: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schema := newIdentitySchema(testCase.identity)
+
+			if diags := schema.ValidateImplementation(context.Background()); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if got, want := len(schema.Attributes), len(testCase.expectedRequiredForImport); got != want {
+				t.Errorf("length of Attributes = %d, want %d", got, want)
+			}
+			for name, want := range testCase.expectedRequiredForImport {
+				attr, ok := schema.Attributes[name].(identityschema.StringAttribute)
+				if !ok {
+					t.Errorf("missing attribute %q", name)
+					continue
+				}
+				if got := attr.IsRequiredForImport(); got != want {
+					t.Errorf("%s RequiredForImport = %t, want %t", name, got, want)
+				}
+				if got, want := attr.IsOptionalForImport(), !want; got != want {
+					t.Errorf("%s OptionalForImport = %t, want %t", name, got, want)
+				}
+			}
+		})
+	}
+}
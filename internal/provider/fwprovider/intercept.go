@@ -13,10 +13,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/preflight"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -77,6 +79,12 @@ type resourceInterceptor interface {
 
 type resourceInterceptors []resourceInterceptor
 
+// A resourceModifyPlanInterceptor is a resource interceptor that is also invoked during planning.
+type resourceModifyPlanInterceptor interface {
+	// modifyPlan is invoked for a ModifyPlan call, after any resource-specific plan modification.
+	modifyPlan(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse, *conns.AWSClient) diag.Diagnostics
+}
+
 type resourceInterceptorFunc[Request resourceCRUDRequest, Response resourceCRUDResponse] interceptorFunc[Request, Response]
 
 // create returns a slice of interceptors that run on resource Create.
@@ -199,7 +207,7 @@ func interceptedResourceHandler[Request resourceCRUDRequest, Response resourceCR
 }
 
 // contextFunc augments Context.
-type contextFunc func(context.Context, *conns.AWSClient) context.Context
+type contextFunc func(context.Context, getAttributeFunc, *conns.AWSClient) context.Context
 
 // wrappedDataSource represents an interceptor dispatcher for a Plugin Framework data source.
 type wrappedDataSource struct {
@@ -208,32 +216,64 @@ type wrappedDataSource struct {
 	inner            datasource.DataSourceWithConfigure
 	interceptors     dataSourceInterceptors
 	meta             *conns.AWSClient
+	// region is non-nil if the data source supports the per-resource `region` argument.
+	region *dataSourceRegion
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors, region *dataSourceRegion) datasource.DataSourceWithConfigure {
 	return &wrappedDataSource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		region:           region,
 	}
 }
 
 func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, nil, w.meta)
 	w.inner.Metadata(ctx, request, response)
 }
 
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, nil, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.region != nil {
+		response.Schema.Attributes = injectRegionAttribute(response.Schema.Attributes, regionDataSourceSchemaAttribute())
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	f := func(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
+		if w.region == nil {
+			w.inner.Read(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		region := w.meta.Region(ctx)
+		if diags := checkRegionInPartition(ctx, w.meta, region); diags.HasError() {
+			return diags
+		}
+
+		if err := w.region.stripConfig(&request.Config); err != nil {
+			response.Diagnostics.AddError("reading data source", err.Error())
+			return response.Diagnostics
+		}
+		if err := w.region.stripState(&response.State); err != nil {
+			response.Diagnostics.AddError("reading data source", err.Error())
+			return response.Diagnostics
+		}
+
 		w.inner.Read(ctx, request, response)
+
+		// Record the effective Region in state.
+		if err := w.region.restoreState(&response.State, region); err != nil {
+			response.Diagnostics.AddError("reading data source", err.Error())
+		}
+
 		return response.Diagnostics
 	}
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, request.Config.GetAttribute, w.meta)
 	diags := interceptedDataSourceReadHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
@@ -242,14 +282,22 @@ func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.Co
 	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
 		w.meta = v
 	}
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, nil, w.meta)
 	w.inner.Configure(ctx, request, response)
 }
 
 func (w *wrappedDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
 	if v, ok := w.inner.(datasource.DataSourceWithConfigValidators); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-		return v.ConfigValidators(ctx)
+		ctx = w.bootstrapContext(ctx, nil, w.meta)
+		validators := v.ConfigValidators(ctx)
+
+		if w.region != nil {
+			validators = slices.ApplyToAll(validators, func(v datasource.ConfigValidator) datasource.ConfigValidator {
+				return regionDataSourceConfigValidator{ConfigValidator: v, region: w.region}
+			})
+		}
+
+		return validators
 	}
 
 	return nil
@@ -371,17 +419,17 @@ type wrappedEphemeralResource struct {
 }
 
 func (w *wrappedEphemeralResource) Metadata(ctx context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, nil, w.meta)
 	w.inner.Metadata(ctx, request, response)
 }
 
 func (w *wrappedEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, nil, w.meta)
 	w.inner.Schema(ctx, request, response)
 }
 
 func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, nil, w.meta)
 	w.inner.Open(ctx, request, response)
 }
 
@@ -389,27 +437,27 @@ func (w *wrappedEphemeralResource) Configure(ctx context.Context, request epheme
 	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
 		w.meta = v
 	}
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, nil, w.meta)
 	w.inner.Configure(ctx, request, response)
 }
 
 func (w *wrappedEphemeralResource) Renew(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) {
 	if v, ok := w.inner.(ephemeral.EphemeralResourceWithRenew); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
+		ctx = w.bootstrapContext(ctx, nil, w.meta)
 		v.Renew(ctx, request, response)
 	}
 }
 
 func (w *wrappedEphemeralResource) Close(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) {
 	if v, ok := w.inner.(ephemeral.EphemeralResourceWithClose); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
+		ctx = w.bootstrapContext(ctx, nil, w.meta)
 		v.Close(ctx, request, response)
 	}
 }
 
 func (w *wrappedEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
 	if v, ok := w.inner.(ephemeral.EphemeralResourceWithConfigValidators); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
+		ctx = w.bootstrapContext(ctx, nil, w.meta)
 		return v.ConfigValidators(ctx)
 	}
 
@@ -418,7 +466,7 @@ func (w *wrappedEphemeralResource) ConfigValidators(ctx context.Context) []ephem
 
 func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
 	if v, ok := w.inner.(ephemeral.EphemeralResourceWithValidateConfig); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
+		ctx = w.bootstrapContext(ctx, nil, w.meta)
 		v.ValidateConfig(ctx, request, response)
 	}
 }
@@ -438,62 +486,186 @@ type wrappedResource struct {
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	// region is non-nil if the resource supports the per-resource `region` argument.
+	region *resourceRegion
+	// identity is non-nil if the resource has declared its identity.
+	identity *types.ServicePackageResourceIdentity
+	// preflightChecks are run during planning if enabled in the provider configuration.
+	preflightChecks []*preflight.Check
+	typeName        string
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
-	return &wrappedResource{
+func newWrappedResource(bootstrapContext contextFunc, typeName string, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, region *resourceRegion, identity *types.ServicePackageResourceIdentity, preflightChecks []*preflight.Check) resource.ResourceWithConfigure {
+	w := &wrappedResource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		region:           region,
+		identity:         identity,
+		preflightChecks:  preflightChecks,
+		typeName:         typeName,
 	}
+
+	if identity != nil {
+		return &wrappedResourceWithIdentity{wrappedResource: w}
+	}
+
+	return w
+}
+
+// wrappedResourceWithIdentity represents an interceptor dispatcher for a Plugin Framework resource that has declared its identity.
+// Only resources with an identity implement resource.ResourceWithIdentity.
+type wrappedResourceWithIdentity struct {
+	*wrappedResource
+}
+
+func (w *wrappedResourceWithIdentity) IdentitySchema(ctx context.Context, request resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = newIdentitySchema(w.identity)
 }
 
 func (w *wrappedResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, nil, w.meta)
 	w.inner.Metadata(ctx, request, response)
 }
 
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, nil, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.region != nil {
+		response.Schema.Attributes = injectRegionAttribute(response.Schema.Attributes, regionResourceSchemaAttribute())
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
+		if w.region == nil {
+			w.inner.Create(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		if err := w.region.stripConfig(&request.Config); err != nil {
+			response.Diagnostics.AddError("creating resource", err.Error())
+			return response.Diagnostics
+		}
+		if _, err := w.region.stripPlan(&request.Plan); err != nil {
+			response.Diagnostics.AddError("creating resource", err.Error())
+			return response.Diagnostics
+		}
+		if _, err := w.region.stripState(&response.State); err != nil {
+			response.Diagnostics.AddError("creating resource", err.Error())
+			return response.Diagnostics
+		}
+
 		w.inner.Create(ctx, request, response)
+
+		// Record the effective Region in state.
+		if err := w.region.restoreState(&response.State, tftypes.NewValue(tftypes.String, w.meta.Region(ctx))); err != nil {
+			response.Diagnostics.AddError("creating resource", err.Error())
+		}
+
 		return response.Diagnostics
 	}
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, request.Plan.GetAttribute, w.meta)
 	diags := interceptedResourceHandler(w.interceptors.create(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
+		if w.region == nil {
+			w.inner.Read(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		if _, err := w.region.stripState(&request.State); err != nil {
+			response.Diagnostics.AddError("reading resource", err.Error())
+			return response.Diagnostics
+		}
+		if _, err := w.region.stripState(&response.State); err != nil {
+			response.Diagnostics.AddError("reading resource", err.Error())
+			return response.Diagnostics
+		}
+
 		w.inner.Read(ctx, request, response)
+
+		// Record the effective Region in state.
+		// Resources created before the introduction of the `region` argument have no Region in state.
+		if err := w.region.restoreState(&response.State, tftypes.NewValue(tftypes.String, w.meta.Region(ctx))); err != nil {
+			response.Diagnostics.AddError("reading resource", err.Error())
+		}
+
 		return response.Diagnostics
 	}
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, request.State.GetAttribute, w.meta)
 	diags := interceptedResourceHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
+		if w.region == nil {
+			w.inner.Update(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		if err := w.region.stripConfig(&request.Config); err != nil {
+			response.Diagnostics.AddError("updating resource", err.Error())
+			return response.Diagnostics
+		}
+		if _, err := w.region.stripPlan(&request.Plan); err != nil {
+			response.Diagnostics.AddError("updating resource", err.Error())
+			return response.Diagnostics
+		}
+		if _, err := w.region.stripState(&request.State); err != nil {
+			response.Diagnostics.AddError("updating resource", err.Error())
+			return response.Diagnostics
+		}
+		if _, err := w.region.stripState(&response.State); err != nil {
+			response.Diagnostics.AddError("updating resource", err.Error())
+			return response.Diagnostics
+		}
+
 		w.inner.Update(ctx, request, response)
+
+		// Record the effective Region in state.
+		if err := w.region.restoreState(&response.State, tftypes.NewValue(tftypes.String, w.meta.Region(ctx))); err != nil {
+			response.Diagnostics.AddError("updating resource", err.Error())
+		}
+
 		return response.Diagnostics
 	}
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, request.Plan.GetAttribute, w.meta)
 	diags := interceptedResourceHandler(w.interceptors.update(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
+		if w.region == nil {
+			w.inner.Delete(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		if _, err := w.region.stripState(&request.State); err != nil {
+			response.Diagnostics.AddError("deleting resource", err.Error())
+			return response.Diagnostics
+		}
+		region, err := w.region.stripState(&response.State)
+		if err != nil {
+			response.Diagnostics.AddError("deleting resource", err.Error())
+			return response.Diagnostics
+		}
+
 		w.inner.Delete(ctx, request, response)
+
+		if err := w.region.restoreState(&response.State, region); err != nil {
+			response.Diagnostics.AddError("deleting resource", err.Error())
+		}
+
 		return response.Diagnostics
 	}
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, request.State.GetAttribute, w.meta)
 	diags := interceptedResourceHandler(w.interceptors.delete(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
@@ -502,15 +674,80 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
 		w.meta = v
 	}
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, nil, w.meta)
 	w.inner.Configure(ctx, request, response)
 }
 
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
+		// The import ID and any Region override of a resource imported by identity are taken from the identity.
+		var identityRegion string
+		if request.ID == "" && request.Identity != nil && w.identity != nil && w.meta != nil {
+			id, region, diags := importIDFromIdentity(ctx, w.identity, request.Identity, w.meta)
+			if diags.HasError() {
+				response.Diagnostics.Append(diags...)
+				return
+			}
+
+			request.ID = id
+			identityRegion = region
+		}
+
+		if w.region == nil {
+			if identityRegion != "" && identityRegion != w.meta.Region(ctx) {
+				response.Diagnostics.AddError("importing resource", fmt.Sprintf("identity Region (%s) does not match the provider's Region (%s)", identityRegion, w.meta.Region(ctx)))
+				return
+			}
+
+			ctx = w.bootstrapContext(ctx, nil, w.meta)
+			v.ImportState(ctx, request, response)
+
+			return
+		}
+
+		// The import ID may have a Region override suffix.
+		region := tftypes.NewValue(tftypes.String, nil)
+		var getAttribute getAttributeFunc
+		if identityRegion != "" {
+			if diags := checkRegionInPartition(ctx, w.meta, identityRegion); diags.HasError() {
+				response.Diagnostics.Append(diags...)
+				return
+			}
+
+			region = tftypes.NewValue(tftypes.String, identityRegion)
+			getAttribute = regionGetAttribute(identityRegion)
+		} else if id, v, ok := conns.ParseImportIDRegion(request.ID); ok {
+			if diags := checkRegionInPartition(ctx, w.meta, v); diags.HasError() {
+				response.Diagnostics.Append(diags...)
+				return
+			}
+
+			request.ID = id
+			region = tftypes.NewValue(tftypes.String, v)
+			getAttribute = regionGetAttribute(v)
+		} else if v, ok := importIDARNRegion(w.identity, request.ID); ok {
+			// An ARN import ID's Region is used when there is no Region suffix.
+			if diags := checkRegionInPartition(ctx, w.meta, v); diags.HasError() {
+				response.Diagnostics.Append(diags...)
+				return
+			}
+
+			region = tftypes.NewValue(tftypes.String, v)
+			getAttribute = regionGetAttribute(v)
+		}
+		ctx = w.bootstrapContext(ctx, getAttribute, w.meta)
+
+		if _, err := w.region.stripState(&response.State); err != nil {
+			response.Diagnostics.AddError("importing resource", err.Error())
+			return
+		}
+
 		v.ImportState(ctx, request, response)
 
+		if err := w.region.restoreState(&response.State, region); err != nil {
+			response.Diagnostics.AddError("importing resource", err.Error())
+		}
+
 		return
 	}
 
@@ -521,16 +758,114 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	w.modifyPlan(ctx, request, response)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for _, v := range w.interceptors {
+		if v, ok := v.(resourceModifyPlanInterceptor); ok {
+			response.Diagnostics.Append(v.modifyPlan(w.bootstrapContext(ctx, response.Plan.GetAttribute, w.meta), request, response, w.meta)...)
+		}
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Preflight checks are run after any resource-specific plan modification.
+	w.runPreflightChecks(ctx, request, response)
+}
+
+func (w *wrappedResource) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if w.region == nil {
+		if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
+			ctx = w.bootstrapContext(ctx, nil, w.meta)
+			v.ModifyPlan(ctx, request, response)
+		}
+
+		return
+	}
+
+	getAttribute := request.State.GetAttribute
+	region := tftypes.NewValue(tftypes.String, nil)
+
+	// Plan the Region unless the resource is being destroyed.
+	if !request.Plan.Raw.IsNull() {
+		v, diags := planRegion(w.bootstrapContext(ctx, nil, w.meta), w.meta, request, response)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		raw, err := v.ToTerraformValue(ctx)
+		if err != nil {
+			response.Diagnostics.AddError("modifying plan", err.Error())
+			return
+		}
+
+		getAttribute = response.Plan.GetAttribute
+		region = raw
+	}
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
+		ctx = w.bootstrapContext(ctx, getAttribute, w.meta)
+
+		if err := w.region.stripConfig(&request.Config); err != nil {
+			response.Diagnostics.AddError("modifying plan", err.Error())
+			return
+		}
+		if _, err := w.region.stripPlan(&request.Plan); err != nil {
+			response.Diagnostics.AddError("modifying plan", err.Error())
+			return
+		}
+		if _, err := w.region.stripState(&request.State); err != nil {
+			response.Diagnostics.AddError("modifying plan", err.Error())
+			return
+		}
+		if _, err := w.region.stripPlan(&response.Plan); err != nil {
+			response.Diagnostics.AddError("modifying plan", err.Error())
+			return
+		}
+
 		v.ModifyPlan(ctx, request, response)
+
+		if err := w.region.restorePlan(&response.Plan, region); err != nil {
+			response.Diagnostics.AddError("modifying plan", err.Error())
+		}
+	}
+}
+
+// runPreflightChecks runs any preflight checks against the planned values of a resource being created or updated.
+func (w *wrappedResource) runPreflightChecks(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if len(w.preflightChecks) == 0 || w.meta == nil || w.meta.PreflightConfig(ctx) == nil {
+		return
+	}
+
+	// No checks are run on destroy or when there are no planned changes.
+	if response.Plan.Raw.IsNull() || response.Plan.Raw.Equal(request.State.Raw) {
+		return
 	}
+
+	ctx = w.bootstrapContext(ctx, response.Plan.GetAttribute, w.meta)
+	response.Diagnostics.Append(preflight.Run(ctx, w.meta, w.preflightChecks, preflight.Request{
+		TypeName: w.typeName,
+		IsCreate: request.State.Raw.IsNull(),
+		Plan:     preflight.NewPlanValues(ctx, response.Plan, request.State),
+	})...)
 }
 
 func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
 	if v, ok := w.inner.(resource.ResourceWithConfigValidators); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-		return v.ConfigValidators(ctx)
+		ctx = w.bootstrapContext(ctx, nil, w.meta)
+		validators := v.ConfigValidators(ctx)
+
+		if w.region != nil {
+			validators = slices.ApplyToAll(validators, func(v resource.ConfigValidator) resource.ConfigValidator {
+				return regionResourceConfigValidator{ConfigValidator: v, region: w.region}
+			})
+		}
+
+		return validators
 	}
 
 	return nil
@@ -538,16 +873,31 @@ func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.Confi
 
 func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
 	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
+		ctx = w.bootstrapContext(ctx, request.Config.GetAttribute, w.meta)
+
+		if w.region != nil {
+			if err := w.region.stripConfig(&request.Config); err != nil {
+				response.Diagnostics.AddError("validating resource configuration", err.Error())
+				return
+			}
+		}
+
 		v.ValidateConfig(ctx, request, response)
 	}
 }
 
 func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
+		ctx = w.bootstrapContext(ctx, nil, w.meta)
+		upgraders := v.UpgradeState(ctx)
+
+		if w.region != nil {
+			for k, upgrader := range upgraders {
+				upgraders[k] = w.region.stateUpgrader(upgrader)
+			}
+		}
 
-		return v.UpgradeState(ctx)
+		return upgraders
 	}
 
 	return nil
@@ -555,9 +905,14 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 
 func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
 	if v, ok := w.inner.(resource.ResourceWithMoveState); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
+		ctx = w.bootstrapContext(ctx, nil, w.meta)
+		movers := v.MoveState(ctx)
+
+		if w.region != nil {
+			movers = slices.ApplyToAll(movers, w.region.stateMover)
+		}
 
-		return v.MoveState(ctx)
+		return movers
 	}
 
 	return nil
@@ -700,6 +1055,27 @@ func (r tagsResourceInterceptor) read(ctx context.Context, request resource.Read
 
 		apiTags := tagsInContext.TagsOut.UnwrapOrDefault()
 
+		// Report tags changed outside of Terraform since the last apply or refresh.
+		if config := meta.TagDiagnosticsConfig(ctx); config != nil {
+			var oldTagsAll tftags.Map
+			diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &oldTagsAll)...)
+
+			if diags.HasError() {
+				return ctx, diags
+			}
+
+			// Imported resources have no previous tags_all.
+			if !oldTagsAll.IsNull() {
+				oldTags := tftags.New(ctx, oldTagsAll).IgnoreConfig(tagsInContext.IgnoreConfig)
+				newTags := apiTags.IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig)
+				diags.Append(tagFindingsDiagnostics(config.Error, path.Root(names.AttrTagsAll), oldTags.Drift(newTags))...)
+
+				if diags.HasError() {
+					return ctx, diags
+				}
+			}
+		}
+
 		// AWS APIs often return empty lists of tags when none have been configured.
 		var stateTags tftags.Map
 		response.State.GetAttribute(ctx, path.Root(names.AttrTags), &stateTags)
@@ -835,3 +1211,86 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+// modifyPlan reports configured resource tags that conflict with the provider's default_tags or ignore_tags configuration
+// and evaluates the provider's tagging policy.
+func (r tagsResourceInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if r.tags == nil || meta == nil {
+		return diags
+	}
+
+	diagnosticsConfig, policy := meta.TagDiagnosticsConfig(ctx), meta.TagPolicyConfig(ctx)
+	if diagnosticsConfig == nil && policy == nil {
+		return diags
+	}
+
+	// No diagnostics are reported on destroy.
+	if request.Plan.Raw.IsNull() {
+		return diags
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return diags
+	}
+
+	var configTags tftags.Map
+	diags.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrTags), &configTags)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	// Tags are evaluated once all configured tags are known.
+	if v, err := configTags.ToTerraformValue(ctx); err != nil || !v.IsFullyKnown() {
+		return diags
+	}
+
+	tags := tftags.New(ctx, configTags)
+
+	if diagnosticsConfig != nil {
+		// Only report on create or when the configured tags change.
+		report := true
+
+		if !request.State.Raw.IsNull() {
+			var stateTags tftags.Map
+			diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTags), &stateTags)...)
+
+			if diags.HasError() {
+				return diags
+			}
+
+			report = !tags.Equal(tftags.New(ctx, stateTags))
+		}
+
+		if report {
+			findings := tags.ConfigConflicts(tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig)
+			diags.Append(tagFindingsDiagnostics(diagnosticsConfig.Error, path.Root(names.AttrTags), findings)...)
+		}
+	}
+
+	if policy != nil {
+		// The policy is evaluated against the resource's tags_all.
+		tagsAll := tagsInContext.DefaultConfig.MergeTags(tags)
+		diags.Append(tagFindingsDiagnostics(policy.Error, path.Root(names.AttrTags), tagsAll.PolicyViolations(policy))...)
+	}
+
+	return diags
+}
+
+// tagFindingsDiagnostics returns the specified resource tag findings as warning or error diagnostics.
+func tagFindingsDiagnostics(isError bool, path path.Path, findings []tftags.Finding) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, finding := range findings {
+		if isError {
+			diags.AddAttributeError(path, finding.Summary, finding.Detail)
+		} else {
+			diags.AddAttributeWarning(path, finding.Summary, finding.Detail)
+		}
+	}
+
+	return diags
+}
@@ -7,8 +7,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
@@ -23,6 +26,7 @@ import (
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/preflight"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -170,6 +174,29 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"api_rate_limits": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to limit the rate and concurrency of AWS API requests per service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of requests that can be made at once. Defaults to `requests_per_second`, rounded up.",
+						},
+						"max_in_flight": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of concurrent requests.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The sustained rate of requests per second.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service package name, e.g. `route53`.",
+						},
+					},
+				},
+			},
 			"assume_role": schema.ListNestedBlock{
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
@@ -292,6 +319,86 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"preflight": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to run read-only checks of planned resource values against live account state during planning.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"skip_checks": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Names of preflight checks that are not run.",
+						},
+					},
+				},
+			},
+			"tag_diagnostics": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to report resource tags that conflict with the provider's tag configuration or that have been changed outside of Terraform.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"severity": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("error", "warning"),
+							},
+							Description: "Severity of the reported diagnostics. Valid values are `warning` and `error`. Defaults to `warning`.",
+						},
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with a tagging policy evaluated against the tags of all taggable resources during planning.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"key_pattern": schema.StringAttribute{
+							Optional:    true,
+							Description: "Regular expression that all tag keys must match.",
+						},
+						"policy_document": schema.StringAttribute{
+							Optional:    true,
+							Description: "AWS Organizations tag policy document to import rules from.",
+						},
+						"required_keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Tag keys that all taggable resources must have.",
+						},
+						"severity": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("error", "warning"),
+							},
+							Description: "Severity of the reported policy violations. Valid values are `warning` and `error`. Defaults to `error`. Warnings for Plugin SDK resources are only logged.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"allowed_values": schema.SetNestedBlock{
+							Description: "Values allowed for a tag key.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrKey: schema.StringAttribute{
+										Required:    true,
+										Description: "Tag key.",
+									},
+									names.AttrValues: schema.SetAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+										Description: "Allowed tag values. A value ending in `*` allows any value with that prefix.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -335,9 +442,26 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			inner.Metadata(ctx, datasource.MetadataRequest{}, &metadataResponse)
 			typeName := metadataResponse.TypeName
 
+			var region *dataSourceRegion
+			if conns.IsRegionOverrideEnabled(servicePackageName, v.Region) {
+				schemaResponse := datasource.SchemaResponse{}
+				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+				// Data sources that already define a top-level `region` attribute or block don't support Region override.
+				_, hasAttribute := schemaResponse.Schema.Attributes[names.AttrRegion]
+				_, hasBlock := schemaResponse.Schema.Blocks[names.AttrRegion]
+				if !hasAttribute && !hasBlock {
+					region = newDataSourceRegion(ctx, schemaResponse.Schema)
+				}
+			}
+
 			// bootstrapContext is run on all wrapped methods before any interceptors.
-			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+			bootstrapContext := func(ctx context.Context, getAttribute getAttributeFunc, meta *conns.AWSClient) context.Context {
+				var overrideRegion string
+				if region != nil {
+					overrideRegion = getRegionAttribute(ctx, getAttribute)
+				}
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, overrideRegion)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx), meta.IgnoreTagsConfig(ctx))
 					ctx = meta.RegisterLogger(ctx)
@@ -368,7 +492,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			}
 
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(bootstrapContext, inner, interceptors)
+				return newWrappedDataSource(bootstrapContext, inner, interceptors, region)
 			})
 		}
 	}
@@ -391,7 +515,13 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	var errs []error
 	var resources []func() resource.Resource
 
-	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
+	servicePackages := p.Primary.Meta().(*conns.AWSClient).ServicePackages
+	preflightChecks, err := preflight.NewRegistryFromServicePackages(ctx, slices.Collect(maps.Values(servicePackages)))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	for _, sp := range servicePackages {
 		servicePackageName := sp.ServicePackageName()
 
 		for _, v := range sp.FrameworkResources(ctx) {
@@ -406,9 +536,26 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			inner.Metadata(ctx, resource.MetadataRequest{}, &metadataResponse)
 			typeName := metadataResponse.TypeName
 
+			var region *resourceRegion
+			if conns.IsRegionOverrideEnabled(servicePackageName, v.Region) {
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				// Resources that already define a top-level `region` attribute or block don't support Region override.
+				_, hasAttribute := schemaResponse.Schema.Attributes[names.AttrRegion]
+				_, hasBlock := schemaResponse.Schema.Blocks[names.AttrRegion]
+				if !hasAttribute && !hasBlock {
+					region = newResourceRegion(ctx, schemaResponse.Schema)
+				}
+			}
+
 			// bootstrapContext is run on all wrapped methods before any interceptors.
-			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+			bootstrapContext := func(ctx context.Context, getAttribute getAttributeFunc, meta *conns.AWSClient) context.Context {
+				var overrideRegion string
+				if region != nil {
+					overrideRegion = getRegionAttribute(ctx, getAttribute)
+				}
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx), meta.IgnoreTagsConfig(ctx))
 					ctx = meta.RegisterLogger(ctx)
@@ -447,8 +594,12 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
+			if v.Identity != nil {
+				interceptors = append(interceptors, identityResourceInterceptor{identity: v.Identity})
+			}
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, typeName, inner, interceptors, region, v.Identity, preflightChecks.Checks(typeName))
 			})
 		}
 	}
@@ -488,7 +639,7 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 				}
 
 				// bootstrapContext is run on all wrapped methods before any interceptors.
-				bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+				bootstrapContext := func(ctx context.Context, _ getAttributeFunc, meta *conns.AWSClient) context.Context {
 					ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name)
 					if meta != nil {
 						ctx = meta.RegisterLogger(ctx)
@@ -523,6 +674,13 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRSubnetsByAZFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewRDSEngineVersionCompareFunction,
+		tffunction.NewS3URIParseFunction,
+		tffunction.NewTagsMergeFunction,
 		tffunction.NewTrimIAMRolePathFunction,
+		tffunction.NewUserDataEncodeFunction,
 	}
 }
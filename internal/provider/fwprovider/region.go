@@ -0,0 +1,410 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// getAttributeFunc retrieves the value of a top-level attribute, e.g. tfsdk.Plan.GetAttribute.
+type getAttributeFunc func(context.Context, path.Path, any) diag.Diagnostics
+
+// getRegionAttribute returns the per-resource Region override, if any, using the specified attribute getter.
+func getRegionAttribute(ctx context.Context, getAttribute getAttributeFunc) string {
+	if getAttribute == nil {
+		return ""
+	}
+
+	var region *string
+	if diags := getAttribute(ctx, path.Root(names.AttrRegion), &region); diags.HasError() || region == nil {
+		return ""
+	}
+
+	return *region
+}
+
+// regionGetAttribute returns an attribute getter that always returns the specified Region.
+func regionGetAttribute(region string) getAttributeFunc {
+	return func(ctx context.Context, _ path.Path, target any) diag.Diagnostics {
+		return tfsdk.ValueAs(ctx, types.StringValue(region), target)
+	}
+}
+
+// regionDataSourceSchemaAttribute returns the schema for the per-resource `region` argument of a data source.
+func regionDataSourceSchemaAttribute() dschema.Attribute {
+	return dschema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			fwvalidators.AWSRegion(),
+		},
+		Description: "The AWS Region to use for API operations. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// regionResourceSchemaAttribute returns the schema for the per-resource `region` argument of a resource.
+// Replacement on change is handled by the wrapped resource's ModifyPlan method.
+func regionResourceSchemaAttribute() rschema.Attribute {
+	return rschema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			fwvalidators.AWSRegion(),
+		},
+		Description: "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// dataSourceRegion implements the per-resource `region` argument for a Plugin Framework data source.
+// The data source's own schema and model do not include the `region` attribute;
+// it is removed from the values passed to the data source and added back to the values it returns.
+type dataSourceRegion struct {
+	innerSchema dschema.Schema // The data source's schema without the `region` attribute.
+	innerType   tftypes.Type
+	outerSchema dschema.Schema
+	outerType   tftypes.Type
+}
+
+func newDataSourceRegion(ctx context.Context, innerSchema dschema.Schema) *dataSourceRegion {
+	outerSchema := innerSchema
+	outerSchema.Attributes = injectRegionAttribute(innerSchema.Attributes, regionDataSourceSchemaAttribute())
+
+	return &dataSourceRegion{
+		innerSchema: innerSchema,
+		innerType:   innerSchema.Type().TerraformType(ctx),
+		outerSchema: outerSchema,
+		outerType:   outerSchema.Type().TerraformType(ctx),
+	}
+}
+
+func (r *dataSourceRegion) stripConfig(v *tfsdk.Config) error {
+	raw, _, err := removeRegion(v.Raw, r.innerType)
+	if err != nil {
+		return err
+	}
+
+	*v = tfsdk.Config{Raw: raw, Schema: r.innerSchema}
+
+	return nil
+}
+
+func (r *dataSourceRegion) stripState(v *tfsdk.State) error {
+	raw, _, err := removeRegion(v.Raw, r.innerType)
+	if err != nil {
+		return err
+	}
+
+	*v = tfsdk.State{Raw: raw, Schema: r.innerSchema}
+
+	return nil
+}
+
+func (r *dataSourceRegion) restoreState(v *tfsdk.State, region string) error {
+	raw, err := addRegion(v.Raw, r.outerType, tftypes.NewValue(tftypes.String, region))
+	if err != nil {
+		return err
+	}
+
+	*v = tfsdk.State{Raw: raw, Schema: r.outerSchema}
+
+	return nil
+}
+
+// resourceRegion implements the per-resource `region` argument for a Plugin Framework resource.
+// The resource's own schema and model do not include the `region` attribute;
+// it is removed from the values passed to the resource and added back to the values it returns.
+type resourceRegion struct {
+	innerSchema rschema.Schema // The resource's schema without the `region` attribute.
+	innerType   tftypes.Type
+	outerSchema rschema.Schema
+	outerType   tftypes.Type
+}
+
+func newResourceRegion(ctx context.Context, innerSchema rschema.Schema) *resourceRegion {
+	outerSchema := innerSchema
+	outerSchema.Attributes = injectRegionAttribute(innerSchema.Attributes, regionResourceSchemaAttribute())
+
+	return &resourceRegion{
+		innerSchema: innerSchema,
+		innerType:   innerSchema.Type().TerraformType(ctx),
+		outerSchema: outerSchema,
+		outerType:   outerSchema.Type().TerraformType(ctx),
+	}
+}
+
+func (r *resourceRegion) stripConfig(v *tfsdk.Config) error {
+	raw, _, err := removeRegion(v.Raw, r.innerType)
+	if err != nil {
+		return err
+	}
+
+	*v = tfsdk.Config{Raw: raw, Schema: r.innerSchema}
+
+	return nil
+}
+
+func (r *resourceRegion) stripPlan(v *tfsdk.Plan) (tftypes.Value, error) {
+	raw, region, err := removeRegion(v.Raw, r.innerType)
+	if err != nil {
+		return region, err
+	}
+
+	*v = tfsdk.Plan{Raw: raw, Schema: r.innerSchema}
+
+	return region, nil
+}
+
+func (r *resourceRegion) stripState(v *tfsdk.State) (tftypes.Value, error) {
+	raw, region, err := removeRegion(v.Raw, r.innerType)
+	if err != nil {
+		return region, err
+	}
+
+	*v = tfsdk.State{Raw: raw, Schema: r.innerSchema}
+
+	return region, nil
+}
+
+func (r *resourceRegion) restorePlan(v *tfsdk.Plan, region tftypes.Value) error {
+	raw, err := addRegion(v.Raw, r.outerType, region)
+	if err != nil {
+		return err
+	}
+
+	*v = tfsdk.Plan{Raw: raw, Schema: r.outerSchema}
+
+	return nil
+}
+
+func (r *resourceRegion) restoreState(v *tfsdk.State, region tftypes.Value) error {
+	raw, err := addRegion(v.Raw, r.outerType, region)
+	if err != nil {
+		return err
+	}
+
+	*v = tfsdk.State{Raw: raw, Schema: r.outerSchema}
+
+	return nil
+}
+
+// planRegion sets the planned value of the per-resource `region` argument.
+// An unconfigured Region defaults to the Region set in the provider configuration, as for Plugin SDK resources.
+// A change of Region forces replacement.
+func planRegion(ctx context.Context, meta *conns.AWSClient, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var configRegion, stateRegion types.String
+
+	diags.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &configRegion)...)
+	if !request.State.Raw.IsNull() {
+		diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &stateRegion)...)
+	}
+	if diags.HasError() {
+		return configRegion, diags
+	}
+
+	region := configRegion
+	switch {
+	case configRegion.IsUnknown():
+	case !configRegion.IsNull():
+		diags.Append(checkRegionInPartition(ctx, meta, configRegion.ValueString())...)
+	case meta != nil:
+		region = types.StringValue(meta.Region(ctx))
+	default:
+		region = types.StringUnknown()
+	}
+	if diags.HasError() {
+		return region, diags
+	}
+
+	diags.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+	if diags.HasError() {
+		return region, diags
+	}
+
+	// Resources created before the introduction of the `region` argument have no Region in state.
+	if stateRegion.ValueString() != "" && !region.Equal(stateRegion) {
+		response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
+	}
+
+	return region, diags
+}
+
+// stateUpgrader returns a state upgrader that runs the specified state upgrader without the `region` attribute.
+// Any Region in the prior state is retained.
+func (r *resourceRegion) stateUpgrader(upgrader resource.StateUpgrader) resource.StateUpgrader {
+	f := upgrader.StateUpgrader
+	upgrader.StateUpgrader = func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+		if _, err := r.stripState(&response.State); err != nil {
+			response.Diagnostics.AddError("upgrading resource state", err.Error())
+			return
+		}
+
+		f(ctx, request, response)
+
+		if err := r.restoreState(&response.State, regionFromRawState(request.RawState)); err != nil {
+			response.Diagnostics.AddError("upgrading resource state", err.Error())
+		}
+	}
+
+	return upgrader
+}
+
+// stateMover returns a state mover that runs the specified state mover without the `region` attribute.
+// The Region is set on the next Read.
+func (r *resourceRegion) stateMover(mover resource.StateMover) resource.StateMover {
+	f := mover.StateMover
+	mover.StateMover = func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+		if _, err := r.stripState(&response.TargetState); err != nil {
+			response.Diagnostics.AddError("moving resource state", err.Error())
+			return
+		}
+
+		f(ctx, request, response)
+
+		if err := r.restoreState(&response.TargetState, tftypes.NewValue(tftypes.String, nil)); err != nil {
+			response.Diagnostics.AddError("moving resource state", err.Error())
+		}
+	}
+
+	return mover
+}
+
+// injectRegionAttribute returns a copy of the specified schema attributes with the `region` attribute added.
+func injectRegionAttribute[T any](attributes map[string]T, region T) map[string]T {
+	v := make(map[string]T, len(attributes)+1)
+	for k, attr := range attributes {
+		v[k] = attr
+	}
+	v[names.AttrRegion] = region
+
+	return v
+}
+
+// removeRegion returns the specified object value without its `region` attribute, and that attribute's value.
+func removeRegion(v tftypes.Value, typ tftypes.Type) (tftypes.Value, tftypes.Value, error) {
+	region := tftypes.NewValue(tftypes.String, nil)
+
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), region, nil
+	}
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil), region, nil
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		return v, region, fmt.Errorf("removing %s attribute: %w", names.AttrRegion, err)
+	}
+
+	if v, ok := attributes[names.AttrRegion]; ok {
+		region = v
+		delete(attributes, names.AttrRegion)
+	}
+
+	if err := tftypes.ValidateValue(typ, attributes); err != nil {
+		return v, region, fmt.Errorf("removing %s attribute: %w", names.AttrRegion, err)
+	}
+
+	return tftypes.NewValue(typ, attributes), region, nil
+}
+
+// addRegion returns the specified object value with its `region` attribute set to the specified value.
+func addRegion(v tftypes.Value, typ tftypes.Type, region tftypes.Value) (tftypes.Value, error) {
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		return v, fmt.Errorf("adding %s attribute: %w", names.AttrRegion, err)
+	}
+
+	attributes[names.AttrRegion] = region
+
+	if err := tftypes.ValidateValue(typ, attributes); err != nil {
+		return v, fmt.Errorf("adding %s attribute: %w", names.AttrRegion, err)
+	}
+
+	return tftypes.NewValue(typ, attributes), nil
+}
+
+// regionFromRawState returns the value of the `region` attribute in the specified raw (prior version) state.
+func regionFromRawState(rawState *tfprotov6.RawState) tftypes.Value {
+	if rawState != nil {
+		if rawState.JSON != nil {
+			var attributes map[string]json.RawMessage
+			if err := json.Unmarshal(rawState.JSON, &attributes); err == nil {
+				var region string
+				if err := json.Unmarshal(attributes[names.AttrRegion], &region); err == nil && region != "" {
+					return tftypes.NewValue(tftypes.String, region)
+				}
+			}
+		} else if region := rawState.Flatmap[names.AttrRegion]; region != "" {
+			return tftypes.NewValue(tftypes.String, region)
+		}
+	}
+
+	return tftypes.NewValue(tftypes.String, nil)
+}
+
+// checkRegionInPartition returns an error diagnostic if the specified Region is not in the configured AWS partition.
+func checkRegionInPartition(ctx context.Context, meta *conns.AWSClient, region string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if meta != nil && region != "" && !meta.IsRegionInPartition(ctx, region) {
+		diags.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region", fmt.Sprintf("Region (%s) is not in the configured AWS partition (%s)", region, meta.Partition(ctx)))
+	}
+
+	return diags
+}
+
+// regionDataSourceConfigValidator runs a data source's config validator against its configuration without the `region` attribute.
+type regionDataSourceConfigValidator struct {
+	datasource.ConfigValidator
+	region *dataSourceRegion
+}
+
+func (v regionDataSourceConfigValidator) ValidateDataSource(ctx context.Context, request datasource.ValidateConfigRequest, response *datasource.ValidateConfigResponse) {
+	if err := v.region.stripConfig(&request.Config); err != nil {
+		response.Diagnostics.AddError("validating data source configuration", err.Error())
+		return
+	}
+
+	v.ConfigValidator.ValidateDataSource(ctx, request, response)
+}
+
+// regionResourceConfigValidator runs a resource's config validator against its configuration without the `region` attribute.
+type regionResourceConfigValidator struct {
+	resource.ConfigValidator
+	region *resourceRegion
+}
+
+func (v regionResourceConfigValidator) ValidateResource(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	if err := v.region.stripConfig(&request.Config); err != nil {
+		response.Diagnostics.AddError("validating resource configuration", err.Error())
+		return
+	}
+
+	v.ConfigValidator.ValidateResource(ctx, request, response)
+}
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRemoveAndAddRegion(t *testing.T) {
+	t.Parallel()
+
+	innerType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		names.AttrID:   tftypes.String,
+		names.AttrName: tftypes.String,
+	}}
+	outerType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		names.AttrID:     tftypes.String,
+		names.AttrName:   tftypes.String,
+		names.AttrRegion: tftypes.String,
+	}}
+
+	testCases := map[string]struct {
+		value          tftypes.Value
+		expectedInner  tftypes.Value
+		expectedRegion tftypes.Value
+	}{
+		"null": {
+			value:          tftypes.NewValue(outerType, nil),
+			expectedInner:  tftypes.NewValue(innerType, nil),
+			expectedRegion: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			value:          tftypes.NewValue(outerType, tftypes.UnknownValue),
+			expectedInner:  tftypes.NewValue(innerType, tftypes.UnknownValue),
+			expectedRegion: tftypes.NewValue(tftypes.String, nil),
+		},
+		"Region": {
+			value: tftypes.NewValue(outerType, map[string]tftypes.Value{
+				names.AttrID:     tftypes.NewValue(tftypes.String, "id-1"),
+				names.AttrName:   tftypes.NewValue(tftypes.String, "name-1"),
+				names.AttrRegion: tftypes.NewValue(tftypes.String, "eu-west-1"), //lintignore:AWSAT003
+			}),
+			expectedInner: tftypes.NewValue(innerType, map[string]tftypes.Value{
+				names.AttrID:   tftypes.NewValue(tftypes.String, "id-1"),
+				names.AttrName: tftypes.NewValue(tftypes.String, "name-1"),
+			}),
+			expectedRegion: tftypes.NewValue(tftypes.String, "eu-west-1"), //lintignore:AWSAT003
+		},
+		"unknown Region": {
+			value: tftypes.NewValue(outerType, map[string]tftypes.Value{
+				names.AttrID:     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				names.AttrName:   tftypes.NewValue(tftypes.String, "name-1"),
+				names.AttrRegion: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expectedInner: tftypes.NewValue(innerType, map[string]tftypes.Value{
+				names.AttrID:   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				names.AttrName: tftypes.NewValue(tftypes.String, "name-1"),
+			}),
+			expectedRegion: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			inner, region, err := removeRegion(testCase.value, innerType)
+			if err != nil {
+				t.Fatalf("removeRegion: unexpected error: %s", err)
+			}
+
+			if !inner.Equal(testCase.expectedInner) {
+				t.Errorf("removeRegion: value = %s, want %s", inner, testCase.expectedInner)
+			}
+			if !region.Equal(testCase.expectedRegion) {
+				t.Errorf("removeRegion: Region = %s, want %s", region, testCase.expectedRegion)
+			}
+
+			outer, err := addRegion(inner, outerType, region)
+			if err != nil {
+				t.Fatalf("addRegion: unexpected error: %s", err)
+			}
+
+			if !outer.Equal(testCase.value) {
+				t.Errorf("addRegion: value = %s, want %s", outer, testCase.value)
+			}
+		})
+	}
+}
+
+func TestRegionFromRawState(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rawState *tfprotov6.RawState
+		expected tftypes.Value
+	}{
+		"nil": {
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"JSON no Region": {
+			rawState: &tfprotov6.RawState{JSON: []byte(`{"id":"id-1"}`)},
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"JSON Region": {
+			rawState: &tfprotov6.RawState{JSON: []byte(`{"id":"id-1","region":"eu-west-1"}`)}, //lintignore:AWSAT003
+			expected: tftypes.NewValue(tftypes.String, "eu-west-1"),                           //lintignore:AWSAT003
+		},
+		"Flatmap Region": {
+			rawState: &tfprotov6.RawState{Flatmap: map[string]string{"id": "id-1", "region": "eu-west-1"}}, //lintignore:AWSAT003
+			expected: tftypes.NewValue(tftypes.String, "eu-west-1"),                                        //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := regionFromRawState(testCase.rawState), testCase.expected; !got.Equal(want) {
+				t.Errorf("Region = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestPlanRegion(t *testing.T) {
+	t.Parallel()
+
+	const providerRegion = "us-west-2" //lintignore:AWSAT003
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrRegion: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		names.AttrID:     tftypes.String,
+		names.AttrRegion: tftypes.String,
+	}}
+	newValue := func(id, region any) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			names.AttrID:     tftypes.NewValue(tftypes.String, id),
+			names.AttrRegion: tftypes.NewValue(tftypes.String, region),
+		})
+	}
+
+	testCases := map[string]struct {
+		config                  tftypes.Value
+		state                   tftypes.Value
+		expectedRegion          types.String
+		expectedRequiresReplace bool
+	}{
+		"create": {
+			config:         newValue(nil, nil),
+			state:          tftypes.NewValue(objectType, nil),
+			expectedRegion: types.StringValue(providerRegion),
+		},
+		"create unknown": {
+			config:         newValue(nil, tftypes.UnknownValue),
+			state:          tftypes.NewValue(objectType, nil),
+			expectedRegion: types.StringUnknown(),
+		},
+		"unconfigured provider Region in state": {
+			config:         newValue(nil, nil),
+			state:          newValue("id-1", providerRegion),
+			expectedRegion: types.StringValue(providerRegion),
+		},
+		"removed": {
+			config:                  newValue(nil, nil),
+			state:                   newValue("id-1", "eu-west-1"), //lintignore:AWSAT003
+			expectedRegion:          types.StringValue(providerRegion),
+			expectedRequiresReplace: true,
+		},
+		"no Region in state": {
+			config:         newValue(nil, nil),
+			state:          newValue("id-1", nil),
+			expectedRegion: types.StringValue(providerRegion),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := conns.NewResourceContext(context.Background(), "test", "Test", providerRegion)
+			request := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Raw: testCase.config, Schema: s},
+				Plan:   tfsdk.Plan{Raw: testCase.config, Schema: s},
+				State:  tfsdk.State{Raw: testCase.state, Schema: s},
+			}
+			response := resource.ModifyPlanResponse{
+				Plan: request.Plan,
+			}
+
+			region, diags := planRegion(ctx, &conns.AWSClient{}, request, &response)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, want := region, testCase.expectedRegion; !got.Equal(want) {
+				t.Errorf("Region = %s, want %s", got, want)
+			}
+
+			if got, want := len(response.RequiresReplace) > 0, testCase.expectedRequiresReplace; got != want {
+				t.Errorf("RequiresReplace = %t, want %t", got, want)
+			}
+		})
+	}
+}
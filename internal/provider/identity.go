@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// newResourceIdentity returns the identity schema of a resource with the specified identity.
+func newResourceIdentity(identity *types.ServicePackageResourceIdentity) *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			identitySchema := make(map[string]*schema.Schema)
+			for _, attr := range identity.Attributes() {
+				identitySchema[attr.Name] = &schema.Schema{
+					Type:              schema.TypeString,
+					RequiredForImport: attr.Required,
+					OptionalForImport: !attr.Required,
+				}
+			}
+
+			return identitySchema
+		},
+	}
+}
+
+// identityInterceptor sets a resource's identity and verifies that it does not change once the resource has been created.
+type identityInterceptor struct {
+	identity *types.ServicePackageResourceIdentity
+}
+
+func (r identityInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case After:
+		switch why {
+		case Create, Read, Update:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			if why == Read {
+				if state := d.GetRawState(); !state.IsNull() && state.IsKnown() {
+					for _, name := range r.identity.AttributeNames() {
+						if !state.Type().IsObjectType() || !state.Type().HasAttribute(name) {
+							continue
+						}
+						old := state.GetAttr(name)
+						if old.IsNull() || !old.IsKnown() || !old.Type().Equals(cty.String) {
+							continue
+						}
+						if old, new := old.AsString(), d.Get(name).(string); old != "" && old != new {
+							return ctx, sdkdiag.AppendErrorf(diags, "Unexpected Identity Change: %s changed from %q to %q", name, old, new)
+						}
+					}
+				}
+			}
+
+			if r.identity.IsARN {
+				name := r.identity.IdentityAttributes[0].Name
+				if v, ok := d.Get(name).(string); ok && v != "" {
+					if err := conns.ValidateARNIdentity(v, c.AccountID(ctx), c.Region(ctx), r.identity.IsGlobalResource); err != nil {
+						return ctx, sdkdiag.AppendErrorf(diags, "Unexpected Identity Change: %s", err)
+					}
+				}
+			}
+
+			identity, err := d.Identity()
+			if err != nil {
+				return ctx, sdkdiag.AppendFromErr(diags, err)
+			}
+
+			if !r.identity.IsARN {
+				if err := identity.Set(names.AttrAccountID, c.AccountID(ctx)); err != nil {
+					return ctx, sdkdiag.AppendErrorf(diags, "setting identity %s: %s", names.AttrAccountID, err)
+				}
+				if !r.identity.IsGlobalResource {
+					if err := identity.Set(names.AttrRegion, c.Region(ctx)); err != nil {
+						return ctx, sdkdiag.AppendErrorf(diags, "setting identity %s: %s", names.AttrRegion, err)
+					}
+				}
+			}
+			for _, name := range r.identity.AttributeNames() {
+				if err := identity.Set(name, d.Get(name)); err != nil {
+					return ctx, sdkdiag.AppendErrorf(diags, "setting identity %s: %s", name, err)
+				}
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// importIdentity returns a StateContextFunc that sets the import ID and any Region override of a resource imported by identity.
+// It must be run before any other import handling.
+func importIdentity(identity *types.ServicePackageResourceIdentity, isRegionOverrideEnabled bool, f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if d.Id() != "" {
+			return f(ctx, d, meta)
+		}
+
+		c, ok := meta.(*conns.AWSClient)
+		if !ok {
+			return f(ctx, d, meta)
+		}
+
+		data, err := d.Identity()
+		if err != nil {
+			return nil, err
+		}
+
+		values := make(map[string]string)
+		for _, attr := range identity.Attributes() {
+			if v, ok := data.GetOk(attr.Name); ok {
+				values[attr.Name] = v.(string)
+			}
+		}
+
+		id, region, err := conns.ImportIDFromIdentity(identity, values, c.AccountID(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		if region != "" {
+			if !isRegionOverrideEnabled {
+				if region != c.Region(ctx) {
+					return nil, fmt.Errorf("identity Region (%s) does not match the provider's Region (%s)", region, c.Region(ctx))
+				}
+			} else {
+				if !c.IsRegionInPartition(ctx, region) {
+					return nil, fmt.Errorf("Region (%s) is not in the configured AWS partition (%s)", region, c.Partition(ctx))
+				}
+
+				if err := d.Set(names.AttrRegion, region); err != nil {
+					return nil, fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+				}
+			}
+		}
+
+		d.SetId(id)
+
+		return f(ctx, d, meta)
+	}
+}
+
+// importARNRegion returns a StateContextFunc that uses the Region of an ARN import ID as the per-resource Region override.
+// It must be run before Context is bootstrapped and after any `@<region>` suffix has been handled.
+func importARNRegion(f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if v, ok := d.Get(names.AttrRegion).(string); !ok || v == "" {
+			if arn, err := arn.Parse(d.Id()); err == nil && arn.Region != "" {
+				if c, ok := meta.(*conns.AWSClient); ok && !c.IsRegionInPartition(ctx, arn.Region) {
+					return nil, fmt.Errorf("Region (%s) is not in the configured AWS partition (%s)", arn.Region, c.Partition(ctx))
+				}
+
+				if err := d.Set(names.AttrRegion, arn.Region); err != nil {
+					return nil, fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+				}
+			}
+		}
+
+		return f(ctx, d, meta)
+	}
+}
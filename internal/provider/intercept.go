@@ -31,6 +31,7 @@ type schemaResourceData interface {
 	GetRawState() cty.Value
 	HasChange(key string) bool
 	Id() string
+	Identity() (*schema.IdentityData, error)
 	Set(string, any) error
 }
 
@@ -92,7 +93,7 @@ func (s interceptorItems) why(why why) interceptorItems {
 func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](bootstrapContext contextFunc, interceptors interceptorItems, f F, why why) F {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		var diags diag.Diagnostics
-		ctx = bootstrapContext(ctx, meta)
+		ctx = bootstrapContext(ctx, d.GetOk, meta)
 		// Before interceptors are run first to last.
 		forward := interceptors.why(why)
 
@@ -135,7 +136,10 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 }
 
 // contextFunc augments Context.
-type contextFunc func(context.Context, any) context.Context
+type contextFunc func(context.Context, getAttributeFunc, any) context.Context
+
+// getAttributeFunc returns the value of the specified top-level attribute and whether it is set.
+type getAttributeFunc func(string) (any, bool)
 
 // wrappedDataSource represents an interceptor dispatcher for a Plugin SDK v2 data source.
 type wrappedDataSource struct {
@@ -173,7 +177,7 @@ func (r *wrappedResource) Delete(f schema.DeleteContextFunc) schema.DeleteContex
 
 func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-		ctx = r.bootstrapContext(ctx, meta)
+		ctx = r.bootstrapContext(ctx, d.GetOk, meta)
 
 		return f(ctx, d, meta)
 	}
@@ -181,7 +185,7 @@ func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFu
 
 func (r *wrappedResource) CustomizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
-		ctx = r.bootstrapContext(ctx, meta)
+		ctx = r.bootstrapContext(ctx, d.GetOk, meta)
 
 		return f(ctx, d, meta)
 	}
@@ -189,7 +193,11 @@ func (r *wrappedResource) CustomizeDiff(f schema.CustomizeDiffFunc) schema.Custo
 
 func (r *wrappedResource) StateUpgrade(f schema.StateUpgradeFunc) schema.StateUpgradeFunc {
 	return func(ctx context.Context, rawState map[string]interface{}, meta any) (map[string]interface{}, error) {
-		ctx = r.bootstrapContext(ctx, meta)
+		getAttribute := func(key string) (any, bool) {
+			v, ok := rawState[key]
+			return v, ok
+		}
+		ctx = r.bootstrapContext(ctx, getAttribute, meta)
 
 		return f(ctx, rawState, meta)
 	}
@@ -245,6 +253,17 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 
 			tagsInContext.TagsIn = option.Some(tags)
 
+			// Report configured tags that conflict with the provider's default_tags or ignore_tags configuration.
+			// Errors are normally reported while planning, by tagConflictsCustomizeDiff.
+			if config := meta.(*conns.AWSClient).TagDiagnosticsConfig(ctx); config != nil && (why == Create || d.HasChange(names.AttrTags)) {
+				findings := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})).ConfigConflicts(tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig)
+				diags = append(diags, tagFindingsDiagnostics(config.Error, cty.GetAttrPath(names.AttrTags), findings)...)
+
+				if diags.HasError() {
+					return ctx, diags
+				}
+			}
+
 			if why == Create {
 				break
 			}
@@ -359,6 +378,17 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 			// Remove any provider configured ignore_tags and system tags from those returned from the service API.
 			tags := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig)
 
+			// Report tags changed outside of Terraform since the last apply or refresh.
+			// Imported resources have no previous tags_all.
+			if config := meta.(*conns.AWSClient).TagDiagnosticsConfig(ctx); config != nil && why == Read && !d.GetRawState().IsNull() && !d.GetRawState().GetAttr(names.AttrTagsAll).IsNull() {
+				oldTags := tftags.New(ctx, d.Get(names.AttrTagsAll).(map[string]interface{})).IgnoreConfig(tagsInContext.IgnoreConfig)
+				diags = append(diags, tagFindingsDiagnostics(config.Error, cty.GetAttrPath(names.AttrTagsAll), oldTags.Drift(tags))...)
+
+				if diags.HasError() {
+					return ctx, diags
+				}
+			}
+
 			// The resource's configured tags can now include duplicate tags that have been configured on the provider.
 			if err := d.Set(names.AttrTags, tags.ResolveDuplicates(ctx, tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig, d, names.AttrTags, nil).Map()); err != nil {
 				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrTags, err)
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/list"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// ListResources enumerates existing resources of the specified type using the configured provider.
+// If includeState is true each resource's full state is read, which is only supported for Plugin SDK resources.
+func ListResources(ctx context.Context, provider *schema.Provider, typeName string, filter list.Filter, includeState bool) iter.Seq2[list.Result, error] {
+	return func(yield func(list.Result, error) bool) {
+		meta, ok := provider.Meta().(*conns.AWSClient)
+		if !ok {
+			yield(list.Result{}, fmt.Errorf("provider is not configured"))
+			return
+		}
+
+		servicePackageName, v := findListResource(ctx, typeName)
+		if v == nil {
+			yield(list.Result{}, fmt.Errorf("resource type %s does not support listing", typeName))
+			return
+		}
+
+		var r *schema.Resource
+		if includeState {
+			if r = provider.ResourcesMap[typeName]; r == nil {
+				yield(list.Result{}, fmt.Errorf("reading state for resource type %s: not supported", typeName))
+				return
+			}
+		}
+
+		ctx := conns.NewResourceContext(ctx, servicePackageName, v.Name, "")
+		ctx = meta.RegisterLogger(ctx)
+
+		for result, err := range v.List(ctx, meta, filter) {
+			if err == nil && r != nil {
+				var ok bool
+				ok, err = readListResult(ctx, r, meta, &result)
+				if err == nil && !ok {
+					// The resource was deleted after being listed.
+					continue
+				}
+			}
+
+			if !yield(result, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// findListResource returns the list resource for the specified resource type.
+func findListResource(ctx context.Context, typeName string) (string, *types.ServicePackageListResource) {
+	for _, sp := range servicePackages(ctx) {
+		if sp, ok := sp.(conns.ServicePackageWithListResources); ok {
+			for _, v := range sp.ListResources(ctx) {
+				if v.TypeName == typeName {
+					return sp.ServicePackageName(), v
+				}
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// readListResult populates the specified list result's state by importing and reading the resource.
+// It returns false if the resource no longer exists.
+func readListResult(ctx context.Context, r *schema.Resource, meta *conns.AWSClient, result *list.Result) (bool, error) {
+	d := r.Data(nil)
+	d.SetId(result.ImportID)
+
+	if v := r.Importer; v != nil && v.StateContext != nil {
+		ds, err := v.StateContext(ctx, d, meta)
+		if err != nil {
+			return false, fmt.Errorf("importing %s: %w", result.ImportID, err)
+		}
+		if len(ds) == 0 {
+			return false, nil
+		}
+		d = ds[0]
+	}
+
+	if f := r.ReadWithoutTimeout; f != nil {
+		if diags := f(ctx, d, meta); diags.HasError() {
+			return false, fmt.Errorf("reading %s: %w", result.ImportID, sdkdiag.DiagnosticsError(diags))
+		}
+	}
+
+	if d.Id() == "" {
+		return false, nil
+	}
+
+	result.State = d.State().Attributes
+
+	return true, nil
+}
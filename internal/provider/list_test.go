@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestListResourcesHaveResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	for _, sp := range servicePackages(ctx) {
+		sp, ok := sp.(conns.ServicePackageWithListResources)
+		if !ok {
+			continue
+		}
+
+		typeNames := make(map[string]bool)
+		for _, v := range sp.FrameworkResources(ctx) {
+			typeNames[v.TypeName] = true
+		}
+		for _, v := range sp.SDKResources(ctx) {
+			typeNames[v.TypeName] = true
+		}
+
+		for _, v := range sp.ListResources(ctx) {
+			if !typeNames[v.TypeName] {
+				t.Errorf("list resource %s (%s) has no corresponding resource", v.TypeName, sp.ServicePackageName())
+			}
+			if v.List == nil {
+				t.Errorf("list resource %s (%s) has no list function", v.TypeName, sp.ServicePackageName())
+			}
+		}
+	}
+}
+
+func TestFindListResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	for _, typeName := range []string{"aws_iam_role", "aws_instance", "aws_s3_bucket"} {
+		if _, v := findListResource(ctx, typeName); v == nil {
+			t.Errorf("no list resource found for %s", typeName)
+		}
+	}
+
+	if _, v := findListResource(ctx, "aws_no_such_resource"); v != nil {
+		t.Errorf("unexpected list resource found for aws_no_such_resource")
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/preflight"
+)
+
+// preflightCustomizeDiff returns a CustomizeDiffFunc that runs the specified preflight checks.
+// Plugin SDK v2 CustomizeDiff functions cannot return warnings, so any warnings are logged.
+func preflightCustomizeDiff(typeName string, checks []*preflight.Check) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		c, ok := meta.(*conns.AWSClient)
+		if !ok || c.PreflightConfig(ctx) == nil {
+			return nil
+		}
+
+		diags := preflight.Run(ctx, c, checks, preflight.Request{
+			TypeName: typeName,
+			IsCreate: d.Id() == "",
+			Plan:     preflight.NewResourceDiffValues(d),
+		})
+
+		for _, v := range diags.Warnings() {
+			tflog.Warn(ctx, "Preflight check warning", map[string]any{
+				"type_name": typeName,
+				"warning":   fwdiag.DiagnosticString(v),
+			})
+		}
+
+		return fwdiag.DiagnosticsError(diags)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -19,14 +20,17 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/preflight"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -52,6 +56,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
+			"api_rate_limits":               apiRateLimitsSchema(),
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 			"custom_ca_bundle": {
@@ -152,6 +157,22 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. " +
 					"Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"preflight": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to run read-only checks of planned resource values against live account state during planning.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"skip_checks": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Names of preflight checks that are not run.",
+						},
+					},
+				},
+			},
 			"profile": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -234,6 +255,76 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_diagnostics": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to report resource tags that conflict with the provider's tag configuration or that have been changed outside of Terraform.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"severity": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(tagDiagnosticsSeverity_Values(), false),
+							Description:  "Severity of the reported diagnostics. Valid values are `warning` and `error`. Defaults to `warning`.",
+						},
+					},
+				},
+			},
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with a tagging policy evaluated against the tags of all taggable resources during planning.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_values": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Values allowed for a tag key.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrKey: {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Tag key.",
+									},
+									names.AttrValues: {
+										Type:        schema.TypeSet,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Allowed tag values. A value ending in `*` allows any value with that prefix.",
+									},
+								},
+							},
+						},
+						"key_pattern": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsValidRegExp,
+							Description:  "Regular expression that all tag keys must match.",
+						},
+						"policy_document": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
+							Description:  "AWS Organizations tag policy document to import rules from.",
+						},
+						"required_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Tag keys that all taggable resources must have.",
+						},
+						"severity": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(tagDiagnosticsSeverity_Values(), false),
+							Description:  "Severity of the reported policy violations. Valid values are `warning` and `error`. Defaults to `error`. Warnings for Plugin SDK resources are only logged.",
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -269,9 +360,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 	}
 
 	var errs []error
+	sps := servicePackages(ctx)
 	servicePackageMap := make(map[string]conns.ServicePackage)
 
-	for _, sp := range servicePackages(ctx) {
+	preflightChecks, err := preflight.NewRegistryFromServicePackages(ctx, sps)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sp := range sps {
 		servicePackageName := sp.ServicePackageName()
 		servicePackageMap[servicePackageName] = sp
 
@@ -291,9 +388,16 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				continue
 			}
 
+			// Resources that already define a top-level `region` attribute, e.g. for cross-Region functionality, don't support Region override.
+			isRegionOverrideEnabled := conns.IsRegionOverrideEnabled(servicePackageName, v.Region) && !hasRegionAttribute(r)
+
 			// bootstrapContext is run on all wrapped methods before any interceptors.
-			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+			bootstrapContext := func(ctx context.Context, getAttribute getAttributeFunc, meta any) context.Context {
+				var overrideRegion string
+				if isRegionOverrideEnabled {
+					overrideRegion = getRegionAttribute(getAttribute)
+				}
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, overrideRegion)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 					ctx = v.RegisterLogger(ctx)
@@ -327,6 +431,16 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			if isRegionOverrideEnabled {
+				injectRegionAttribute(r, regionDataSourceSchema)
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: regionInterceptor{},
+				})
+			}
+
 			ds := &wrappedDataSource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -367,9 +481,17 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				continue
 			}
 
+			// Resources that already define a top-level `region` attribute, e.g. for cross-Region functionality, don't support Region override.
+			isRegionOverrideEnabled := conns.IsRegionOverrideEnabled(servicePackageName, v.Region) && !hasRegionAttribute(r)
+			identity := v.Identity
+
 			// bootstrapContext is run on all wrapped methods before any interceptors.
-			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+			bootstrapContext := func(ctx context.Context, getAttribute getAttributeFunc, meta any) context.Context {
+				var overrideRegion string
+				if isRegionOverrideEnabled {
+					overrideRegion = getRegionAttribute(getAttribute)
+				}
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 					ctx = v.RegisterLogger(ctx)
@@ -414,6 +536,28 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			if isRegionOverrideEnabled {
+				injectRegionAttribute(r, regionResourceSchema)
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Create | Read | Update,
+					interceptor: regionInterceptor{},
+				})
+			}
+
+			if identity != nil {
+				r.Identity = newResourceIdentity(identity)
+
+				interceptors = append(interceptors, interceptorItem{
+					when: After,
+					why:  Create | Read | Update,
+					interceptor: identityInterceptor{
+						identity: identity,
+					},
+				})
+			}
+
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -434,11 +578,47 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			if v := r.Importer; v != nil {
 				if v := v.StateContext; v != nil {
 					r.Importer.StateContext = rs.State(v)
+					if isRegionOverrideEnabled {
+						if identity != nil && identity.IsARN && !identity.IsGlobalResource {
+							// An ARN import ID's Region is used when there is no Region suffix.
+							r.Importer.StateContext = importARNRegion(r.Importer.StateContext)
+						}
+						// The import ID's Region suffix must be handled before Context is bootstrapped.
+						r.Importer.StateContext = importRegion(r.Importer.StateContext)
+					}
+					if identity != nil {
+						// The import ID and Region of a resource imported by identity are set before any other import handling.
+						r.Importer.StateContext = importIdentity(identity, isRegionOverrideEnabled, r.Importer.StateContext)
+					}
+				}
+			}
+			if v.Tags != nil {
+				// Tag configuration conflicts and the tagging policy are evaluated after any resource-specific plan customization.
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(v, tagConflictsCustomizeDiff, tagPolicyCustomizeDiff(typeName))
+				} else {
+					r.CustomizeDiff = customdiff.Sequence(tagConflictsCustomizeDiff, tagPolicyCustomizeDiff(typeName))
+				}
+			}
+			if checks := preflightChecks.Checks(typeName); len(checks) > 0 {
+				// Preflight checks are run after any resource-specific plan customization.
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(v, preflightCustomizeDiff(typeName, checks))
+				} else {
+					r.CustomizeDiff = preflightCustomizeDiff(typeName, checks)
 				}
 			}
 			if v := r.CustomizeDiff; v != nil {
 				r.CustomizeDiff = rs.CustomizeDiff(v)
 			}
+			if isRegionOverrideEnabled {
+				// The default Region must be set without any per-resource Region override in Context.
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(defaultRegion, v)
+				} else {
+					r.CustomizeDiff = defaultRegion
+				}
+			}
 			for _, stateUpgrader := range r.StateUpgraders {
 				if v := stateUpgrader.Upgrade; v != nil {
 					stateUpgrader.Upgrade = rs.StateUpgrade(v)
@@ -518,6 +698,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("api_rate_limits"); ok && len(v.([]any)) > 0 {
+		apiRateLimits, dx := expandAPIRateLimits(ctx, cty.GetAttrPath("api_rate_limits"), v.([]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.APIRateLimits = apiRateLimits
+	}
+
 	if v, ok := d.GetOk("assume_role"); ok {
 		path := cty.GetAttrPath("assume_role")
 		v := v.([]any)
@@ -602,6 +791,22 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("preflight"); ok && len(v.([]any)) > 0 {
+		config.Preflight = expandPreflight(v.([]any)[0])
+	}
+
+	if v, ok := d.GetOk("tag_diagnostics"); ok && len(v.([]any)) > 0 {
+		config.TagDiagnosticsConfig = expandTagDiagnostics(v.([]any)[0])
+	}
+
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]any)) > 0 {
+		tagPolicy, err := expandTagPolicy(v.([]any)[0])
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.TagPolicyConfig = tagPolicy
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -624,6 +829,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	} else {
 		meta = new(conns.AWSClient)
 	}
+
+	// Provider-level recording or replaying of AWS API interactions.
+	if httpClient, err := vcr.ProviderHTTPClient(ctx); err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	} else if httpClient != nil {
+		meta.SetHTTPClient(ctx, httpClient)
+	}
+
 	meta, ds := config.ConfigureProvider(ctx, meta)
 	diags = append(diags, ds...)
 
@@ -634,6 +847,178 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	return meta, diags
 }
 
+func apiRateLimitsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with settings to limit the rate and concurrency of AWS API requests per service.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of requests that can be made at once. Defaults to `requests_per_second`, rounded up.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"max_in_flight": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of concurrent requests.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Description:  "The sustained rate of requests per second.",
+					ValidateFunc: validation.FloatAtLeast(0),
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The service package name, e.g. `route53`.",
+					ValidateFunc: validation.StringInSlice(names.ProviderPackages(), false),
+				},
+			},
+		},
+	}
+}
+
+func expandAPIRateLimits(_ context.Context, path cty.Path, tfList []any) (map[string]conns.APIRateLimit, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	apiRateLimits := make(map[string]conns.APIRateLimit)
+
+	for i, v := range tfList {
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		path := path.IndexInt(i)
+		servicePackageName := tfMap["service"].(string)
+		if _, ok := apiRateLimits[servicePackageName]; ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(path.GetAttr("service"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Duplicate API rate limits for service %q", servicePackageName),
+			))
+			continue
+		}
+
+		apiRateLimit := conns.APIRateLimit{}
+		if v, ok := tfMap["burst"].(int); ok {
+			apiRateLimit.Burst = v
+		}
+		if v, ok := tfMap["max_in_flight"].(int); ok {
+			apiRateLimit.MaxInFlight = v
+		}
+		if v, ok := tfMap["requests_per_second"].(float64); ok {
+			apiRateLimit.RequestsPerSecond = v
+		}
+
+		if apiRateLimit.MaxInFlight == 0 && apiRateLimit.RequestsPerSecond == 0 {
+			diags = append(diags, errs.NewAttributeWarningDiagnostic(path,
+				"Ineffective API rate limits",
+				fmt.Sprintf("Neither requests_per_second nor max_in_flight is set for service %q. No limits will be applied.", servicePackageName),
+			))
+			continue
+		}
+
+		apiRateLimits[servicePackageName] = apiRateLimit
+	}
+
+	return apiRateLimits, diags
+}
+
+func expandPreflight(tfMap any) *conns.PreflightConfig {
+	config := &conns.PreflightConfig{}
+
+	// An empty `preflight {}` block enables all checks.
+	if tfMap, ok := tfMap.(map[string]any); ok {
+		if v, ok := tfMap["skip_checks"].(*schema.Set); ok && v.Len() > 0 {
+			config.SkipChecks = flex.ExpandStringValueSet(v)
+		}
+	}
+
+	return config
+}
+
+const (
+	tagDiagnosticsSeverityError   = "error"
+	tagDiagnosticsSeverityWarning = "warning"
+)
+
+func tagDiagnosticsSeverity_Values() []string {
+	return []string{
+		tagDiagnosticsSeverityError,
+		tagDiagnosticsSeverityWarning,
+	}
+}
+
+func expandTagDiagnostics(tfMap any) *tftags.DiagnosticsConfig {
+	config := &tftags.DiagnosticsConfig{}
+
+	// An empty `tag_diagnostics {}` block reports warnings.
+	if tfMap, ok := tfMap.(map[string]any); ok {
+		if v, ok := tfMap["severity"].(string); ok {
+			config.Error = v == tagDiagnosticsSeverityError
+		}
+	}
+
+	return config
+}
+
+func expandTagPolicy(tfMapRaw any) (*tftags.PolicyConfig, error) {
+	config := &tftags.PolicyConfig{
+		Error: true,
+	}
+
+	tfMap, ok := tfMapRaw.(map[string]any)
+	if !ok {
+		return config, nil
+	}
+
+	if v, ok := tfMap["allowed_values"].(*schema.Set); ok && v.Len() > 0 {
+		config.AllowedValues = make(map[string][]string)
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			key, _ := tfMap[names.AttrKey].(string)
+			if values, ok := tfMap[names.AttrValues].(*schema.Set); ok {
+				config.AllowedValues[key] = flex.ExpandStringValueSet(values)
+			}
+		}
+	}
+
+	if v, ok := tfMap["key_pattern"].(string); ok && v != "" {
+		re, err := regexp.Compile(v)
+		if err != nil {
+			return nil, fmt.Errorf("compiling tag_policy key_pattern: %w", err)
+		}
+		config.KeyPattern = re
+	}
+
+	if v, ok := tfMap["required_keys"].(*schema.Set); ok && v.Len() > 0 {
+		config.RequiredKeys = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["severity"].(string); ok && v != "" {
+		config.Error = v == tagDiagnosticsSeverityError
+	}
+
+	// Rules configured in the provider take precedence over those in the document.
+	if v, ok := tfMap["policy_document"].(string); ok && v != "" {
+		policy, err := tftags.NewPolicyConfigFromOrganizationsDocument(v)
+		if err != nil {
+			return nil, err
+		}
+		config.Merge(policy)
+	}
+
+	return config, nil
+}
+
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
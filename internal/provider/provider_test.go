@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -71,6 +73,252 @@ func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandAPIRateLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := cty.GetAttrPath("api_rate_limits")
+
+	testcases := map[string]struct {
+		tfList        []any
+		expected      map[string]conns.APIRateLimit
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			tfList:   []any{},
+			expected: map[string]conns.APIRateLimit{},
+		},
+		"multiple services": {
+			tfList: []any{
+				map[string]any{
+					"service":             names.Route53,
+					"requests_per_second": 5.0,
+					"burst":               10,
+					"max_in_flight":       0,
+				},
+				map[string]any{
+					"service":             names.Organizations,
+					"requests_per_second": 0.0,
+					"burst":               0,
+					"max_in_flight":       2,
+				},
+			},
+			expected: map[string]conns.APIRateLimit{
+				names.Route53: {
+					Burst:             10,
+					RequestsPerSecond: 5,
+				},
+				names.Organizations: {
+					MaxInFlight: 2,
+				},
+			},
+		},
+		"duplicate service": {
+			tfList: []any{
+				map[string]any{
+					"service":             names.IAM,
+					"requests_per_second": 5.0,
+				},
+				map[string]any{
+					"service":             names.IAM,
+					"requests_per_second": 10.0,
+				},
+			},
+			expected: map[string]conns.APIRateLimit{
+				names.IAM: {
+					RequestsPerSecond: 5,
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(path.IndexInt(1).GetAttr("service"),
+					"Invalid Attribute Value",
+					`Duplicate API rate limits for service "iam"`,
+				),
+			},
+		},
+		"no limits": {
+			tfList: []any{
+				map[string]any{
+					"service": names.IAM,
+				},
+			},
+			expected: map[string]conns.APIRateLimit{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeWarningDiagnostic(path.IndexInt(0),
+					"Ineffective API rate limits",
+					`Neither requests_per_second nor max_in_flight is set for service "iam". No limits will be applied.`,
+				),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := expandAPIRateLimits(ctx, path, testcase.tfList)
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+			if diff := cmp.Diff(results, testcase.expected); diff != "" {
+				t.Errorf("unexpected results difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandPreflight(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		tfMap    any
+		expected *conns.PreflightConfig
+	}{
+		"empty block": {
+			tfMap:    nil,
+			expected: &conns.PreflightConfig{},
+		},
+		"no skipped checks": {
+			tfMap: map[string]any{
+				"skip_checks": schema.NewSet(schema.HashString, []any{}),
+			},
+			expected: &conns.PreflightConfig{},
+		},
+		"skipped checks": {
+			tfMap: map[string]any{
+				"skip_checks": schema.NewSet(schema.HashString, []any{"kms_key_state"}),
+			},
+			expected: &conns.PreflightConfig{
+				SkipChecks: []string{"kms_key_state"},
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(expandPreflight(testcase.tfMap), testcase.expected); diff != "" {
+				t.Errorf("unexpected results difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandTagDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		tfMap    any
+		expected *tftags.DiagnosticsConfig
+	}{
+		"empty block": {
+			tfMap:    nil,
+			expected: &tftags.DiagnosticsConfig{},
+		},
+		"warning": {
+			tfMap: map[string]any{
+				"severity": "warning",
+			},
+			expected: &tftags.DiagnosticsConfig{},
+		},
+		"error": {
+			tfMap: map[string]any{
+				"severity": "error",
+			},
+			expected: &tftags.DiagnosticsConfig{
+				Error: true,
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(expandTagDiagnostics(testcase.tfMap), testcase.expected); diff != "" {
+				t.Errorf("unexpected results difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		tfMap       any
+		expected    *tftags.PolicyConfig
+		expectedErr bool
+	}{
+		"empty block": {
+			tfMap: nil,
+			expected: &tftags.PolicyConfig{
+				Error: true,
+			},
+		},
+		"rules": {
+			tfMap: map[string]any{
+				"allowed_values": schema.NewSet(schema.HashResource(&schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKey:    {Type: schema.TypeString},
+						names.AttrValues: {Type: schema.TypeSet, Elem: &schema.Schema{Type: schema.TypeString}},
+					},
+				}), []any{
+					map[string]any{
+						names.AttrKey:    "Environment",
+						names.AttrValues: schema.NewSet(schema.HashString, []any{"prod"}),
+					},
+				}),
+				"required_keys": schema.NewSet(schema.HashString, []any{"Owner"}),
+				"severity":      "warning",
+				"policy_document": `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {"@@assign": "CostCenter"},
+      "report_required_tag_for": {"@@assign": ["ec2:instance"]}
+    },
+    "environment": {
+      "tag_key": {"@@assign": "Environment"},
+      "tag_value": {"@@assign": ["dev", "prod"]}
+    }
+  }
+}`,
+			},
+			expected: &tftags.PolicyConfig{
+				AllowedValues: map[string][]string{
+					"Environment": {"prod"},
+				},
+				CanonicalKeys: []string{"CostCenter", "Environment"},
+				RequiredKeys:  []string{"Owner"},
+			},
+		},
+		"invalid policy document": {
+			tfMap: map[string]any{
+				"policy_document": `{"tags": []}`,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := expandTagPolicy(testcase.tfMap)
+
+			if got, want := err != nil, testcase.expectedErr; got != want {
+				t.Fatalf("expandTagPolicy err %t, want %t", got, want)
+			}
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("unexpected results difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := []struct {
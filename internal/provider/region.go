@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// regionDataSourceSchema returns the schema for the per-resource `region` argument of a data source.
+func regionDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The AWS Region to use for API operations. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// regionResourceSchema returns the schema for the per-resource `region` argument of a resource.
+func regionResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// hasRegionAttribute returns whether the specified resource's schema has a top-level `region` attribute.
+func hasRegionAttribute(r *schema.Resource) bool {
+	_, ok := r.SchemaMap()[names.AttrRegion]
+	return ok
+}
+
+// injectRegionAttribute adds the per-resource `region` argument to the specified resource's schema.
+func injectRegionAttribute(r *schema.Resource, regionSchema func() *schema.Schema) {
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			s := f()
+			s[names.AttrRegion] = regionSchema()
+			return s
+		}
+	} else {
+		r.Schema[names.AttrRegion] = regionSchema()
+	}
+}
+
+// getRegionAttribute returns the per-resource Region override, if any, using the specified attribute getter.
+func getRegionAttribute(getAttribute getAttributeFunc) string {
+	if getAttribute == nil {
+		return ""
+	}
+
+	if v, ok := getAttribute(names.AttrRegion); ok {
+		if v, ok := v.(string); ok {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// regionInterceptor implements the per-resource `region` argument for data sources and resources.
+// The Region override itself is placed in Context by the bootstrap function.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		switch why {
+		case Create, Read, Update:
+			if v, ok := d.Get(names.AttrRegion).(string); ok && v != "" {
+				if !c.IsRegionInPartition(ctx, v) {
+					return ctx, sdkdiag.AppendErrorf(diags, "Region (%s) is not in the configured AWS partition (%s)", v, c.Partition(ctx))
+				}
+			}
+		}
+	case After:
+		switch why {
+		case Create, Read, Update:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			// Record the effective Region in state.
+			if err := d.Set(names.AttrRegion, c.Region(ctx)); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// defaultRegion is a CustomizeDiffFunc that defaults a resource's `region` argument to the Region set in the provider configuration.
+// It must not be run with a per-resource Region override in Context.
+func defaultRegion(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return nil
+	}
+
+	if v := d.GetRawConfig().GetAttr(names.AttrRegion); !v.IsNull() {
+		if v.IsKnown() {
+			if region := v.AsString(); !c.IsRegionInPartition(ctx, region) {
+				return fmt.Errorf("Region (%s) is not in the configured AWS partition (%s)", region, c.Partition(ctx))
+			}
+		}
+
+		return nil
+	}
+
+	// Resources created before the introduction of the `region` argument have no Region in state.
+	// The value is set on the next Read.
+	if d.Id() != "" && d.Get(names.AttrRegion).(string) == "" {
+		return nil
+	}
+
+	if providerRegion := c.Region(ctx); d.Get(names.AttrRegion).(string) != providerRegion {
+		return d.SetNew(names.AttrRegion, providerRegion)
+	}
+
+	return nil
+}
+
+// importRegion returns a StateContextFunc that handles an optional `@<region>` suffix on the import ID.
+// The suffix is removed from the ID before the wrapped function is called.
+func importRegion(f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if id, region, ok := conns.ParseImportIDRegion(d.Id()); ok {
+			if c, ok := meta.(*conns.AWSClient); ok && !c.IsRegionInPartition(ctx, region) {
+				return nil, fmt.Errorf("Region (%s) is not in the configured AWS partition (%s)", region, c.Partition(ctx))
+			}
+
+			d.SetId(id)
+			if err := d.Set(names.AttrRegion, region); err != nil {
+				return nil, fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+			}
+		}
+
+		return f(ctx, d, meta)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// tagPolicyCustomizeDiff returns a CustomizeDiffFunc that evaluates the provider's tagging policy against a resource's planned `tags_all`.
+// Plugin SDK v2 CustomizeDiff functions cannot return warnings, so any warnings are logged.
+func tagPolicyCustomizeDiff(typeName string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		c, ok := meta.(*conns.AWSClient)
+		if !ok {
+			return nil
+		}
+
+		policy := c.TagPolicyConfig(ctx)
+		if policy == nil {
+			return nil
+		}
+
+		// The policy is evaluated once all configured tags are known.
+		if !d.GetRawConfig().GetAttr(names.AttrTags).IsWhollyKnown() {
+			return nil
+		}
+
+		tagsInContext, ok := tftags.FromContext(ctx)
+		if !ok {
+			return nil
+		}
+
+		tagsAll := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]any)))
+		diags := tagFindingsDiagnostics(policy.Error, cty.GetAttrPath(names.AttrTags), tagsAll.PolicyViolations(policy))
+
+		for _, v := range sdkdiag.Warnings(diags) {
+			tflog.Warn(ctx, "Tag policy violation", map[string]any{
+				"type_name": typeName,
+				"warning":   sdkdiag.DiagnosticString(v),
+			})
+		}
+
+		return sdkdiag.DiagnosticsError(diags)
+	}
+}
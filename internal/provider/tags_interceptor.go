@@ -7,8 +7,10 @@ import (
 	"context"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -170,3 +172,58 @@ func tagsReadFunc(ctx context.Context, d schemaResourceData, sp conns.ServicePac
 
 	return ctx, diags
 }
+
+// tagFindingsDiagnostics returns the specified resource tag findings as warning or error diagnostics.
+func tagFindingsDiagnostics(isError bool, path cty.Path, findings []tftags.Finding) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	severity := diag.Warning
+	if isError {
+		severity = diag.Error
+	}
+
+	for _, finding := range findings {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      severity,
+			Summary:       finding.Summary,
+			Detail:        finding.Detail,
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+// tagConflictsCustomizeDiff returns a CustomizeDiffFunc that fails the plan if a resource's configured tags conflict with the provider's
+// default_tags or ignore_tags configuration and tag_diagnostics has a severity of error.
+// Plugin SDK v2 CustomizeDiff functions cannot return warnings, so warnings are reported by the tags interceptor on Create and Update.
+func tagConflictsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return nil
+	}
+
+	config := c.TagDiagnosticsConfig(ctx)
+	if config == nil || !config.Error {
+		return nil
+	}
+
+	// Conflicts are reported once all configured tags are known.
+	if !d.GetRawConfig().GetAttr(names.AttrTags).IsWhollyKnown() {
+		return nil
+	}
+
+	// Only report on create or when the configured tags change.
+	if d.Id() != "" && !d.HasChange(names.AttrTags) {
+		return nil
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	findings := tftags.New(ctx, d.Get(names.AttrTags).(map[string]any)).ConfigConflicts(tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig)
+
+	return sdkdiag.DiagnosticsError(tagFindingsDiagnostics(config.Error, cty.GetAttrPath(names.AttrTags), findings))
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -92,7 +93,7 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}))
 
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
-		ctx = conns.NewResourceContext(ctx, "Test", "aws_test")
+		ctx = conns.NewResourceContext(ctx, "Test", "aws_test", "")
 		if v, ok := meta.(*conns.AWSClient); ok {
 			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 		}
@@ -134,6 +135,10 @@ func (d *resourceData) GetRawState() cty.Value { // nosemgrep:ci.aws-in-func-nam
 	return cty.Value{}
 }
 
+func (d *resourceData) Identity() (*schema.IdentityData, error) {
+	return nil, nil
+}
+
 func (d *resourceData) Get(key string) any {
 	return nil
 }
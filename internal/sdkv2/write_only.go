@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Write-only arguments are sent to the AWS API on create and update but are never persisted in Terraform plan or state.
+// Their values are only available from configuration and may be ephemeral. They require Terraform v1.11.0 or later.
+// A write-only argument named `<name>_wo` is paired with a required `<name>_wo_version` argument whose value
+// must be changed to send a new value of the write-only argument to the AWS API.
+
+const (
+	// WriteOnlyVersionAttributeSuffix is the suffix of the argument that triggers an update of a write-only argument.
+	WriteOnlyVersionAttributeSuffix = "_version"
+)
+
+// WriteOnlyStringSchema returns the schema for the specified optional, sensitive write-only string argument.
+// Its value must be read from configuration with GetWriteOnlyString.
+func WriteOnlyStringSchema(writeOnlyAttributeName string, conflictsWith ...string) *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		WriteOnly:     true,
+		ConflictsWith: conflictsWith,
+		RequiredWith:  []string{writeOnlyAttributeName + WriteOnlyVersionAttributeSuffix},
+	}
+}
+
+// WriteOnlyVersionSchema returns the schema for the argument that triggers an update of the specified write-only argument.
+// Versions start at 1 so that a configured version is always distinguishable from an unset one.
+func WriteOnlyVersionSchema(writeOnlyAttributeName string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		RequiredWith: []string{writeOnlyAttributeName},
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+}
+
+// GetWriteOnlyString returns the configured value of the specified top-level write-only string argument.
+// An empty string is returned if the argument is not configured.
+func GetWriteOnlyString(d *schema.ResourceData, writeOnlyAttributeName string) string {
+	v := d.GetRawConfig()
+	if !v.IsKnown() || v.IsNull() || !v.Type().IsObjectType() || !v.Type().HasAttribute(writeOnlyAttributeName) {
+		return ""
+	}
+
+	v = v.GetAttr(writeOnlyAttributeName)
+	if !v.IsKnown() || v.IsNull() || !v.Type().Equals(cty.String) {
+		return ""
+	}
+
+	return v.AsString()
+}
+
+// HasWriteOnlyChange returns whether a new value of the specified write-only argument must be sent to the AWS API.
+func HasWriteOnlyChange(d *schema.ResourceData, writeOnlyAttributeName string) bool {
+	return d.HasChange(writeOnlyAttributeName + WriteOnlyVersionAttributeSuffix)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func writeOnlyTestResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password_wo"},
+			},
+			"password_wo":         WriteOnlyStringSchema("password_wo", "password"),
+			"password_wo_version": WriteOnlyVersionSchema("password_wo"),
+		},
+	}
+}
+
+func TestWriteOnlySchema(t *testing.T) {
+	t.Parallel()
+
+	r := writeOnlyTestResource()
+
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Fatalf("InternalValidate: %s", err)
+	}
+
+	if !r.Schema["password_wo"].WriteOnly {
+		t.Error("password_wo is not write-only")
+	}
+}
+
+func TestWriteOnlyValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config        map[string]any
+		expectedError bool
+	}{
+		"value and version": {
+			config: map[string]any{
+				"password_wo":         "secret",
+				"password_wo_version": 1,
+			},
+		},
+		"value without version": {
+			config: map[string]any{
+				"password_wo": "secret",
+			},
+			expectedError: true,
+		},
+		"version without value": {
+			config: map[string]any{
+				"password_wo_version": 1,
+			},
+			expectedError: true,
+		},
+		"zero version": {
+			config: map[string]any{
+				"password_wo":         "secret",
+				"password_wo_version": 0,
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := writeOnlyTestResource().Validate(terraform.NewResourceConfigRaw(testCase.config))
+
+			if got, want := diags.HasError(), testCase.expectedError; got != want {
+				t.Errorf("Validate error = %t, want %t: %v", got, want, diags)
+			}
+		})
+	}
+}
+
+func TestGetWriteOnlyString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rawConfig cty.Value
+		expected  string
+	}{
+		"null config": {
+			rawConfig: cty.NullVal(cty.Object(map[string]cty.Type{
+				"password_wo": cty.String,
+			})),
+		},
+		"not configured": {
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"password_wo": cty.NullVal(cty.String),
+			}),
+		},
+		"unknown": {
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"password_wo": cty.UnknownVal(cty.String),
+			}),
+		},
+		"configured": {
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"password_wo": cty.StringVal("secret"),
+			}),
+			expected: "secret",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := writeOnlyTestResource().Data(&terraform.InstanceState{
+				ID:        "test",
+				RawConfig: testCase.rawConfig,
+			})
+
+			if got, want := GetWriteOnlyString(d, "password_wo"), testCase.expected; got != want {
+				t.Errorf("GetWriteOnlyString = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -31,6 +31,19 @@ func GreaterThanOrEqual(s1, s2 string) bool {
 	return v1.GreaterThanOrEqual(v2)
 }
 
+// Compare returns -1, 0 or 1 depending on whether the first version string is less than,
+// equal to or greater than the second according to Semantic Versioning rules (https://semver.org/).
+// An error is returned if either version string cannot be parsed.
+func Compare(s1, s2 string) (int, error) {
+	v1, v2, err := parseVersions(s1, s2)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return v1.Compare(v2), nil
+}
+
 func parseVersions(s1, s2 string) (*gversion.Version, *gversion.Version, error) {
 	v1, err := gversion.NewVersion(s1)
 
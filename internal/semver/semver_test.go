@@ -48,3 +48,28 @@ func TestSemVerGreaterThanOrEqual(t *testing.T) {
 		}
 	}
 }
+
+func TestSemVerCompare(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		s1      string
+		s2      string
+		n       int
+		wantErr bool
+	}{
+		{"1.0", "2.0", -1, false},
+		{"3.0", "2.0", 1, false},
+		{"4.0", "4.0", 0, false},
+		{"2", "10", -1, false},
+		{"abc", "xyz", 0, true},
+	} {
+		n, err := Compare(tc.s1, tc.s2)
+		if got, want := err != nil, tc.wantErr; got != want {
+			t.Fatalf("SemVerCompare(%q, %q) error: %v", tc.s1, tc.s2, err)
+		}
+		if tc.n != n {
+			t.Fatalf("SemVerCompare(%q, %q) should be: %d", tc.s1, tc.s2, tc.n)
+		}
+	}
+}
@@ -11,7 +11,12 @@ var (
 	ResourceAgentCollaborator             = newAgentCollaboratorResource
 	ResourceAgentKnowledgeBaseAssociation = newAgentKnowledgeBaseAssociationResource
 	ResourceDataSource                    = newDataSourceResource
+	ResourceFlow                          = newFlowResource
+	ResourceFlowAlias                     = newFlowAliasResource
+	ResourceFlowVersion                   = newFlowVersionResource
 	ResourceKnowledgeBase                 = newKnowledgeBaseResource
+	ResourcePrompt                        = newPromptResource
+	ResourcePromptVersion                 = newPromptVersionResource
 
 	FindAgentByID                                  = findAgentByID
 	FindAgentActionGroupByThreePartKey             = findAgentActionGroupByThreePartKey
@@ -19,5 +24,10 @@ var (
 	FindAgentCollaboratorByThreePartKey            = findAgentCollaboratorByThreePartKey
 	FindAgentKnowledgeBaseAssociationByThreePartID = findAgentKnowledgeBaseAssociationByThreePartKey
 	FindDataSourceByTwoPartKey                     = findDataSourceByTwoPartKey
+	FindFlowAliasByTwoPartKey                      = findFlowAliasByTwoPartKey
+	FindFlowByID                                   = findFlowByID
+	FindFlowVersionByTwoPartKey                    = findFlowVersionByTwoPartKey
 	FindKnowledgeBaseByID                          = findKnowledgeBaseByID
+	FindPromptByID                                 = findPromptByID
+	FindPromptByTwoPartKey                         = findPromptByTwoPartKey
 )
@@ -0,0 +1,959 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent/document"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_bedrockagent_flow", name="Flow")
+// @Tags(identifierAttribute="arn")
+func newFlowResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &flowResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type flowResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*flowResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrockagent_flow"
+}
+
+func (r *flowResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_encryption_key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			names.AttrExecutionRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^([0-9a-zA-Z][_-]?){1,100}$`), "valid characters are a-z, A-Z, 0-9, _ (underscore) and - (hyphen). The name can have up to 100 characters"),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FlowStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrVersion: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[flowDefinitionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"connection": flowConnectionBlock(ctx),
+						"node":       flowNodeBlock(ctx),
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func flowConnectionBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[flowConnectionModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(100),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrName: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrSource: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrTarget: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrType: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.FlowConnectionType](),
+					Required:   true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				names.AttrConfiguration: schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[flowConnectionConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"conditional": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[flowConditionalConnectionConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+									listvalidator.ExactlyOneOf(
+										path.MatchRelative().AtParent().AtName("conditional"),
+										path.MatchRelative().AtParent().AtName("data"),
+									),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										names.AttrCondition: schema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+							},
+							"data": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[flowDataConnectionConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"source_output": schema.StringAttribute{
+											Required: true,
+										},
+										"target_input": schema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flowNodeBlock(ctx context.Context) schema.ListNestedBlock {
+	// Nodes whose configuration has no arguments are configured with an empty block, e.g. `input {}`.
+	emptyConfigurationBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[flowEmptyNodeConfigurationModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+		}
+	}
+	s3ServiceConfigurationBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeServiceConfigurationModel](ctx),
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtLeast(1),
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Blocks: map[string]schema.Block{
+					"s3": schema.ListNestedBlock{
+						CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeS3ConfigurationModel](ctx),
+						Validators: []validator.List{
+							listvalidator.IsRequired(),
+							listvalidator.SizeAtLeast(1),
+							listvalidator.SizeAtMost(1),
+						},
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								names.AttrBucketName: schema.StringAttribute{
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	guardrailConfigurationBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[guardrailConfigurationModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"guardrail_identifier": schema.StringAttribute{
+						Required: true,
+					},
+					"guardrail_version": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+		}
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrName: schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z]([_]?[0-9a-zA-Z]){0,49}$`), "must start with a letter and contain only alphanumeric characters and single underscores. The name can have up to 50 characters"),
+					},
+				},
+				names.AttrType: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.FlowNodeType](),
+					Required:   true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				names.AttrConfiguration: schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"agent": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[agentFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+									listvalidator.ExactlyOneOf(
+										path.MatchRelative().AtParent().AtName("agent"),
+										path.MatchRelative().AtParent().AtName("collector"),
+										path.MatchRelative().AtParent().AtName(names.AttrCondition),
+										path.MatchRelative().AtParent().AtName("input"),
+										path.MatchRelative().AtParent().AtName("iterator"),
+										path.MatchRelative().AtParent().AtName("knowledge_base"),
+										path.MatchRelative().AtParent().AtName("lambda_function"),
+										path.MatchRelative().AtParent().AtName("lex"),
+										path.MatchRelative().AtParent().AtName("output"),
+										path.MatchRelative().AtParent().AtName("prompt"),
+										path.MatchRelative().AtParent().AtName("retrieval"),
+										path.MatchRelative().AtParent().AtName("storage"),
+									),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"agent_alias_arn": schema.StringAttribute{
+											CustomType: fwtypes.ARNType,
+											Required:   true,
+										},
+									},
+								},
+							},
+							"collector": emptyConfigurationBlock(),
+							names.AttrCondition: schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[conditionFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										names.AttrCondition: schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[flowConditionModel](ctx),
+											Validators: []validator.List{
+												listvalidator.SizeBetween(1, 5),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													names.AttrExpression: schema.StringAttribute{
+														Optional: true,
+													},
+													names.AttrName: schema.StringAttribute{
+														Required: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"input":    emptyConfigurationBlock(),
+							"iterator": emptyConfigurationBlock(),
+							"knowledge_base": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[knowledgeBaseFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"knowledge_base_id": schema.StringAttribute{
+											Required: true,
+										},
+										"model_id": schema.StringAttribute{
+											Optional: true,
+										},
+									},
+									Blocks: map[string]schema.Block{
+										"guardrail_configuration": guardrailConfigurationBlock(),
+									},
+								},
+							},
+							"lambda_function": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[lambdaFunctionFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"lambda_arn": schema.StringAttribute{
+											CustomType: fwtypes.ARNType,
+											Required:   true,
+										},
+									},
+								},
+							},
+							"lex": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[lexFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"bot_alias_arn": schema.StringAttribute{
+											CustomType: fwtypes.ARNType,
+											Required:   true,
+										},
+										"locale_id": schema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+							},
+							"output": emptyConfigurationBlock(),
+							"prompt": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										"guardrail_configuration": guardrailConfigurationBlock(),
+										"source_configuration": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeSourceConfigurationModel](ctx),
+											Validators: []validator.List{
+												listvalidator.IsRequired(),
+												listvalidator.SizeAtLeast(1),
+												listvalidator.SizeAtMost(1),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Blocks: map[string]schema.Block{
+													"inline": schema.ListNestedBlock{
+														CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeInlineConfigurationModel](ctx),
+														Validators: []validator.List{
+															listvalidator.SizeAtMost(1),
+															listvalidator.ExactlyOneOf(
+																path.MatchRelative().AtParent().AtName("inline"),
+																path.MatchRelative().AtParent().AtName("resource"),
+															),
+														},
+														NestedObject: schema.NestedBlockObject{
+															Attributes: map[string]schema.Attribute{
+																"additional_model_request_fields": schema.StringAttribute{
+																	CustomType: fwtypes.NewSmithyJSONType(ctx, document.NewLazyDocument),
+																	Optional:   true,
+																},
+																"model_id": schema.StringAttribute{
+																	Required: true,
+																},
+																"template_type": schema.StringAttribute{
+																	CustomType: fwtypes.StringEnumType[awstypes.PromptTemplateType](),
+																	Required:   true,
+																},
+															},
+															Blocks: map[string]schema.Block{
+																"inference_configuration": promptInferenceConfigurationBlock(ctx),
+																"template_configuration":  promptTemplateConfigurationBlock(ctx),
+															},
+														},
+													},
+													"resource": schema.ListNestedBlock{
+														CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeResourceConfigurationModel](ctx),
+														Validators: []validator.List{
+															listvalidator.SizeAtMost(1),
+														},
+														NestedObject: schema.NestedBlockObject{
+															Attributes: map[string]schema.Attribute{
+																"prompt_arn": schema.StringAttribute{
+																	CustomType: fwtypes.ARNType,
+																	Required:   true,
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"retrieval": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[retrievalFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										"service_configuration": s3ServiceConfigurationBlock(),
+									},
+								},
+							},
+							"storage": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[storageFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										"service_configuration": s3ServiceConfigurationBlock(),
+									},
+								},
+							},
+						},
+					},
+				},
+				"input": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeInputModel](ctx),
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							names.AttrExpression: schema.StringAttribute{
+								Required: true,
+							},
+							names.AttrName: schema.StringAttribute{
+								Required: true,
+							},
+							names.AttrType: schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.FlowNodeIODataType](),
+								Required:   true,
+							},
+						},
+					},
+				},
+				"output": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeOutputModel](ctx),
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							names.AttrName: schema.StringAttribute{
+								Required: true,
+							},
+							names.AttrType: schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.FlowNodeIODataType](),
+								Required:   true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *flowResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	name := data.Name.ValueString()
+	var input bedrockagent.CreateFlowInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateFlow(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Agent Flow (%s)", name), err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.Id)
+
+	if input.Definition != nil {
+		if _, err := prepareFlow(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Agent Flow (%s)", name), err.Error())
+
+			return
+		}
+	}
+
+	flow, err := findFlowByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Flow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setComputedValues(ctx, flow)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *flowResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	output, err := findFlowByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Flow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new flowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	if !new.CustomerEncryptionKeyARN.Equal(old.CustomerEncryptionKeyARN) ||
+		!new.Definition.Equal(old.Definition) ||
+		!new.Description.Equal(old.Description) ||
+		!new.ExecutionRoleARN.Equal(old.ExecutionRoleARN) ||
+		!new.Name.Equal(old.Name) {
+		var input bedrockagent.UpdateFlowInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.FlowIdentifier = fwflex.StringFromFramework(ctx, new.ID)
+
+		_, err := conn.UpdateFlow(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Flow (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if input.Definition != nil {
+			if _, err := prepareFlow(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Flow (%s)", new.ID.ValueString()), err.Error())
+
+				return
+			}
+		}
+	}
+
+	flow, err := findFlowByID(ctx, conn, new.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Flow (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	new.setComputedValues(ctx, flow)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *flowResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	_, err := conn.DeleteFlow(ctx, &bedrockagent.DeleteFlowInput{
+		FlowIdentifier: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Agent Flow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *flowResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func prepareFlow(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*bedrockagent.GetFlowOutput, error) {
+	input := &bedrockagent.PrepareFlowInput{
+		FlowIdentifier: aws.String(id),
+	}
+
+	_, err := conn.PrepareFlow(ctx, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("preparing Bedrock Agent Flow (%s): %w", id, err)
+	}
+
+	output, err := waitFlowPrepared(ctx, conn, id, timeout)
+
+	if err != nil {
+		return nil, fmt.Errorf("waiting for Bedrock Agent Flow (%s) prepare: %w", id, err)
+	}
+
+	return output, nil
+}
+
+func findFlowByID(ctx context.Context, conn *bedrockagent.Client, id string) (*bedrockagent.GetFlowOutput, error) {
+	input := &bedrockagent.GetFlowInput{
+		FlowIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetFlow(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusFlow(ctx context.Context, conn *bedrockagent.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFlowByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitFlowPrepared(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*bedrockagent.GetFlowOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.FlowStatusNotPrepared, awstypes.FlowStatusPreparing),
+		Target:  enum.Slice(awstypes.FlowStatusPrepared),
+		Refresh: statusFlow(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagent.GetFlowOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type flowResourceModel struct {
+	ARN                      types.String                                         `tfsdk:"arn"`
+	CreatedAt                timetypes.RFC3339                                    `tfsdk:"created_at"`
+	CustomerEncryptionKeyARN fwtypes.ARN                                          `tfsdk:"customer_encryption_key_arn"`
+	Definition               fwtypes.ListNestedObjectValueOf[flowDefinitionModel] `tfsdk:"definition"`
+	Description              types.String                                         `tfsdk:"description"`
+	ExecutionRoleARN         fwtypes.ARN                                          `tfsdk:"execution_role_arn"`
+	ID                       types.String                                         `tfsdk:"id"`
+	Name                     types.String                                         `tfsdk:"name"`
+	Status                   fwtypes.StringEnum[awstypes.FlowStatus]              `tfsdk:"status"`
+	Tags                     tftags.Map                                           `tfsdk:"tags"`
+	TagsAll                  tftags.Map                                           `tfsdk:"tags_all"`
+	Timeouts                 timeouts.Value                                       `tfsdk:"timeouts"`
+	UpdatedAt                timetypes.RFC3339                                    `tfsdk:"updated_at"`
+	Version                  types.String                                         `tfsdk:"version"`
+}
+
+func (m *flowResourceModel) setComputedValues(ctx context.Context, output *bedrockagent.GetFlowOutput) {
+	m.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	m.CreatedAt = fwflex.TimeToFramework(ctx, output.CreatedAt)
+	m.Status = fwtypes.StringEnumValue(output.Status)
+	m.UpdatedAt = fwflex.TimeToFramework(ctx, output.UpdatedAt)
+	m.Version = fwflex.StringToFramework(ctx, output.Version)
+}
+
+type flowDefinitionModel struct {
+	Connections fwtypes.ListNestedObjectValueOf[flowConnectionModel] `tfsdk:"connection"`
+	Nodes       fwtypes.ListNestedObjectValueOf[flowNodeModel]       `tfsdk:"node"`
+}
+
+type flowConnectionModel struct {
+	Configuration fwtypes.ListNestedObjectValueOf[flowConnectionConfigurationModel] `tfsdk:"configuration"`
+	Name          types.String                                                      `tfsdk:"name"`
+	Source        types.String                                                      `tfsdk:"source"`
+	Target        types.String                                                      `tfsdk:"target"`
+	Type          fwtypes.StringEnum[awstypes.FlowConnectionType]                   `tfsdk:"type"`
+}
+
+type flowConnectionConfigurationModel struct {
+	Conditional fwtypes.ListNestedObjectValueOf[flowConditionalConnectionConfigurationModel] `tfsdk:"conditional"`
+	Data        fwtypes.ListNestedObjectValueOf[flowDataConnectionConfigurationModel]        `tfsdk:"data"`
+}
+
+func (m flowConnectionConfigurationModel) UnionMembers() []any {
+	return []any{
+		&awstypes.FlowConnectionConfigurationMemberConditional{},
+		&awstypes.FlowConnectionConfigurationMemberData{},
+	}
+}
+
+type flowConditionalConnectionConfigurationModel struct {
+	Condition types.String `tfsdk:"condition"`
+}
+
+type flowDataConnectionConfigurationModel struct {
+	SourceOutput types.String `tfsdk:"source_output"`
+	TargetInput  types.String `tfsdk:"target_input"`
+}
+
+type flowNodeModel struct {
+	Configuration fwtypes.ListNestedObjectValueOf[flowNodeConfigurationModel] `tfsdk:"configuration"`
+	Inputs        fwtypes.ListNestedObjectValueOf[flowNodeInputModel]         `tfsdk:"input"`
+	Name          types.String                                                `tfsdk:"name"`
+	Outputs       fwtypes.ListNestedObjectValueOf[flowNodeOutputModel]        `tfsdk:"output"`
+	Type          fwtypes.StringEnum[awstypes.FlowNodeType]                   `tfsdk:"type"`
+}
+
+type flowNodeConfigurationModel struct {
+	Agent          fwtypes.ListNestedObjectValueOf[agentFlowNodeConfigurationModel]          `tfsdk:"agent"`
+	Collector      fwtypes.ListNestedObjectValueOf[flowEmptyNodeConfigurationModel]          `tfsdk:"collector"`
+	Condition      fwtypes.ListNestedObjectValueOf[conditionFlowNodeConfigurationModel]      `tfsdk:"condition"`
+	Input          fwtypes.ListNestedObjectValueOf[flowEmptyNodeConfigurationModel]          `tfsdk:"input"`
+	Iterator       fwtypes.ListNestedObjectValueOf[flowEmptyNodeConfigurationModel]          `tfsdk:"iterator"`
+	KnowledgeBase  fwtypes.ListNestedObjectValueOf[knowledgeBaseFlowNodeConfigurationModel]  `tfsdk:"knowledge_base"`
+	LambdaFunction fwtypes.ListNestedObjectValueOf[lambdaFunctionFlowNodeConfigurationModel] `tfsdk:"lambda_function"`
+	Lex            fwtypes.ListNestedObjectValueOf[lexFlowNodeConfigurationModel]            `tfsdk:"lex"`
+	Output         fwtypes.ListNestedObjectValueOf[flowEmptyNodeConfigurationModel]          `tfsdk:"output"`
+	Prompt         fwtypes.ListNestedObjectValueOf[promptFlowNodeConfigurationModel]         `tfsdk:"prompt"`
+	Retrieval      fwtypes.ListNestedObjectValueOf[retrievalFlowNodeConfigurationModel]      `tfsdk:"retrieval"`
+	Storage        fwtypes.ListNestedObjectValueOf[storageFlowNodeConfigurationModel]        `tfsdk:"storage"`
+}
+
+func (m flowNodeConfigurationModel) UnionMembers() []any {
+	return []any{
+		&awstypes.FlowNodeConfigurationMemberAgent{},
+		&awstypes.FlowNodeConfigurationMemberCollector{},
+		&awstypes.FlowNodeConfigurationMemberCondition{},
+		&awstypes.FlowNodeConfigurationMemberInput{},
+		&awstypes.FlowNodeConfigurationMemberIterator{},
+		&awstypes.FlowNodeConfigurationMemberKnowledgeBase{},
+		&awstypes.FlowNodeConfigurationMemberLambdaFunction{},
+		&awstypes.FlowNodeConfigurationMemberLex{},
+		&awstypes.FlowNodeConfigurationMemberOutput{},
+		&awstypes.FlowNodeConfigurationMemberPrompt{},
+		&awstypes.FlowNodeConfigurationMemberRetrieval{},
+		&awstypes.FlowNodeConfigurationMemberStorage{},
+	}
+}
+
+type flowEmptyNodeConfigurationModel struct{}
+
+type agentFlowNodeConfigurationModel struct {
+	AgentAliasARN fwtypes.ARN `tfsdk:"agent_alias_arn"`
+}
+
+type conditionFlowNodeConfigurationModel struct {
+	Conditions fwtypes.ListNestedObjectValueOf[flowConditionModel] `tfsdk:"condition"`
+}
+
+type flowConditionModel struct {
+	Expression types.String `tfsdk:"expression"`
+	Name       types.String `tfsdk:"name"`
+}
+
+type knowledgeBaseFlowNodeConfigurationModel struct {
+	GuardrailConfiguration fwtypes.ListNestedObjectValueOf[guardrailConfigurationModel] `tfsdk:"guardrail_configuration"`
+	KnowledgeBaseID        types.String                                                 `tfsdk:"knowledge_base_id"`
+	ModelID                types.String                                                 `tfsdk:"model_id"`
+}
+
+type lambdaFunctionFlowNodeConfigurationModel struct {
+	LambdaARN fwtypes.ARN `tfsdk:"lambda_arn"`
+}
+
+type lexFlowNodeConfigurationModel struct {
+	BotAliasARN fwtypes.ARN  `tfsdk:"bot_alias_arn"`
+	LocaleID    types.String `tfsdk:"locale_id"`
+}
+
+type promptFlowNodeConfigurationModel struct {
+	GuardrailConfiguration fwtypes.ListNestedObjectValueOf[guardrailConfigurationModel]            `tfsdk:"guardrail_configuration"`
+	SourceConfiguration    fwtypes.ListNestedObjectValueOf[promptFlowNodeSourceConfigurationModel] `tfsdk:"source_configuration"`
+}
+
+type promptFlowNodeSourceConfigurationModel struct {
+	Inline   fwtypes.ListNestedObjectValueOf[promptFlowNodeInlineConfigurationModel]   `tfsdk:"inline"`
+	Resource fwtypes.ListNestedObjectValueOf[promptFlowNodeResourceConfigurationModel] `tfsdk:"resource"`
+}
+
+func (m promptFlowNodeSourceConfigurationModel) UnionMembers() []any {
+	return []any{
+		&awstypes.PromptFlowNodeSourceConfigurationMemberInline{},
+		&awstypes.PromptFlowNodeSourceConfigurationMemberResource{},
+	}
+}
+
+type promptFlowNodeInlineConfigurationModel struct {
+	AdditionalModelRequestFields fwtypes.SmithyJSON[document.Interface]                             `tfsdk:"additional_model_request_fields"`
+	InferenceConfiguration       fwtypes.ListNestedObjectValueOf[promptInferenceConfigurationModel] `tfsdk:"inference_configuration"`
+	ModelID                      types.String                                                       `tfsdk:"model_id"`
+	TemplateConfiguration        fwtypes.ListNestedObjectValueOf[promptTemplateConfigurationModel]  `tfsdk:"template_configuration"`
+	TemplateType                 fwtypes.StringEnum[awstypes.PromptTemplateType]                    `tfsdk:"template_type"`
+}
+
+type promptFlowNodeResourceConfigurationModel struct {
+	PromptARN fwtypes.ARN `tfsdk:"prompt_arn"`
+}
+
+type retrievalFlowNodeConfigurationModel struct {
+	ServiceConfiguration fwtypes.ListNestedObjectValueOf[flowNodeServiceConfigurationModel] `tfsdk:"service_configuration"`
+}
+
+type storageFlowNodeConfigurationModel struct {
+	ServiceConfiguration fwtypes.ListNestedObjectValueOf[flowNodeServiceConfigurationModel] `tfsdk:"service_configuration"`
+}
+
+// flowNodeServiceConfigurationModel models both the retrieval and storage node service configuration unions.
+type flowNodeServiceConfigurationModel struct {
+	S3 fwtypes.ListNestedObjectValueOf[flowNodeS3ConfigurationModel] `tfsdk:"s3"`
+}
+
+func (m flowNodeServiceConfigurationModel) UnionMembers() []any {
+	return []any{
+		&awstypes.RetrievalFlowNodeServiceConfigurationMemberS3{},
+		&awstypes.StorageFlowNodeServiceConfigurationMemberS3{},
+	}
+}
+
+type flowNodeS3ConfigurationModel struct {
+	BucketName types.String `tfsdk:"bucket_name"`
+}
+
+type flowNodeInputModel struct {
+	Expression types.String                                    `tfsdk:"expression"`
+	Name       types.String                                    `tfsdk:"name"`
+	Type       fwtypes.StringEnum[awstypes.FlowNodeIODataType] `tfsdk:"type"`
+}
+
+type flowNodeOutputModel struct {
+	Name types.String                                    `tfsdk:"name"`
+	Type fwtypes.StringEnum[awstypes.FlowNodeIODataType] `tfsdk:"type"`
+}
@@ -0,0 +1,343 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_bedrockagent_flow_alias", name="Flow Alias")
+// @Tags(identifierAttribute="arn")
+func newFlowAliasResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &flowAliasResource{}, nil
+}
+
+type flowAliasResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*flowAliasResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrockagent_flow_alias"
+}
+
+func (r *flowAliasResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"alias_id":    framework.IDAttribute(),
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			"flow_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"flow_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^([0-9a-zA-Z][_-]?){1,100}$`), "valid characters are a-z, A-Z, 0-9, _ (underscore) and - (hyphen). The name can have up to 100 characters"),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"routing_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[flowAliasRoutingConfigurationListItemModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"flow_version": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *flowAliasResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flowAliasResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	name := data.Name.ValueString()
+	var input bedrockagent.CreateFlowAliasInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateFlowAlias(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Agent Flow Alias (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.AliasID = fwflex.StringToFramework(ctx, output.Id)
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.CreatedAt = fwflex.TimeToFramework(ctx, output.CreatedAt)
+	data.FlowID = fwflex.StringToFramework(ctx, output.FlowId)
+	data.UpdatedAt = fwflex.TimeToFramework(ctx, output.UpdatedAt)
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Agent Flow Alias (%s)", name), err.Error())
+
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *flowAliasResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flowAliasResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	output, err := findFlowAliasByTwoPartKey(ctx, conn, data.AliasID.ValueString(), data.FlowID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Flow Alias (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Default the flow identifier to the flow ID on import.
+	if data.FlowIdentifier.IsNull() {
+		data.FlowIdentifier = data.FlowID
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowAliasResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new flowAliasResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	if !new.Description.Equal(old.Description) ||
+		!new.Name.Equal(old.Name) ||
+		!new.RoutingConfiguration.Equal(old.RoutingConfiguration) {
+		var input bedrockagent.UpdateFlowAliasInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.AliasIdentifier = fwflex.StringFromFramework(ctx, new.AliasID)
+		input.FlowIdentifier = fwflex.StringFromFramework(ctx, new.FlowID)
+
+		output, err := conn.UpdateFlowAlias(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Flow Alias (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.UpdatedAt = fwflex.TimeToFramework(ctx, output.UpdatedAt)
+	} else {
+		new.UpdatedAt = old.UpdatedAt
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *flowAliasResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flowAliasResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	_, err := conn.DeleteFlowAlias(ctx, &bedrockagent.DeleteFlowAliasInput{
+		AliasIdentifier: fwflex.StringFromFramework(ctx, data.AliasID),
+		FlowIdentifier:  fwflex.StringFromFramework(ctx, data.FlowID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Agent Flow Alias (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *flowAliasResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findFlowAliasByTwoPartKey(ctx context.Context, conn *bedrockagent.Client, aliasID, flowID string) (*bedrockagent.GetFlowAliasOutput, error) {
+	input := &bedrockagent.GetFlowAliasInput{
+		AliasIdentifier: aws.String(aliasID),
+		FlowIdentifier:  aws.String(flowID),
+	}
+
+	output, err := conn.GetFlowAlias(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type flowAliasResourceModel struct {
+	AliasID              types.String                                                                `tfsdk:"alias_id" autoflex:"-"`
+	ARN                  types.String                                                                `tfsdk:"arn"`
+	CreatedAt            timetypes.RFC3339                                                           `tfsdk:"created_at"`
+	Description          types.String                                                                `tfsdk:"description"`
+	FlowID               types.String                                                                `tfsdk:"flow_id"`
+	FlowIdentifier       types.String                                                                `tfsdk:"flow_identifier"`
+	ID                   types.String                                                                `tfsdk:"id" autoflex:"-"`
+	Name                 types.String                                                                `tfsdk:"name"`
+	RoutingConfiguration fwtypes.ListNestedObjectValueOf[flowAliasRoutingConfigurationListItemModel] `tfsdk:"routing_configuration"`
+	Tags                 tftags.Map                                                                  `tfsdk:"tags"`
+	TagsAll              tftags.Map                                                                  `tfsdk:"tags_all"`
+	UpdatedAt            timetypes.RFC3339                                                           `tfsdk:"updated_at"`
+}
+
+const (
+	flowAliasResourceIDPartCount = 2
+)
+
+func (m *flowAliasResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), flowAliasResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	m.AliasID = types.StringValue(parts[0])
+	m.FlowID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *flowAliasResourceModel) setID() (string, error) {
+	parts := []string{
+		m.AliasID.ValueString(),
+		m.FlowID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, flowAliasResourceIDPartCount, false)
+}
+
+type flowAliasRoutingConfigurationListItemModel struct {
+	FlowVersion types.String `tfsdk:"flow_version"`
+}
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrockagent "github.com/hashicorp/terraform-provider-aws/internal/service/bedrockagent"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBedrockAgentFlowAlias_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v bedrockagent.GetFlowAliasOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_flow_alias.test"
+	flowResourceName := "aws_bedrockagent_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowAliasConfig_basic(rName, "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowAliasExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "alias_id"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test"),
+					resource.TestCheckResourceAttrPair(resourceName, "flow_id", flowResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "routing_configuration.0.flow_version", "aws_bedrockagent_flow_version.test", names.AttrVersion),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"flow_identifier"},
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentFlowAlias_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v bedrockagent.GetFlowAliasOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_flow_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowAliasConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowAliasExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfbedrockagent.ResourceFlowAlias, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentFlowAlias_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v bedrockagent.GetFlowAliasOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_flow_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowAliasConfig_basic(rName, "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowAliasExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test"),
+				),
+			},
+			{
+				Config: testAccFlowAliasConfig_basic(rName, "updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowAliasExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFlowAliasDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_bedrockagent_flow_alias" {
+				continue
+			}
+
+			_, err := tfbedrockagent.FindFlowAliasByTwoPartKey(ctx, conn, rs.Primary.Attributes["alias_id"], rs.Primary.Attributes["flow_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Bedrock Agent Flow Alias %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFlowAliasExists(ctx context.Context, n string, v *bedrockagent.GetFlowAliasOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentClient(ctx)
+
+		output, err := tfbedrockagent.FindFlowAliasByTwoPartKey(ctx, conn, rs.Primary.Attributes["alias_id"], rs.Primary.Attributes["flow_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccFlowAliasConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccFlowVersionConfig_basic(rName), fmt.Sprintf(`
+resource "aws_bedrockagent_flow_alias" "test" {
+  name            = %[1]q
+  flow_identifier = aws_bedrockagent_flow.test.id
+  description     = %[2]q
+
+  routing_configuration {
+    flow_version = aws_bedrockagent_flow_version.test.version
+  }
+}
+`, rName, description))
+}
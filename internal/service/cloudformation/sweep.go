@@ -4,9 +4,11 @@
 package cloudformation
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"slices"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
@@ -18,15 +20,16 @@ import (
 	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cloudformation_stack_set_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudformation_stack_set_instance", &resource.Sweeper{
 		Name: "aws_cloudformation_stack_set_instance",
 		F:    sweepStackSetInstances,
 	})
 
-	resource.AddTestSweepers("aws_cloudformation_stack_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudformation_stack_set", &resource.Sweeper{
 		Name: "aws_cloudformation_stack_set",
 		Dependencies: []string{
 			"aws_cloudformation_stack_set_instance",
@@ -34,7 +37,7 @@ func RegisterSweepers() {
 		F: sweepStackSets,
 	})
 
-	resource.AddTestSweepers("aws_cloudformation_stack", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudformation_stack", &resource.Sweeper{
 		Name: "aws_cloudformation_stack",
 		Dependencies: []string{
 			"aws_cloudformation_stack_set_instance",
@@ -227,24 +230,15 @@ func sweepStacks(region string) error {
 
 		for _, v := range page.StackSummaries {
 			name := aws.ToString(v.StackName)
-			inputU := &cloudformation.UpdateTerminationProtectionInput{
-				EnableTerminationProtection: aws.Bool(false),
-				StackName:                   aws.String(name),
-			}
-
-			log.Printf("[INFO] Disabling termination protection for CloudFormation Stack: %s", name)
-			_, err := conn.UpdateTerminationProtection(ctx, inputU)
-
-			if err != nil {
-				log.Printf("[ERROR] Disabling termination protection for CloudFormation Stack (%s): %s", name, err)
-				continue
-			}
-
 			r := resourceStack()
 			d := r.Data(nil)
 			d.SetId(name)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, &stackSweeper{
+				SweepableWithMetadata: sweep.NewSweepResource(r, d, client),
+				conn:                  conn,
+				name:                  name,
+			})
 		}
 	}
 
@@ -256,3 +250,24 @@ func sweepStacks(region string) error {
 
 	return nil
 }
+
+// stackSweeper disables a CloudFormation Stack's termination protection before deleting it.
+type stackSweeper struct {
+	sweep.SweepableWithMetadata
+	conn *cloudformation.Client
+	name string
+}
+
+func (s *stackSweeper) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	input := &cloudformation.UpdateTerminationProtectionInput{
+		EnableTerminationProtection: aws.Bool(false),
+		StackName:                   aws.String(s.name),
+	}
+
+	log.Printf("[INFO] Disabling termination protection for CloudFormation Stack: %s", s.name)
+	if _, err := s.conn.UpdateTerminationProtection(ctx, input); err != nil {
+		return fmt.Errorf("disabling termination protection for CloudFormation Stack (%s): %w", s.name, err)
+	}
+
+	return s.SweepableWithMetadata.Delete(ctx, timeout, optFns...)
+}
@@ -20,7 +20,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cloudfront_cache_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_cache_policy", &resource.Sweeper{
 		Name: "aws_cloudfront_cache_policy",
 		F:    sweepCachePolicies,
 		Dependencies: []string{
@@ -29,22 +29,22 @@ func RegisterSweepers() {
 	})
 
 	// DO NOT add a continuous deployment policy sweeper as these are swept as part of the distribution sweeper
-	// resource.AddTestSweepers("aws_cloudfront_continuous_deployment_policy", &resource.Sweeper{
+	// sweep.AddTestSweepers("aws_cloudfront_continuous_deployment_policy", &resource.Sweeper{
 	//	Name: "aws_cloudfront_continuous_deployment_policy",
 	//	F:    sweepContinuousDeploymentPolicies,
 	//})
 
-	resource.AddTestSweepers("aws_cloudfront_distribution", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_distribution", &resource.Sweeper{
 		Name: "aws_cloudfront_distribution",
 		F:    sweepDistributions,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_field_level_encryption_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_field_level_encryption_config", &resource.Sweeper{
 		Name: "aws_cloudfront_field_level_encryption_config",
 		F:    sweepFieldLevelEncryptionConfigs,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_field_level_encryption_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_field_level_encryption_profile", &resource.Sweeper{
 		Name: "aws_cloudfront_field_level_encryption_profile",
 		F:    sweepFieldLevelEncryptionProfiles,
 		Dependencies: []string{
@@ -52,17 +52,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_function", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_function", &resource.Sweeper{
 		Name: "aws_cloudfront_function",
 		F:    sweepFunctions,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_key_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_key_group", &resource.Sweeper{
 		Name: "aws_cloudfront_key_group",
 		F:    sweepKeyGroup,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_monitoring_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_monitoring_subscription", &resource.Sweeper{
 		Name: "aws_cloudfront_monitoring_subscription",
 		F:    sweepMonitoringSubscriptions,
 		Dependencies: []string{
@@ -70,7 +70,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_origin_access_control", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_origin_access_control", &resource.Sweeper{
 		Name: "aws_cloudfront_origin_access_control",
 		F:    sweepOriginAccessControls,
 		Dependencies: []string{
@@ -78,7 +78,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_origin_request_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_origin_request_policy", &resource.Sweeper{
 		Name: "aws_cloudfront_origin_request_policy",
 		F:    sweepOriginRequestPolicies,
 		Dependencies: []string{
@@ -86,12 +86,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_realtime_log_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_realtime_log_config", &resource.Sweeper{
 		Name: "aws_cloudfront_realtime_log_config",
 		F:    sweepRealtimeLogsConfig,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_response_headers_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_response_headers_policy", &resource.Sweeper{
 		Name: "aws_cloudfront_response_headers_policy",
 		F:    sweepResponseHeadersPolicies,
 		Dependencies: []string{
@@ -99,7 +99,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_vpc_origin", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_vpc_origin", &resource.Sweeper{
 		Name: "aws_cloudfront_vpc_origin",
 		F:    sweepVPCOrigins,
 	})
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeartifact
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_codeartifact_authorization_token", name="Authorization Token")
+func newAuthorizationTokenEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &authorizationTokenEphemeralResource{}, nil
+}
+
+type authorizationTokenEphemeralResource struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (*authorizationTokenEphemeralResource) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_codeartifact_authorization_token"
+}
+
+func (e *authorizationTokenEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authorization_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrDomain: schema.StringAttribute{
+				Required: true,
+			},
+			"domain_owner": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.Between(900, 43200),
+						int64validator.OneOf(0),
+					),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (e *authorizationTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data authorizationTokenEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().CodeArtifactClient(ctx)
+
+	if data.DomainOwner.IsNull() || data.DomainOwner.IsUnknown() {
+		data.DomainOwner = types.StringValue(e.Meta().AccountID(ctx))
+	}
+
+	domainName, domainOwner := data.Domain.ValueString(), data.DomainOwner.ValueString()
+	input := codeartifact.GetAuthorizationTokenInput{
+		Domain:          aws.String(domainName),
+		DomainOwner:     aws.String(domainOwner),
+		DurationSeconds: fwflex.Int64FromFramework(ctx, data.DurationSeconds),
+	}
+
+	output, err := conn.GetAuthorizationToken(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CodeArtifact Authorization Token (%s:%s)", domainOwner, domainName), err.Error())
+
+		return
+	}
+
+	data.AuthorizationToken = fwflex.StringToFramework(ctx, output.AuthorizationToken)
+	data.Expiration = timetypes.NewRFC3339TimePointerValue(output.Expiration)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type authorizationTokenEphemeralResourceModel struct {
+	AuthorizationToken types.String      `tfsdk:"authorization_token"`
+	Domain             types.String      `tfsdk:"domain"`
+	DomainOwner        types.String      `tfsdk:"domain_owner"`
+	DurationSeconds    types.Int64       `tfsdk:"duration_seconds"`
+	Expiration         timetypes.RFC3339 `tfsdk:"expiration"`
+}
@@ -27,6 +27,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Identity: types.RegionalARNIdentity(names.AttrARN),
 		},
 		{
 			Factory:  newHostResource,
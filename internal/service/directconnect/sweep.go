@@ -4,8 +4,10 @@
 package directconnect
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/directconnect"
@@ -16,20 +18,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_dx_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_connection", &resource.Sweeper{
 		Name: "aws_dx_connection",
 		F:    sweepConnections,
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway_association_proposal", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway_association_proposal", &resource.Sweeper{
 		Name: "aws_dx_gateway_association_proposal",
 		F:    sweepGatewayAssociationProposals,
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway_association", &resource.Sweeper{
 		Name: "aws_dx_gateway_association",
 		F:    sweepGatewayAssociations,
 		Dependencies: []string{
@@ -37,7 +40,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway", &resource.Sweeper{
 		Name: "aws_dx_gateway",
 		F:    sweepGateways,
 		Dependencies: []string{
@@ -46,13 +49,13 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dx_lag", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_lag", &resource.Sweeper{
 		Name:         "aws_dx_lag",
 		F:            sweepLags,
 		Dependencies: []string{"aws_dx_connection"},
 	})
 
-	resource.AddTestSweepers("aws_dx_macsec_key", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_macsec_key", &resource.Sweeper{
 		Name:         "aws_dx_macsec_key",
 		F:            sweepMacSecKeys,
 		Dependencies: []string{},
@@ -426,6 +429,7 @@ func sweepMacSecKeys(region string) error {
 	// clean up the dangling resource is to use Secrets Manager to delete
 	// the MACsec key secret.
 	smConn := client.SecretsManagerClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	output, err := dxConn.DescribeConnections(ctx, input)
 
@@ -440,20 +444,42 @@ func sweepMacSecKeys(region string) error {
 
 	for _, v := range output.Connections {
 		for _, v := range v.MacSecKeys {
-			arn := aws.ToString(v.SecretARN)
+			sweepResources = append(sweepResources, &macSecKeySecretSweeper{
+				conn: smConn,
+				arn:  aws.ToString(v.SecretARN),
+			})
+		}
+	}
 
-			input := &secretsmanager.DeleteSecretInput{
-				SecretId: aws.String(arn),
-			}
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
-			log.Printf("[DEBUG] Deleting MACSec secret key: %s", arn)
-			_, err := smConn.DeleteSecret(ctx, input)
+	if err != nil {
+		return fmt.Errorf("error sweeping Direct Connect MACsec Keys (%s): %w", region, err)
+	}
 
-			if err != nil {
-				continue
-			}
-		}
+	return nil
+}
+
+type macSecKeySecretSweeper struct {
+	conn *secretsmanager.Client
+	arn  string
+}
+
+func (s *macSecKeySecretSweeper) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	input := &secretsmanager.DeleteSecretInput{
+		SecretId: aws.String(s.arn),
+	}
+
+	log.Printf("[DEBUG] Deleting MACSec secret key: %s", s.arn)
+	if _, err := s.conn.DeleteSecret(ctx, input); err != nil {
+		log.Printf("[WARN] Deleting MACSec secret key (%s): %s", s.arn, err)
 	}
 
 	return nil
 }
+
+func (s *macSecKeySecretSweeper) Metadata(ctx context.Context, refresh bool) (sweep.Metadata, error) {
+	return sweep.Metadata{
+		ID: s.arn,
+	}, nil
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -186,10 +187,13 @@ func resourceCluster() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 			},
 			"master_password": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"master_password_wo"},
 			},
+			"master_password_wo":         sdkv2.WriteOnlyStringSchema("master_password_wo", "master_password"),
+			"master_password_wo_version": sdkv2.WriteOnlyVersionSchema("master_password_wo"),
 			"master_username": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -376,6 +380,11 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			requiresModifyDbCluster = true
 		}
 
+		if v := sdkv2.GetWriteOnlyString(d, "master_password_wo"); v != "" {
+			inputM.MasterUserPassword = aws.String(v)
+			requiresModifyDbCluster = true
+		}
+
 		if v, ok := d.GetOk(names.AttrPort); ok {
 			input.Port = aws.Int32(int32(v.(int)))
 		}
@@ -462,9 +471,14 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			return sdkdiag.AppendErrorf(diags, "creating DocumentDB Cluster (restore to point-in-time) (%s): %s", identifier, err)
 		}
 	} else {
+		masterPassword := d.Get("master_password").(string)
+		if v := sdkv2.GetWriteOnlyString(d, "master_password_wo"); v != "" {
+			masterPassword = v
+		}
+
 		// Secondary DocDB clusters part of a global cluster will not supply the master_password
 		if _, ok := d.GetOk("global_cluster_identifier"); !ok {
-			if _, ok := d.GetOk("master_password"); !ok {
+			if masterPassword == "" {
 				return sdkdiag.AppendErrorf(diags, `provider.aws: aws_docdb_cluster: %s: "master_password": required field is not set`, identifier)
 			}
 		}
@@ -481,7 +495,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			DeletionProtection:  aws.Bool(d.Get(names.AttrDeletionProtection).(bool)),
 			Engine:              aws.String(d.Get(names.AttrEngine).(string)),
 			MasterUsername:      aws.String(d.Get("master_username").(string)),
-			MasterUserPassword:  aws.String(d.Get("master_password").(string)),
+			MasterUserPassword:  aws.String(masterPassword),
 			Tags:                getTagsIn(ctx),
 		}
 
@@ -673,6 +687,12 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.MasterUserPassword = aws.String(d.Get("master_password").(string))
 		}
 
+		if sdkv2.HasWriteOnlyChange(d, "master_password_wo") {
+			if v := sdkv2.GetWriteOnlyString(d, "master_password_wo"); v != "" {
+				input.MasterUserPassword = aws.String(v)
+			}
+		}
+
 		if d.HasChange("preferred_backup_window") {
 			input.PreferredBackupWindow = aws.String(d.Get("preferred_backup_window").(string))
 		}
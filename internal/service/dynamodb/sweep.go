@@ -22,12 +22,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_dynamodb_table", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dynamodb_table", &resource.Sweeper{
 		Name: "aws_dynamodb_table",
 		F:    sweepTables,
 	})
 
-	resource.AddTestSweepers("aws_dynamodb_backup", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dynamodb_backup", &resource.Sweeper{
 		Name: "aws_dynamodb_backup",
 		F:    sweepBackups,
 	})
@@ -57,15 +57,6 @@ func sweepTables(region string) error {
 		}
 
 		for _, v := range page.TableNames {
-			_, err := conn.UpdateTable(ctx, &dynamodb.UpdateTableInput{
-				DeletionProtectionEnabled: aws.Bool(false),
-				TableName:                 aws.String(v),
-			})
-
-			if err != nil {
-				log.Printf("[WARN] DynamoDB Table (%s): %s", v, err)
-			}
-
 			r := resourceTable()
 			d := r.Data(nil)
 			d.SetId(v)
@@ -78,7 +69,11 @@ func sweepTables(region string) error {
 				continue
 			}
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, &tableSweeper{
+				SweepableWithMetadata: sweep.NewSweepResource(r, d, client),
+				conn:                  conn,
+				name:                  v,
+			})
 		}
 	}
 
@@ -91,6 +86,26 @@ func sweepTables(region string) error {
 	return nil
 }
 
+// tableSweeper disables a DynamoDB Table's deletion protection before deleting it.
+type tableSweeper struct {
+	sweep.SweepableWithMetadata
+	conn *dynamodb.Client
+	name string
+}
+
+func (s *tableSweeper) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	_, err := s.conn.UpdateTable(ctx, &dynamodb.UpdateTableInput{
+		DeletionProtectionEnabled: aws.Bool(false),
+		TableName:                 aws.String(s.name),
+	})
+
+	if err != nil {
+		log.Printf("[WARN] DynamoDB Table (%s): %s", s.name, err)
+	}
+
+	return s.SweepableWithMetadata.Delete(ctx, timeout, optFns...)
+}
+
 func sweepBackups(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/list"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @ListResource("aws_instance", name="Instance")
+func listInstances(ctx context.Context, meta any, filter list.Filter) iter.Seq2[list.Result, error] {
+	return func(yield func(list.Result, error) bool) {
+		conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+		input := ec2.DescribeInstancesInput{
+			Filters: []awstypes.Filter{
+				newFilter("instance-state-name", enum.Slice(
+					awstypes.InstanceStateNamePending,
+					awstypes.InstanceStateNameRunning,
+					awstypes.InstanceStateNameStopping,
+					awstypes.InstanceStateNameStopped,
+				)),
+			},
+		}
+		if filter.NamePrefix != "" {
+			input.Filters = append(input.Filters, newFilter("tag:Name", []string{filter.NamePrefix + "*"}))
+		}
+		for k, v := range filter.Tags {
+			if v == "" {
+				input.Filters = append(input.Filters, newFilter("tag-key", []string{k}))
+			} else {
+				input.Filters = append(input.Filters, newFilter("tag:"+k, []string{v}))
+			}
+		}
+
+		instances, err := findInstances(ctx, conn, &input)
+
+		if err != nil {
+			yield(list.Result{}, fmt.Errorf("listing EC2 Instances: %w", err))
+			return
+		}
+
+		for _, v := range instances {
+			id := aws.ToString(v.InstanceId)
+			tags := keyValueTags(ctx, v.Tags).Map()
+			name := tags["Name"]
+
+			if !filter.MatchName(name) || !filter.MatchTags(tags) {
+				continue
+			}
+
+			result := list.Result{
+				DisplayName: name,
+				Identity: map[string]string{
+					names.AttrID: id,
+				},
+				ImportID: id,
+			}
+			if result.DisplayName == "" {
+				result.DisplayName = id
+			}
+
+			if !yield(result, nil) {
+				return
+			}
+		}
+	}
+}
@@ -127,6 +127,16 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 	}
 }
 
+func (p *servicePackage) ListResources(ctx context.Context) []*types.ServicePackageListResource {
+	return []*types.ServicePackageListResource{
+		{
+			List:     listInstances,
+			TypeName: "aws_instance",
+			Name:     "Instance",
+		},
+	}
+}
+
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
 		{
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -15,17 +16,17 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_customer_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_customer_gateway", &resource.Sweeper{
 		Name: "aws_customer_gateway",
 		F:    sweepCustomerGateways,
 		Dependencies: []string{
@@ -33,17 +34,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_capacity_reservation", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_capacity_reservation", &resource.Sweeper{
 		Name: "aws_ec2_capacity_reservation",
 		F:    sweepCapacityReservations,
 	})
 
-	resource.AddTestSweepers("aws_ec2_carrier_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_carrier_gateway", &resource.Sweeper{
 		Name: "aws_ec2_carrier_gateway",
 		F:    sweepCarrierGateways,
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_client_vpn_endpoint", &resource.Sweeper{
 		Name: "aws_ec2_client_vpn_endpoint",
 		F:    sweepClientVPNEndpoints,
 		Dependencies: []string{
@@ -51,17 +52,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_network_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_client_vpn_network_association", &resource.Sweeper{
 		Name: "aws_ec2_client_vpn_network_association",
 		F:    sweepClientVPNNetworkAssociations,
 	})
 
-	resource.AddTestSweepers("aws_ec2_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_fleet", &resource.Sweeper{
 		Name: "aws_ec2_fleet",
 		F:    sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_ebs_volume", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ebs_volume", &resource.Sweeper{
 		Name: "aws_ebs_volume",
 		Dependencies: []string{
 			"aws_instance",
@@ -69,7 +70,7 @@ func RegisterSweepers() {
 		F: sweepEBSVolumes,
 	})
 
-	resource.AddTestSweepers("aws_ebs_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ebs_snapshot", &resource.Sweeper{
 		Name: "aws_ebs_snapshot",
 		F:    sweepEBSSnapshots,
 		Dependencies: []string{
@@ -77,12 +78,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_egress_only_internet_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_egress_only_internet_gateway", &resource.Sweeper{
 		Name: "aws_egress_only_internet_gateway",
 		F:    sweepEgressOnlyInternetGateways,
 	})
 
-	resource.AddTestSweepers("aws_eip", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eip", &resource.Sweeper{
 		Name: "aws_eip",
 		Dependencies: []string{
 			"aws_eip_domain_name",
@@ -91,17 +92,17 @@ func RegisterSweepers() {
 		F: sweepEIPs,
 	})
 
-	resource.AddTestSweepers("aws_eip_domain_name", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eip_domain_name", &resource.Sweeper{
 		Name: "aws_eip_domain_name",
 		F:    sweepEIPDomainNames,
 	})
 
-	resource.AddTestSweepers("aws_flow_log", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_flow_log", &resource.Sweeper{
 		Name: "aws_flow_log",
 		F:    sweepFlowLogs,
 	})
 
-	resource.AddTestSweepers("aws_ec2_host", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_host", &resource.Sweeper{
 		Name: "aws_ec2_host",
 		F:    sweepHosts,
 		Dependencies: []string{
@@ -109,7 +110,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_instance", &resource.Sweeper{
 		Name: "aws_instance",
 		F:    sweepInstances,
 		Dependencies: []string{
@@ -119,7 +120,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_internet_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_internet_gateway", &resource.Sweeper{
 		Name: "aws_internet_gateway",
 		Dependencies: []string{
 			"aws_subnet",
@@ -127,7 +128,7 @@ func RegisterSweepers() {
 		F: sweepInternetGateways,
 	})
 
-	resource.AddTestSweepers("aws_key_pair", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_key_pair", &resource.Sweeper{
 		Name: "aws_key_pair",
 		Dependencies: []string{
 			"aws_elastic_beanstalk_environment",
@@ -138,7 +139,7 @@ func RegisterSweepers() {
 		F: sweepKeyPairs,
 	})
 
-	resource.AddTestSweepers("aws_launch_template", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_launch_template", &resource.Sweeper{
 		Name: "aws_launch_template",
 		Dependencies: []string{
 			"aws_autoscaling_group",
@@ -147,17 +148,17 @@ func RegisterSweepers() {
 		F: sweepLaunchTemplates,
 	})
 
-	resource.AddTestSweepers("aws_nat_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_nat_gateway", &resource.Sweeper{
 		Name: "aws_nat_gateway",
 		F:    sweepNATGateways,
 	})
 
-	resource.AddTestSweepers("aws_network_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_network_acl", &resource.Sweeper{
 		Name: "aws_network_acl",
 		F:    sweepNetworkACLs,
 	})
 
-	resource.AddTestSweepers("aws_network_interface", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_network_interface", &resource.Sweeper{
 		Name: "aws_network_interface",
 		F:    sweepNetworkInterfaces,
 		Dependencies: []string{
@@ -176,7 +177,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_managed_prefix_list", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_managed_prefix_list", &resource.Sweeper{
 		Name: "aws_ec2_managed_prefix_list",
 		F:    sweepManagedPrefixLists,
 		Dependencies: []string{
@@ -186,12 +187,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_network_insights_path", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_network_insights_path", &resource.Sweeper{
 		Name: "aws_ec2_network_insights_path",
 		F:    sweepNetworkInsightsPaths,
 	})
 
-	resource.AddTestSweepers("aws_placement_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_placement_group", &resource.Sweeper{
 		Name: "aws_placement_group",
 		F:    sweepPlacementGroups,
 		Dependencies: []string{
@@ -203,12 +204,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route_table", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route_table", &resource.Sweeper{
 		Name: "aws_route_table",
 		F:    sweepRouteTables,
 	})
 
-	resource.AddTestSweepers("aws_security_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_security_group", &resource.Sweeper{
 		Name: "aws_security_group",
 		Dependencies: []string{
 			"aws_subnet",
@@ -216,12 +217,12 @@ func RegisterSweepers() {
 		F: sweepSecurityGroups,
 	})
 
-	resource.AddTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
 		Name: "aws_spot_fleet_request",
 		F:    sweepSpotFleetRequests,
 	})
 
-	resource.AddTestSweepers("aws_spot_instance_request", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_spot_instance_request", &resource.Sweeper{
 		Name: "aws_spot_instance_request",
 		F:    sweepSpotInstanceRequests,
 	})
@@ -276,7 +277,7 @@ func RegisterSweepers() {
 		"aws_vpc_endpoint",
 	)
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_filter", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_traffic_mirror_filter", &resource.Sweeper{
 		Name: "aws_ec2_traffic_mirror_filter",
 		F:    sweepTrafficMirrorFilters,
 		Dependencies: []string{
@@ -284,12 +285,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_session", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_traffic_mirror_session", &resource.Sweeper{
 		Name: "aws_ec2_traffic_mirror_session",
 		F:    sweepTrafficMirrorSessions,
 	})
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_target", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_traffic_mirror_target", &resource.Sweeper{
 		Name: "aws_ec2_traffic_mirror_target",
 		F:    sweepTrafficMirrorTargets,
 		Dependencies: []string{
@@ -297,17 +298,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_peering_attachment",
 		F:    sweepTransitGatewayPeeringAttachments,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_multicast_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_multicast_domain", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_multicast_domain",
 		F:    sweepTransitGatewayMulticastDomains,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway",
 		F:    sweepTransitGateways,
 		Dependencies: []string{
@@ -319,12 +320,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect_peer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_connect_peer", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect_peer",
 		F:    sweepTransitGatewayConnectPeers,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_connect", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect",
 		F:    sweepTransitGatewayConnects,
 		Dependencies: []string{
@@ -333,7 +334,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_vpc_attachment",
 		F:    sweepTransitGatewayVPCAttachments,
 		Dependencies: []string{
@@ -342,12 +343,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_dhcp_options", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_dhcp_options", &resource.Sweeper{
 		Name: "aws_vpc_dhcp_options",
 		F:    sweepVPCDHCPOptions,
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
 		Name: "aws_vpc_endpoint",
 		F:    sweepVPCEndpoints,
 		Dependencies: []string{
@@ -357,12 +358,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint_connection_accepter", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint_connection_accepter", &resource.Sweeper{
 		Name: "aws_vpc_endpoint_connection_accepter",
 		F:    sweepVPCEndpointConnectionAccepters,
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
 		Name: "aws_vpc_endpoint_service",
 		F:    sweepVPCEndpointServices,
 		Dependencies: []string{
@@ -370,12 +371,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_peering_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_peering_connection", &resource.Sweeper{
 		Name: "aws_vpc_peering_connection",
 		F:    sweepVPCPeeringConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpc", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc", &resource.Sweeper{
 		Name: "aws_vpc",
 		Dependencies: []string{
 			"aws_ec2_carrier_gateway",
@@ -395,12 +396,12 @@ func RegisterSweepers() {
 		F: sweepVPCs,
 	})
 
-	resource.AddTestSweepers("aws_vpn_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpn_connection", &resource.Sweeper{
 		Name: "aws_vpn_connection",
 		F:    sweepVPNConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
 		Name: "aws_vpn_gateway",
 		F:    sweepVPNGateways,
 		Dependencies: []string{
@@ -410,32 +411,32 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_ipam", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_ipam", &resource.Sweeper{
 		Name: "aws_vpc_ipam",
 		F:    sweepIPAMs,
 	})
 
-	resource.AddTestSweepers("aws_vpc_ipam_resource_discovery", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_ipam_resource_discovery", &resource.Sweeper{
 		Name: "aws_vpc_ipam_resource_discovery",
 		F:    sweepIPAMResourceDiscoveries,
 	})
 
-	resource.AddTestSweepers("aws_ami", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ami", &resource.Sweeper{
 		Name: "aws_ami",
 		F:    sweepAMIs,
 	})
 
-	resource.AddTestSweepers("aws_vpc_network_performance_metric_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_network_performance_metric_subscription", &resource.Sweeper{
 		Name: "aws_vpc_network_performance_metric_subscription",
 		F:    sweepNetworkPerformanceMetricSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_ec2_instance_connect_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_instance_connect_endpoint", &resource.Sweeper{
 		Name: "aws_ec2_instance_connect_endpoint",
 		F:    sweepInstanceConnectEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_trust_provider", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_trust_provider", &resource.Sweeper{
 		Name: "aws_verifiedaccess_trust_provider",
 		F:    sweepVerifiedAccessTrustProviders,
 		Dependencies: []string{
@@ -443,7 +444,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_instance_trust_provider_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_instance_trust_provider_attachment", &resource.Sweeper{
 		Name: "aws_verifiedaccess_instance_trust_provider_attachment",
 		F:    sweepVerifiedAccessTrustProviderAttachments,
 		Dependencies: []string{
@@ -451,7 +452,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_group", &resource.Sweeper{
 		Name: "aws_verifiedaccess_group",
 		F:    sweepVerifiedAccessGroups,
 		Dependencies: []string{
@@ -459,12 +460,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_endpoint", &resource.Sweeper{
 		Name: "aws_verifiedaccess_endpoint",
 		F:    sweepVerifiedAccessEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_instance", &resource.Sweeper{
 		Name: "aws_verifiedaccess_instance",
 		F:    sweepVerifiedAccessInstances,
 		Dependencies: []string{
@@ -480,41 +481,41 @@ func sweepCapacityReservations(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.EC2Client(ctx)
+	input := &ec2.DescribeCapacityReservationsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
 
-	resp, err := conn.DescribeCapacityReservations(ctx, &ec2.DescribeCapacityReservationsInput{})
-
-	if awsv2.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping EC2 Capacity Reservation sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("Error retrieving EC2 Capacity Reservations: %s", err)
-	}
-
-	if len(resp.CapacityReservations) == 0 {
-		log.Print("[DEBUG] No EC2 Capacity Reservations to sweep")
-		return nil
-	}
+	pages := ec2.NewDescribeCapacityReservationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
 
-	for _, r := range resp.CapacityReservations {
-		if r.State != awstypes.CapacityReservationStateCancelled && r.State != awstypes.CapacityReservationStateExpired {
-			id := aws.ToString(r.CapacityReservationId)
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping EC2 Capacity Reservation sweep for %s: %s", region, err)
+			return nil
+		}
 
-			log.Printf("[INFO] Cancelling EC2 Capacity Reservation EC2 Instance: %s", id)
+		if err != nil {
+			return fmt.Errorf("error listing EC2 Capacity Reservations (%s): %w", region, err)
+		}
 
-			opts := &ec2.CancelCapacityReservationInput{
-				CapacityReservationId: aws.String(id),
+		for _, v := range page.CapacityReservations {
+			if v.State == awstypes.CapacityReservationStateCancelled || v.State == awstypes.CapacityReservationStateExpired {
+				continue
 			}
 
-			_, err := conn.CancelCapacityReservation(ctx, opts)
+			r := resourceCapacityReservation()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.CapacityReservationId))
 
-			if err != nil {
-				log.Printf("[ERROR] Error cancelling EC2 Capacity Reservation (%s): %s", id, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 	}
 
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Capacity Reservations (%s): %w", region, err)
+	}
+
 	return nil
 }
 
@@ -1045,15 +1046,15 @@ func sweepInstances(region string) error {
 					continue
 				}
 
-				if err := disableInstanceAPIStop(ctx, conn, id, false); err != nil {
-					log.Printf("[INFO] EC2 Instance (%s): %s", id, err)
-				}
-
 				r := resourceInstance()
 				d := r.Data(nil)
 				d.SetId(id)
 
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, &instanceSweeper{
+					SweepableWithMetadata: sweep.NewSweepResource(r, d, client),
+					conn:                  conn,
+					id:                    id,
+				})
 			}
 		}
 	}
@@ -1067,6 +1068,21 @@ func sweepInstances(region string) error {
 	return nil
 }
 
+// instanceSweeper disables an EC2 Instance's stop protection before terminating it.
+type instanceSweeper struct {
+	sweep.SweepableWithMetadata
+	conn *ec2.Client
+	id   string
+}
+
+func (s *instanceSweeper) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	if err := disableInstanceAPIStop(ctx, s.conn, s.id, false); err != nil {
+		log.Printf("[INFO] EC2 Instance (%s): %s", s.id, err)
+	}
+
+	return s.SweepableWithMetadata.Delete(ctx, timeout, optFns...)
+}
+
 func sweepInternetGateways(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -1495,14 +1511,12 @@ func sweepPlacementGroups(region string) error {
 func sweepRouteTables(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-
 	conn := client.EC2Client(ctx)
-	var sweeperErrs *multierror.Error
 	input := &ec2.DescribeRouteTablesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	pages := ec2.NewDescribeRouteTablesPaginator(conn, input)
 	for pages.HasMorePages() {
@@ -1510,42 +1524,21 @@ func sweepRouteTables(region string) error {
 
 		if awsv2.SkipSweepError(err) {
 			log.Printf("[WARN] Skipping EC2 Route Table sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil()
+			return nil
 		}
 
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EC2 Route Tables: %w", err))
+			return fmt.Errorf("error listing EC2 Route Tables (%s): %w", region, err)
 		}
 
-		for _, routeTable := range page.RouteTables {
-			id := aws.ToString(routeTable.RouteTableId)
-			isMainRouteTableAssociation := false
-
-			for _, routeTableAssociation := range routeTable.Associations {
-				if aws.ToBool(routeTableAssociation.Main) {
-					isMainRouteTableAssociation = true
-					break
-				}
-
-				associationID := aws.ToString(routeTableAssociation.RouteTableAssociationId)
-
-				input := &ec2.DisassociateRouteTableInput{
-					AssociationId: routeTableAssociation.RouteTableAssociationId,
-				}
-
-				log.Printf("[DEBUG] Deleting EC2 Route Table Association: %s", associationID)
-				_, err := conn.DisassociateRouteTable(ctx, input)
-
-				if err != nil {
-					sweeperErr := fmt.Errorf("error deleting EC2 Route Table (%s) Association (%s): %w", id, associationID, err)
-					log.Printf("[ERROR] %s", sweeperErr)
-					sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					continue
-				}
-			}
+		for _, v := range page.RouteTables {
+			id := aws.ToString(v.RouteTableId)
 
-			if isMainRouteTableAssociation {
-				for _, route := range routeTable.Routes {
+			if slices.ContainsFunc(v.Associations, func(v awstypes.RouteTableAssociation) bool {
+				return aws.ToBool(v.Main)
+			}) {
+				// Main route tables can't be deleted, so delete their non-local routes.
+				for _, route := range v.Routes {
 					if gatewayID := aws.ToString(route.GatewayId); gatewayID == gatewayIDLocal || gatewayID == gatewayIDVPCLattice {
 						continue
 					}
@@ -1556,57 +1549,81 @@ func sweepRouteTables(region string) error {
 						continue
 					}
 
-					input := &ec2.DeleteRouteInput{
-						DestinationCidrBlock:     route.DestinationCidrBlock,
-						DestinationIpv6CidrBlock: route.DestinationIpv6CidrBlock,
-						RouteTableId:             routeTable.RouteTableId,
-					}
-
-					log.Printf("[DEBUG] Deleting EC2 Route Table (%s) Route", id)
-					_, err := conn.DeleteRoute(ctx, input)
-
-					if err != nil {
-						sweeperErr := fmt.Errorf("error deleting EC2 Route Table (%s) Route: %w", id, err)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-						continue
-					}
+					sweepResources = append(sweepResources, &mainRouteTableRouteSweeper{
+						conn:         conn,
+						routeTableID: id,
+						route:        route,
+						tags:         keyValueTags(ctx, v.Tags).Map(),
+					})
 				}
 
 				continue
 			}
 
-			input := &ec2.DeleteRouteTableInput{
-				RouteTableId: routeTable.RouteTableId,
-			}
-
-			log.Printf("[DEBUG] Deleting EC2 Route Table: %s", id)
-			_, err := conn.DeleteRouteTable(ctx, input)
+			// The Route Table's associations are deleted with it.
+			r := resourceRouteTable()
+			d := r.Data(nil)
+			d.SetId(id)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting EC2 Route Table (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Route Tables (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+type mainRouteTableRouteSweeper struct {
+	conn         *ec2.Client
+	routeTableID string
+	route        awstypes.Route
+	tags         map[string]string
+}
+
+func (s *mainRouteTableRouteSweeper) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	input := &ec2.DeleteRouteInput{
+		DestinationCidrBlock:     s.route.DestinationCidrBlock,
+		DestinationIpv6CidrBlock: s.route.DestinationIpv6CidrBlock,
+		DestinationPrefixListId:  s.route.DestinationPrefixListId,
+		RouteTableId:             aws.String(s.routeTableID),
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Route Table (%s) Route", s.routeTableID)
+	_, err := s.conn.DeleteRoute(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteNotFound, errCodeInvalidRouteTableIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting EC2 Route Table (%s) Route: %w", s.routeTableID, err)
+	}
+
+	return nil
+}
+
+func (s *mainRouteTableRouteSweeper) Metadata(ctx context.Context, refresh bool) (sweep.Metadata, error) {
+	return sweep.Metadata{
+		ID:   s.routeTableID,
+		Tags: s.tags,
+	}, nil
 }
 
 func sweepSecurityGroups(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-
 	conn := client.EC2Client(ctx)
 	input := &ec2.DescribeSecurityGroupsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
 
-	// Delete all non-default EC2 Security Group Rules to prevent DependencyViolation errors
 	pages := ec2.NewDescribeSecurityGroupsPaginator(conn, input)
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
@@ -1617,84 +1634,92 @@ func sweepSecurityGroups(region string) error {
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error retrieving EC2 Security Groups: %w", err)
+			return fmt.Errorf("error listing EC2 Security Groups (%s): %w", region, err)
 		}
 
-		for _, sg := range page.SecurityGroups {
-			if aws.ToString(sg.GroupName) == "default" {
-				log.Printf("[DEBUG] Skipping default EC2 Security Group: %s", aws.ToString(sg.GroupId))
+		for _, v := range page.SecurityGroups {
+			if aws.ToString(v.GroupName) == "default" {
+				log.Printf("[DEBUG] Skipping default EC2 Security Group: %s", aws.ToString(v.GroupId))
 				continue
 			}
 
-			if sg.IpPermissions != nil {
-				req := &ec2.RevokeSecurityGroupIngressInput{
-					GroupId:       sg.GroupId,
-					IpPermissions: sg.IpPermissions,
-				}
-
-				if _, err = conn.RevokeSecurityGroupIngress(ctx, req); err != nil {
-					log.Printf("[ERROR] Error revoking ingress rule for Security Group (%s): %s", aws.ToString(sg.GroupId), err)
-				}
-			}
+			sweepResources = append(sweepResources, &securityGroupSweeper{
+				conn:          conn,
+				securityGroup: v,
+			})
+		}
+	}
 
-			if sg.IpPermissionsEgress != nil {
-				req := &ec2.RevokeSecurityGroupEgressInput{
-					GroupId:       sg.GroupId,
-					IpPermissions: sg.IpPermissionsEgress,
-				}
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
-				if _, err = conn.RevokeSecurityGroupEgress(ctx, req); err != nil {
-					log.Printf("[ERROR] Error revoking egress rule for Security Group (%s): %s", aws.ToString(sg.GroupId), err)
-				}
-			}
-		}
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Security Groups (%s): %w", region, err)
 	}
 
-	pages = ec2.NewDescribeSecurityGroupsPaginator(conn, input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
+	return nil
+}
 
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping EC2 Security Group sweep for %s: %s", region, err)
-			return nil
+// securityGroupSweeper revokes a Security Group's rules before deleting it.
+// Security Groups referencing each other are swept concurrently, so deletion is retried on DependencyViolation
+// until the referencing rules have been revoked.
+type securityGroupSweeper struct {
+	conn          *ec2.Client
+	securityGroup awstypes.SecurityGroup
+}
+
+func (s *securityGroupSweeper) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	id := aws.ToString(s.securityGroup.GroupId)
+
+	if len(s.securityGroup.IpPermissions) > 0 {
+		input := &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:       aws.String(id),
+			IpPermissions: s.securityGroup.IpPermissions,
 		}
 
-		if err != nil {
-			return fmt.Errorf("Error retrieving EC2 Security Groups: %w", err)
+		if _, err := s.conn.RevokeSecurityGroupIngress(ctx, input); err != nil {
+			log.Printf("[ERROR] Error revoking ingress rule for Security Group (%s): %s", id, err)
 		}
+	}
 
-		for _, sg := range page.SecurityGroups {
-			if aws.ToString(sg.GroupName) == "default" {
-				log.Printf("[DEBUG] Skipping default EC2 Security Group: %s", aws.ToString(sg.GroupId))
-				continue
-			}
+	if len(s.securityGroup.IpPermissionsEgress) > 0 {
+		input := &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:       aws.String(id),
+			IpPermissions: s.securityGroup.IpPermissionsEgress,
+		}
 
-			input := &ec2.DeleteSecurityGroupInput{
-				GroupId: sg.GroupId,
-			}
+		if _, err := s.conn.RevokeSecurityGroupEgress(ctx, input); err != nil {
+			log.Printf("[ERROR] Error revoking egress rule for Security Group (%s): %s", id, err)
+		}
+	}
 
-			// Handle EC2 eventual consistency
-			err := retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
-				_, err := conn.DeleteSecurityGroup(ctx, input)
+	input := &ec2.DeleteSecurityGroupInput{
+		GroupId: aws.String(id),
+	}
 
-				if tfawserr.ErrCodeEquals(err, "DependencyViolation") {
-					return retry.RetryableError(err)
-				}
-				if err != nil {
-					return retry.NonRetryableError(err)
-				}
-				return nil
-			})
+	// Handle EC2 eventual consistency
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 1*time.Minute, func() (interface{}, error) {
+		return s.conn.DeleteSecurityGroup(ctx, input)
+	}, errCodeDependencyViolation)
 
-			if err != nil {
-				log.Printf("[ERROR] Error deleting Security Group (%s): %s", aws.ToString(sg.GroupId), err)
-			}
-		}
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidGroupNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting EC2 Security Group (%s): %w", id, err)
 	}
 
 	return nil
 }
 
+func (s *securityGroupSweeper) Metadata(ctx context.Context, refresh bool) (sweep.Metadata, error) {
+	return sweep.Metadata{
+		ID:   aws.ToString(s.securityGroup.GroupId),
+		Name: aws.ToString(s.securityGroup.GroupName),
+		Tags: keyValueTags(ctx, s.securityGroup.Tags).Map(),
+	}, nil
+}
+
 func sweepSpotFleetRequests(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_ecr_authorization_token", name="Authorization Token")
+func newAuthorizationTokenEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &authorizationTokenEphemeralResource{}, nil
+}
+
+type authorizationTokenEphemeralResource struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (*authorizationTokenEphemeralResource) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_ecr_authorization_token"
+}
+
+func (e *authorizationTokenEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authorization_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expires_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrPassword: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"proxy_endpoint": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrUserName: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *authorizationTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data authorizationTokenEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().ECRClient(ctx)
+
+	input := ecr.GetAuthorizationTokenInput{}
+	output, err := conn.GetAuthorizationToken(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading ECR Authorization Token", err.Error())
+
+		return
+	}
+
+	if len(output.AuthorizationData) == 0 {
+		response.Diagnostics.AddError("reading ECR Authorization Token", "empty result")
+
+		return
+	}
+
+	authorizationData := output.AuthorizationData[0]
+	authorizationToken := aws.ToString(authorizationData.AuthorizationToken)
+	authBytes, err := itypes.Base64Decode(authorizationToken)
+	if err != nil {
+		response.Diagnostics.AddError("decoding ECR Authorization Token", err.Error())
+
+		return
+	}
+
+	userName, password, ok := strings.Cut(string(authBytes), ":")
+	if !ok {
+		response.Diagnostics.AddError("decoding ECR Authorization Token", "unknown ECR authorization token format")
+
+		return
+	}
+
+	data.AuthorizationToken = types.StringValue(authorizationToken)
+	data.ExpiresAt = timetypes.NewRFC3339TimePointerValue(authorizationData.ExpiresAt)
+	data.Password = types.StringValue(password)
+	data.ProxyEndpoint = fwflex.StringToFramework(ctx, authorizationData.ProxyEndpoint)
+	data.UserName = types.StringValue(userName)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type authorizationTokenEphemeralResourceModel struct {
+	AuthorizationToken types.String      `tfsdk:"authorization_token"`
+	ExpiresAt          timetypes.RFC3339 `tfsdk:"expires_at"`
+	Password           types.String      `tfsdk:"password"`
+	ProxyEndpoint      types.String      `tfsdk:"proxy_endpoint"`
+	UserName           types.String      `tfsdk:"user_name"`
+}
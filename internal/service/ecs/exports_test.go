@@ -22,6 +22,7 @@ var (
 	FindTag                                 = findTag
 	FindTaskDefinitionByFamilyOrARN         = findTaskDefinitionByFamilyOrARN
 	FindTaskSetNoTagsByThreePartKey         = findTaskSetNoTagsByThreePartKey
+	PolicyTrustsServicePrincipal            = policyTrustsServicePrincipal
 	RoleNameFromARN                         = roleNameFromARN
 	TaskDefinitionARNStripRevision          = taskDefinitionARNStripRevision
 	ValidTaskDefinitionContainerDefinitions = validTaskDefinitionContainerDefinitions
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/preflight"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	preflightCheckTaskRoleTrust = "ecs_task_role_trust"
+
+	ecsTasksServicePrincipal = "ecs-tasks.amazonaws.com"
+)
+
+func (p *servicePackage) PreflightChecks(ctx context.Context) []*preflight.Check {
+	return []*preflight.Check{
+		{
+			Name:     preflightCheckTaskRoleTrust,
+			TypeName: "aws_ecs_task_definition",
+			Func:     taskRoleTrustPreflightCheck(names.AttrExecutionRoleARN, "task_role_arn"),
+		},
+	}
+}
+
+// taskRoleTrustPreflightCheck returns a preflight check that the IAM roles referenced by the specified attributes can be assumed by ECS tasks.
+func taskRoleTrustPreflightCheck(attrNames ...string) preflight.CheckFunc {
+	return func(ctx context.Context, meta *conns.AWSClient, request preflight.Request) (diag.Diagnostics, error) {
+		var diags diag.Diagnostics
+
+		for _, attrName := range attrNames {
+			if !request.Plan.HasChange(attrName) {
+				continue
+			}
+
+			roleARN, ok := request.Plan.GetString(attrName)
+			if !ok || roleARN == "" {
+				continue
+			}
+
+			v, err := arn.Parse(roleARN)
+			if err != nil {
+				continue
+			}
+
+			// Roles in other accounts cannot be read.
+			if v.AccountID != meta.AccountID(ctx) {
+				continue
+			}
+
+			roleName := v.Resource[strings.LastIndex(v.Resource, "/")+1:]
+			role, err := tfiam.FindRoleByName(ctx, meta.IAMClient(ctx), roleName)
+
+			if tfresource.NotFound(err) {
+				diags.AddAttributeError(path.Root(attrName),
+					"IAM role not found",
+					fmt.Sprintf("IAM role (%s) does not exist.", roleARN),
+				)
+				continue
+			}
+
+			if err != nil {
+				return diags, fmt.Errorf("reading IAM Role (%s): %w", roleName, err)
+			}
+
+			trusted, err := policyTrustsServicePrincipal(aws.ToString(role.AssumeRolePolicyDocument), ecsTasksServicePrincipal)
+
+			if err != nil {
+				return diags, fmt.Errorf("reading IAM Role (%s) trust policy: %w", roleName, err)
+			}
+
+			if !trusted {
+				diags.AddAttributeWarning(path.Root(attrName),
+					"IAM role not assumable by ECS tasks",
+					fmt.Sprintf("The trust policy of IAM role (%s) does not allow the %s service principal to assume the role.", roleARN, ecsTasksServicePrincipal),
+				)
+			}
+		}
+
+		return diags, nil
+	}
+}
+
+// policyTrustsServicePrincipal returns whether the specified, possibly URL-encoded, trust policy allows the specified service principal to assume the role.
+func policyTrustsServicePrincipal(policy, servicePrincipal string) (bool, error) {
+	policy, err := url.QueryUnescape(policy)
+	if err != nil {
+		return false, err
+	}
+
+	var doc tfiam.IAMPolicyDoc
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return false, err
+	}
+
+	for _, statement := range doc.Statements {
+		if statement.Effect != "Allow" {
+			continue
+		}
+
+		for _, principal := range statement.Principals {
+			if principal.Type != "Service" {
+				continue
+			}
+
+			switch v := principal.Identifiers.(type) {
+			case string:
+				if v == servicePrincipal {
+					return true, nil
+				}
+			case []string:
+				if slices.Contains(v, servicePrincipal) {
+					return true, nil
+				}
+			}
+		}
+	}
+
+	return false, nil
+}
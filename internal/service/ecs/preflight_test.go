@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"testing"
+
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+)
+
+func TestPolicyTrustsServicePrincipal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy      string
+		expected    bool
+		expectError bool
+	}{
+		"service principal": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ecs-tasks.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			expected: true,
+		},
+		"service principal list": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":["ec2.amazonaws.com","ecs-tasks.amazonaws.com"]},"Action":"sts:AssumeRole"}]}`,
+			expected: true,
+		},
+		"URL encoded": {
+			policy:   `%7B%22Version%22%3A%222012-10-17%22%2C%22Statement%22%3A%5B%7B%22Effect%22%3A%22Allow%22%2C%22Principal%22%3A%7B%22Service%22%3A%22ecs-tasks.amazonaws.com%22%7D%2C%22Action%22%3A%22sts%3AAssumeRole%22%7D%5D%7D`,
+			expected: true,
+		},
+		"other service principal": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+		},
+		"deny": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":{"Service":"ecs-tasks.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+		},
+		"invalid JSON": {
+			policy:      `{`,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfecs.PolicyTrustsServicePrincipal(testCase.policy, "ecs-tasks.amazonaws.com")
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expectError = %t", err, want)
+			}
+			if got != testCase.expected {
+				t.Errorf("PolicyTrustsServicePrincipal = %t, want %t", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -4,8 +4,10 @@
 package emr
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/emr"
@@ -14,15 +16,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_emr_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emr_cluster", &resource.Sweeper{
 		Name: "aws_emr_cluster",
 		F:    sweepClusters,
 	})
 
-	resource.AddTestSweepers("aws_emr_studio", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emr_studio", &resource.Sweeper{
 		Name: "aws_emr_studio",
 		F:    sweepStudios,
 	})
@@ -56,21 +59,15 @@ func sweepClusters(region string) error {
 
 		for _, v := range page.Clusters {
 			id := aws.ToString(v.Id)
-
-			_, err := conn.SetTerminationProtection(ctx, &emr.SetTerminationProtectionInput{
-				JobFlowIds:           []string{id},
-				TerminationProtected: aws.Bool(false),
-			})
-
-			if err != nil {
-				log.Printf("[ERROR] unsetting EMR Cluster (%s) termination protection: %s", id, err)
-			}
-
 			r := resourceCluster()
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, &clusterSweeper{
+				SweepableWithMetadata: sweep.NewSweepResource(r, d, client),
+				conn:                  conn,
+				id:                    id,
+			})
 		}
 	}
 
@@ -83,6 +80,26 @@ func sweepClusters(region string) error {
 	return nil
 }
 
+// clusterSweeper unsets an EMR Cluster's termination protection before deleting it.
+type clusterSweeper struct {
+	sweep.SweepableWithMetadata
+	conn *emr.Client
+	id   string
+}
+
+func (s *clusterSweeper) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	_, err := s.conn.SetTerminationProtection(ctx, &emr.SetTerminationProtectionInput{
+		JobFlowIds:           []string{s.id},
+		TerminationProtected: aws.Bool(false),
+	})
+
+	if err != nil {
+		log.Printf("[ERROR] unsetting EMR Cluster (%s) termination protection: %s", s.id, err)
+	}
+
+	return s.SweepableWithMetadata.Delete(ctx, timeout, optFns...)
+}
+
 func sweepStudios(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -4,25 +4,28 @@
 package guardduty
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/guardduty"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
 		Name:         "aws_guardduty_detector",
 		F:            sweepDetectors,
 		Dependencies: []string{"aws_guardduty_publishing_destination"},
 	})
 
-	resource.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
 		Name: "aws_guardduty_publishing_destination",
 		F:    sweepPublishingDestinations,
 	})
@@ -31,17 +34,14 @@ func RegisterSweepers() {
 func sweepDetectors(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-
 	conn := client.GuardDutyClient(ctx)
 	input := &guardduty.ListDetectorsInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	pages := guardduty.NewListDetectorsPaginator(conn, input)
-
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
@@ -51,62 +51,80 @@ func sweepDetectors(region string) error {
 		}
 
 		if err != nil {
-			return fmt.Errorf("error retrieving GuardDuty Detectors: %w", err)
+			return fmt.Errorf("error listing GuardDuty Detectors (%s): %w", region, err)
 		}
 
-		for _, detectorID := range page.DetectorIds {
-			input := &guardduty.DeleteDetectorInput{
-				DetectorId: &detectorID,
-			}
-
-			log.Printf("[INFO] Deleting GuardDuty Detector: %s", detectorID)
-			_, err := conn.DeleteDetector(ctx, input)
-			if tfawserr.ErrCodeContains(err, "AccessDenied") {
-				log.Printf("[WARN] Skipping GuardDuty Detector (%s): %s", detectorID, err)
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting GuardDuty Detector (%s): %w", detectorID, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			}
+		for _, v := range page.DetectorIds {
+			sweepResources = append(sweepResources, &detectorSweeper{
+				conn: conn,
+				id:   v,
+			})
 		}
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping GuardDuty Detectors (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+type detectorSweeper struct {
+	conn *guardduty.Client
+	id   string
+}
+
+func (s *detectorSweeper) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	input := &guardduty.DeleteDetectorInput{
+		DetectorId: aws.String(s.id),
+	}
+
+	log.Printf("[INFO] Deleting GuardDuty Detector: %s", s.id)
+	_, err := s.conn.DeleteDetector(ctx, input)
+
+	if tfawserr.ErrCodeContains(err, "AccessDenied") {
+		log.Printf("[WARN] Skipping GuardDuty Detector (%s): %s", s.id, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting GuardDuty Detector (%s): %w", s.id, err)
+	}
+
+	return nil
 }
 
 func sweepPublishingDestinations(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-
 	conn := client.GuardDutyClient(ctx)
-	var sweeperErrs *multierror.Error
-
-	detect_input := &guardduty.ListDetectorsInput{}
-
-	pages := guardduty.NewListDetectorsPaginator(conn, detect_input)
+	input := &guardduty.ListDetectorsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
 
+	pages := guardduty.NewListDetectorsPaginator(conn, input)
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping GuardDuty Publishing Destination sweep for %s: %s", region, err)
+			return nil
+		}
+
 		if err != nil {
-			sweeperErr := fmt.Errorf("Error receiving Guardduty detectors for publishing sweep : %w", err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+			return fmt.Errorf("error listing GuardDuty Detectors (%s): %w", region, err)
 		}
 
 		for _, detectorID := range page.DetectorIds {
-			list_input := &guardduty.ListPublishingDestinationsInput{
-				DetectorId: &detectorID,
+			input := &guardduty.ListPublishingDestinationsInput{
+				DetectorId: aws.String(detectorID),
 			}
 
-			pages := guardduty.NewListPublishingDestinationsPaginator(conn, list_input)
-
+			pages := guardduty.NewListPublishingDestinationsPaginator(conn, input)
 			for pages.HasMorePages() {
 				page, err := pages.NextPage(ctx)
 
@@ -116,27 +134,25 @@ func sweepPublishingDestinations(region string) error {
 				}
 
 				if err != nil {
-					return fmt.Errorf("error retrieving GuardDuty Publishing Destinations: %s", err)
+					return fmt.Errorf("error listing GuardDuty Publishing Destinations (%s): %w", region, err)
 				}
 
-				for _, destination_element := range page.Destinations {
-					input := &guardduty.DeletePublishingDestinationInput{
-						DestinationId: destination_element.DestinationId,
-						DetectorId:    &detectorID,
-					}
+				for _, v := range page.Destinations {
+					r := ResourcePublishingDestination()
+					d := r.Data(nil)
+					d.SetId(fmt.Sprintf("%s:%s", detectorID, aws.ToString(v.DestinationId)))
 
-					log.Printf("[INFO] Deleting GuardDuty Publishing Destination: %s", *destination_element.DestinationId)
-					_, err := conn.DeletePublishingDestination(ctx, input)
-
-					if err != nil {
-						sweeperErr := fmt.Errorf("error deleting GuardDuty Publishing Destination (%s): %w", *destination_element.DestinationId, err)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					}
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 				}
 			}
 		}
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping GuardDuty Publishing Destinations (%s): %w", region, err)
+	}
+
+	return nil
 }
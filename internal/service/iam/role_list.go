@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/list"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @ListResource("aws_iam_role", name="Role")
+func listRoles(ctx context.Context, meta any, filter list.Filter) iter.Seq2[list.Result, error] {
+	return func(yield func(list.Result, error) bool) {
+		conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+		roles, err := findRoles(ctx, conn, &iam.ListRolesInput{}, func(v awstypes.Role) bool {
+			// Service-linked roles are managed by aws_iam_service_linked_role.
+			return !strings.HasPrefix(aws.ToString(v.Path), "/aws-service-role/") && filter.MatchName(aws.ToString(v.RoleName))
+		})
+
+		if err != nil {
+			yield(list.Result{}, fmt.Errorf("listing IAM Roles: %w", err))
+			return
+		}
+
+		for _, v := range roles {
+			name := aws.ToString(v.RoleName)
+
+			if filter.HasTags() {
+				tags, err := roleKeyValueTags(ctx, conn, name)
+
+				if err != nil {
+					yield(list.Result{}, fmt.Errorf("listing tags for IAM Role (%s): %w", name, err))
+					return
+				}
+
+				if !filter.MatchTags(tags.Map()) {
+					continue
+				}
+			}
+
+			result := list.Result{
+				DisplayName: name,
+				Identity: map[string]string{
+					names.AttrName: name,
+				},
+				ImportID: name,
+			}
+
+			if !yield(result, nil) {
+				return
+			}
+		}
+	}
+}
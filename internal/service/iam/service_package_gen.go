@@ -58,6 +58,16 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 	}
 }
 
+func (p *servicePackage) ListResources(ctx context.Context) []*types.ServicePackageListResource {
+	return []*types.ServicePackageListResource{
+		{
+			List:     listRoles,
+			TypeName: "aws_iam_role",
+			Name:     "Role",
+		},
+	}
+}
+
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
 		{
@@ -229,6 +239,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrName,
 				ResourceType:        "Role",
 			},
+			Identity: types.GlobalSingleParameterIdentity(names.AttrName),
 		},
 		{
 			Factory:  resourceRolePolicy,
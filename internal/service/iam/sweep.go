@@ -29,7 +29,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_iam_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_group", &resource.Sweeper{
 		Name: "aws_iam_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -43,7 +43,7 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_iam_openid_connect_provider", sweepOpenIDConnectProvider)
 
-	resource.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
 		Name: "aws_iam_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -55,7 +55,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_role", &resource.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_batch_compute_environment",
@@ -92,14 +92,14 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_iam_signing_certificate", sweepSigningCertificates)
 
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
 		Name: "aws_iam_server_certificate",
 		F:    sweepServerCertificates,
 	})
 
 	awsv2.Register("aws_iam_service_linked_role", sweepServiceLinkedRoles)
 
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_user", &resource.Sweeper{
 		Name: "aws_iam_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -115,113 +115,118 @@ func RegisterSweepers() {
 func sweepGroups(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-
 	conn := client.IAMClient(ctx)
 	input := &iam.ListGroupsInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	pages := iam.NewListGroupsPaginator(conn, input)
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
+
 		if awsv2.SkipSweepError(err) {
 			log.Printf("[WARN] Skipping IAM Group sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil()
+			return nil
 		}
 
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving IAM Groups: %w", err))
+			return fmt.Errorf("error listing IAM Groups (%s): %w", region, err)
 		}
 
-		for _, group := range page.Groups {
-			name := aws.ToString(group.GroupName)
+		for _, v := range page.Groups {
+			name := aws.ToString(v.GroupName)
 
 			if name == "Admin" || name == "TerraformAccTests" {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting IAM Group: %s", name)
+			sweepResources = append(sweepResources, &groupSweeper{
+				conn:  conn,
+				group: v,
+			})
+		}
+	}
 
-			getGroupInput := &iam.GetGroupInput{
-				GroupName: group.GroupName,
-			}
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
-			getGroupOutput, err := conn.GetGroup(ctx, getGroupInput)
+	if err != nil {
+		return fmt.Errorf("error sweeping IAM Groups (%s): %w", region, err)
+	}
 
-			if errs.IsA[*awstypes.NoSuchEntityException](err) {
-				continue
-			}
+	return nil
+}
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error reading IAM Group (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+// groupSweeper removes an IAM Group's users and policies before deleting it.
+type groupSweeper struct {
+	conn  *iam.Client
+	group awstypes.Group
+}
 
-			if getGroupOutput != nil {
-				for _, user := range getGroupOutput.Users {
-					username := aws.ToString(user.UserName)
+func (s *groupSweeper) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	name := aws.ToString(s.group.GroupName)
 
-					log.Printf("[INFO] Removing IAM User (%s) from Group: %s", username, name)
+	log.Printf("[INFO] Deleting IAM Group: %s", name)
+	output, err := s.conn.GetGroup(ctx, &iam.GetGroupInput{
+		GroupName: aws.String(name),
+	})
 
-					input := &iam.RemoveUserFromGroupInput{
-						UserName:  user.UserName,
-						GroupName: group.GroupName,
-					}
+	if errs.IsA[*awstypes.NoSuchEntityException](err) {
+		return nil
+	}
 
-					_, err := conn.RemoveUserFromGroup(ctx, input)
+	if err != nil {
+		return fmt.Errorf("reading IAM Group (%s): %w", name, err)
+	}
 
-					if errs.IsA[*awstypes.NoSuchEntityException](err) {
-						continue
-					}
+	for _, user := range output.Users {
+		username := aws.ToString(user.UserName)
 
-					if err != nil {
-						sweeperErr := fmt.Errorf("error removing IAM User (%s) from IAM Group (%s): %w", username, name, err)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-						continue
-					}
-				}
-			}
+		log.Printf("[INFO] Removing IAM User (%s) from Group: %s", username, name)
+		_, err := s.conn.RemoveUserFromGroup(ctx, &iam.RemoveUserFromGroupInput{
+			GroupName: aws.String(name),
+			UserName:  aws.String(username),
+		})
 
-			input := &iam.DeleteGroupInput{
-				GroupName: group.GroupName,
-			}
+		if errs.IsA[*awstypes.NoSuchEntityException](err) {
+			continue
+		}
 
-			if err := deleteGroupPolicyAttachments(ctx, conn, name); err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Group (%s) policy attachments: %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+		if err != nil {
+			return fmt.Errorf("removing IAM User (%s) from IAM Group (%s): %w", username, name, err)
+		}
+	}
 
-			if err := deleteGroupPolicies(ctx, conn, name); err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Group (%s) policies: %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+	if err := deleteGroupPolicyAttachments(ctx, s.conn, name); err != nil {
+		return fmt.Errorf("deleting IAM Group (%s) policy attachments: %w", name, err)
+	}
 
-			_, err = conn.DeleteGroup(ctx, input)
+	if err := deleteGroupPolicies(ctx, s.conn, name); err != nil {
+		return fmt.Errorf("deleting IAM Group (%s) policies: %w", name, err)
+	}
 
-			if errs.IsA[*awstypes.NoSuchEntityException](err) {
-				continue
-			}
+	_, err = s.conn.DeleteGroup(ctx, &iam.DeleteGroupInput{
+		GroupName: aws.String(name),
+	})
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Group (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-		}
+	if errs.IsA[*awstypes.NoSuchEntityException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting IAM Group (%s): %w", name, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return nil
+}
+
+func (s *groupSweeper) Metadata(ctx context.Context, refresh bool) (sweep.Metadata, error) {
+	return sweep.Metadata{
+		ID:        aws.ToString(s.group.GroupName),
+		Name:      aws.ToString(s.group.GroupName),
+		CreatedAt: aws.ToTime(s.group.CreateDate),
+	}, nil
 }
 
 func sweepInstanceProfile(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
@@ -427,55 +432,72 @@ func sweepRoles(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.IAMClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
 
-	roles := make([]string, 0)
-	pages := iam.NewListRolesPaginator(conn, &iam.ListRolesInput{})
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping IAM Role sweep for %s: %s", region, err)
-			return nil
+	roles, err := findRoles(ctx, conn, &iam.ListRolesInput{}, func(v awstypes.Role) bool {
+		if roleName := aws.ToString(v.RoleName); !roleNameFilter(roleName) {
+			log.Printf("[INFO] Skipping IAM Role (%s): no match on allow-list", roleName)
+			return false
 		}
 
-		if err != nil {
-			return fmt.Errorf("retrieving IAM Roles: %w", err)
-		}
+		return true
+	})
 
-		for _, role := range page.Roles {
-			roleName := aws.ToString(role.RoleName)
-			if roleNameFilter(roleName) {
-				roles = append(roles, roleName)
-			} else {
-				log.Printf("[INFO] Skipping IAM Role (%s): no match on allow-list", roleName)
-			}
-		}
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IAM Role sweep for %s: %s", region, err)
+		return nil
 	}
 
-	if len(roles) == 0 {
-		log.Print("[DEBUG] No IAM Roles to sweep")
-		return nil
+	if err != nil {
+		return fmt.Errorf("retrieving IAM Roles: %w", err)
 	}
 
-	var sweeperErrs *multierror.Error
+	for _, role := range roles {
+		sweepResources = append(sweepResources, &roleSweeper{
+			conn: conn,
+			role: role,
+		})
+	}
 
-	for _, roleName := range roles {
-		log.Printf("[DEBUG] Deleting IAM Role (%s)", roleName)
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
-		err := deleteRole(ctx, conn, roleName, true, true, true)
+	if err != nil {
+		return fmt.Errorf("error sweeping IAM Roles (%s): %w", region, err)
+	}
 
-		if tfawserr.ErrCodeContains(err, "AccessDenied") {
-			log.Printf("[WARN] Skipping IAM Role (%s): %s", roleName, err)
-			continue
-		}
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting IAM Role (%s): %w", roleName, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	return nil
+}
+
+// roleSweeper deletes an IAM Role's instance profiles and policies before deleting it.
+type roleSweeper struct {
+	conn *iam.Client
+	role awstypes.Role
+}
+
+func (s *roleSweeper) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	roleName := aws.ToString(s.role.RoleName)
+
+	log.Printf("[DEBUG] Deleting IAM Role (%s)", roleName)
+	err := deleteRole(ctx, s.conn, roleName, true, true, true)
+
+	if tfawserr.ErrCodeContains(err, "AccessDenied") {
+		log.Printf("[WARN] Skipping IAM Role (%s): %s", roleName, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting IAM Role (%s): %w", roleName, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return nil
+}
+
+func (s *roleSweeper) Metadata(ctx context.Context, refresh bool) (sweep.Metadata, error) {
+	return sweep.Metadata{
+		ID:        aws.ToString(s.role.RoleName),
+		Name:      aws.ToString(s.role.RoleName),
+		CreatedAt: aws.ToTime(s.role.CreateDate),
+	}, nil
 }
 
 func sweepSAMLProvider(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
@@ -508,6 +530,7 @@ func sweepServerCertificates(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.IAMClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	pages := iam.NewListServerCertificatesPaginator(conn, &iam.ListServerCertificatesInput{})
 	for pages.HasMorePages() {
@@ -521,20 +544,22 @@ func sweepServerCertificates(region string) error {
 			return fmt.Errorf("Error retrieving IAM Server Certificates: %s", err)
 		}
 
-		for _, sc := range page.ServerCertificateMetadataList {
-			log.Printf("[INFO] Deleting IAM Server Certificate: %s", aws.ToString(sc.ServerCertificateName))
+		for _, v := range page.ServerCertificateMetadataList {
+			r := resourceServerCertificate()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.ServerCertificateId))
+			d.Set(names.AttrName, v.ServerCertificateName)
 
-			_, err := conn.DeleteServerCertificate(ctx, &iam.DeleteServerCertificateInput{
-				ServerCertificateName: sc.ServerCertificateName,
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete IAM Server Certificate %s: %s",
-					aws.ToString(sc.ServerCertificateName), err)
-				continue
-			}
+			sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
 		}
 	}
 
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IAM Server Certificates (%s): %w", region, err)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/preflight"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	preflightCheckKeyState = "kms_key_state"
+)
+
+func (p *servicePackage) PreflightChecks(ctx context.Context) []*preflight.Check {
+	return []*preflight.Check{
+		keyStatePreflightCheck("aws_cloudwatch_log_group", names.AttrKMSKeyID),
+		keyStatePreflightCheck("aws_db_instance", names.AttrKMSKeyID),
+		keyStatePreflightCheck("aws_ebs_volume", names.AttrKMSKeyID),
+		keyStatePreflightCheck("aws_rds_cluster", names.AttrKMSKeyID),
+		keyStatePreflightCheck("aws_secretsmanager_secret", names.AttrKMSKeyID),
+		keyStatePreflightCheck("aws_sns_topic", "kms_master_key_id"),
+		keyStatePreflightCheck("aws_sqs_queue", "kms_master_key_id"),
+	}
+}
+
+// keyStatePreflightCheck returns a preflight check that the KMS key referenced by the specified attribute is usable.
+func keyStatePreflightCheck(typeName, attrName string) *preflight.Check {
+	return &preflight.Check{
+		Name:     preflightCheckKeyState,
+		TypeName: typeName,
+		Func: func(ctx context.Context, meta *conns.AWSClient, request preflight.Request) (diag.Diagnostics, error) {
+			var diags diag.Diagnostics
+
+			if !request.Plan.HasChange(attrName) {
+				return diags, nil
+			}
+
+			keyID, ok := request.Plan.GetString(attrName)
+			if !ok || keyID == "" {
+				return diags, nil
+			}
+
+			conn := meta.KMSClient(ctx)
+			key, err := findKey(ctx, conn, &kms.DescribeKeyInput{
+				KeyId: aws.String(keyID),
+			})
+
+			if tfresource.NotFound(err) {
+				diags.AddAttributeError(path.Root(attrName),
+					"KMS key not found",
+					fmt.Sprintf("KMS key (%s) does not exist or is not accessible.", keyID),
+				)
+				return diags, nil
+			}
+
+			if err != nil {
+				return diags, fmt.Errorf("reading KMS Key (%s): %w", keyID, err)
+			}
+
+			switch state := key.KeyState; state {
+			case awstypes.KeyStateEnabled, awstypes.KeyStateCreating, awstypes.KeyStateUpdating:
+			case awstypes.KeyStateDisabled, awstypes.KeyStatePendingDeletion, awstypes.KeyStatePendingReplicaDeletion, awstypes.KeyStatePendingImport, awstypes.KeyStateUnavailable:
+				diags.AddAttributeError(path.Root(attrName),
+					"KMS key not usable",
+					fmt.Sprintf("KMS key (%s) is in the %s state and cannot be used for encryption.", aws.ToString(key.Arn), state),
+				)
+			default:
+				diags.AddAttributeWarning(path.Root(attrName),
+					"Unexpected KMS key state",
+					fmt.Sprintf("KMS key (%s) is in the %s state.", aws.ToString(key.Arn), state),
+				)
+			}
+
+			return diags, nil
+		},
+	}
+}
@@ -9,49 +9,48 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_lightsail_container_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_container_service", &resource.Sweeper{
 		Name: "aws_lightsail_container_service",
 		F:    sweepContainerServices,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_database", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_database", &resource.Sweeper{
 		Name: "aws_lightsail_database",
 		F:    sweepDatabases,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_disk", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_disk", &resource.Sweeper{
 		Name: "aws_lightsail_disk",
 		F:    sweepDisks,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_distribution", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_distribution", &resource.Sweeper{
 		Name: "aws_lightsail_distribution",
 		F:    sweepDistributions,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_domain", &resource.Sweeper{
 		Name: "aws_lightsail_domain",
 		F:    sweepDomains,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_instance", &resource.Sweeper{
 		Name: "aws_lightsail_instance",
 		F:    sweepInstances,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_lb", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_lb", &resource.Sweeper{
 		Name: "aws_lightsail_lb",
 		F:    sweepLoadBalancers,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
 		Name: "aws_lightsail_static_ip",
 		F:    sweepStaticIPs,
 	})
@@ -276,7 +275,7 @@ func sweepInstances(region string) error {
 	conn := client.LightsailClient(ctx)
 
 	input := &lightsail.GetInstancesInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	for {
 		output, err := conn.GetInstances(ctx, input)
@@ -291,19 +290,11 @@ func sweepInstances(region string) error {
 		}
 
 		for _, instance := range output.Instances {
-			name := aws.ToString(instance.Name)
-			input := &lightsail.DeleteInstanceInput{
-				InstanceName: instance.Name,
-			}
-
-			log.Printf("[INFO] Deleting Lightsail Instance: %s", name)
-			_, err := conn.DeleteInstance(ctx, input)
+			r := ResourceInstance()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(instance.Name))
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Lightsail Instance (%s): %s", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.ToString(output.NextPageToken) == "" {
@@ -313,7 +304,13 @@ func sweepInstances(region string) error {
 		input.PageToken = output.NextPageToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Lightsail Instances (%s): %w", region, err)
+	}
+
+	return nil
 }
 
 func sweepLoadBalancers(region string) error {
@@ -16,6 +16,7 @@ import (
 )
 
 // @FrameworkDataSource("aws_arn", name="ARN")
+// @Region(global=true)
 func newARNDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &arnDataSource{}
 
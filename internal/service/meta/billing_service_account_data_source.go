@@ -16,6 +16,7 @@ import (
 )
 
 // @FrameworkDataSource("aws_billing_service_account", name="Billing Service Account")
+// @Region(global=true)
 func newBillingServiceAccountDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &billingServiceAccountDataSource{}
 
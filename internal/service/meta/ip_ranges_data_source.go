@@ -24,6 +24,7 @@ import (
 )
 
 // @FrameworkDataSource("aws_ip_ranges", name="IP Ranges")
+// @Region(global=true)
 func newIPRangesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &ipRangesDataSource{}
 
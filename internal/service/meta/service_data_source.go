@@ -19,6 +19,7 @@ import (
 )
 
 // @FrameworkDataSource("aws_service", name="Service")
+// @Region(global=true)
 func newServiceDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &serviceDataSource{}
 
@@ -17,46 +17,82 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newARNDataSource,
 			TypeName: "aws_arn",
 			Name:     "ARN",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal:          true,
+				IsOverrideEnabled: false,
+			},
 		},
 		{
 			Factory:  newBillingServiceAccountDataSource,
 			TypeName: "aws_billing_service_account",
 			Name:     "Billing Service Account",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal:          true,
+				IsOverrideEnabled: false,
+			},
 		},
 		{
 			Factory:  newDefaultTagsDataSource,
 			TypeName: "aws_default_tags",
 			Name:     "Default Tags",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal:          true,
+				IsOverrideEnabled: false,
+			},
 		},
 		{
 			Factory:  newIPRangesDataSource,
 			TypeName: "aws_ip_ranges",
 			Name:     "IP Ranges",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal:          true,
+				IsOverrideEnabled: false,
+			},
 		},
 		{
 			Factory:  newPartitionDataSource,
 			TypeName: "aws_partition",
 			Name:     "Partition",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal:          true,
+				IsOverrideEnabled: false,
+			},
 		},
 		{
 			Factory:  newRegionDataSource,
 			TypeName: "aws_region",
 			Name:     "Region",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal:          true,
+				IsOverrideEnabled: false,
+			},
 		},
 		{
 			Factory:  newRegionsDataSource,
 			TypeName: "aws_regions",
 			Name:     "Regions",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal:          true,
+				IsOverrideEnabled: false,
+			},
 		},
 		{
 			Factory:  newServiceDataSource,
 			TypeName: "aws_service",
 			Name:     "Service",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal:          true,
+				IsOverrideEnabled: false,
+			},
 		},
 		{
 			Factory:  newServicePrincipalDataSource,
 			TypeName: "aws_service_principal",
 			Name:     "Service Principal",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal:          true,
+				IsOverrideEnabled: false,
+			},
 		},
 	}
 }
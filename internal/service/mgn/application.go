@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mgn_application", name="Application")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mgn/types;awstypes;awstypes.Application")
+func newApplicationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &applicationResource{}, nil
+}
+
+type applicationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*applicationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_mgn_application"
+}
+
+func (r *applicationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(600),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *applicationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data applicationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	name := data.Name.ValueString()
+	var input mgn.CreateApplicationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, applicationFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateApplication(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MGN Application (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, applicationFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *applicationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data applicationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	output, err := findApplicationByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MGN Application (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, applicationFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *applicationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new applicationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	if !new.Description.Equal(old.Description) || !new.Name.Equal(old.Name) {
+		input := mgn.UpdateApplicationInput{
+			ApplicationID: fwflex.StringFromFramework(ctx, new.ID),
+			Description:   aws.String(new.Description.ValueString()),
+			Name:          fwflex.StringFromFramework(ctx, new.Name),
+		}
+
+		_, err := conn.UpdateApplication(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MGN Application (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *applicationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data applicationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	// Applications must be archived before they can be deleted.
+	output, err := findApplicationByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MGN Application (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if !aws.ToBool(output.IsArchived) {
+		_, err := conn.ArchiveApplication(ctx, &mgn.ArchiveApplicationInput{
+			ApplicationID: fwflex.StringFromFramework(ctx, data.ID),
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("archiving MGN Application (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	_, err = conn.DeleteApplication(ctx, &mgn.DeleteApplicationInput{
+		ApplicationID: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MGN Application (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *applicationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// The API prefixes the application's ID with the resource type name.
+var applicationFlexOpt = fwflex.WithFieldNamePrefix("Application")
+
+func findApplicationByID(ctx context.Context, conn *mgn.Client, id string) (*awstypes.Application, error) {
+	input := &mgn.ListApplicationsInput{
+		Filters: &awstypes.ListApplicationsRequestFilters{
+			ApplicationIDs: []string{id},
+		},
+	}
+
+	return findApplication(ctx, conn, input)
+}
+
+func findApplication(ctx context.Context, conn *mgn.Client, input *mgn.ListApplicationsInput) (*awstypes.Application, error) {
+	output, err := findApplications(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findApplications(ctx context.Context, conn *mgn.Client, input *mgn.ListApplicationsInput) ([]awstypes.Application, error) {
+	var output []awstypes.Application
+
+	pages := mgn.NewListApplicationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+type applicationResourceModel struct {
+	ARN         types.String `tfsdk:"arn"`
+	Description types.String `tfsdk:"description"`
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Tags        tftags.Map   `tfsdk:"tags"`
+	TagsAll     tftags.Map   `tfsdk:"tags_all"`
+}
@@ -0,0 +1,384 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mgn_launch_configuration_template", name="Launch Configuration Template")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mgn/types;awstypes;awstypes.LaunchConfigurationTemplate")
+func newLaunchConfigurationTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &launchConfigurationTemplateResource{}, nil
+}
+
+type launchConfigurationTemplateResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*launchConfigurationTemplateResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_mgn_launch_configuration_template"
+}
+
+func (r *launchConfigurationTemplateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	diskConfigurationBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[launchTemplateDiskConfModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrIOPS: schema.Int64Attribute{
+					Optional: true,
+				},
+				names.AttrThroughput: schema.Int64Attribute{
+					Optional: true,
+				},
+				names.AttrVolumeType: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.VolumeType](),
+					Optional:   true,
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"associate_public_ip_address": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"boot_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.BootMode](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"copy_private_ip": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"copy_tags": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ec2_launch_template_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable_map_auto_tagging": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"launch_disposition": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.LaunchDisposition](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"map_auto_tagging_mpe_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"small_volume_max_size": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"target_instance_type_right_sizing_method": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TargetInstanceTypeRightSizingMethod](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"large_volume_conf": diskConfigurationBlock,
+			"licensing": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[licensingModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"os_byol": schema.BoolAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"small_volume_conf": diskConfigurationBlock,
+		},
+	}
+}
+
+func (r *launchConfigurationTemplateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data launchConfigurationTemplateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	var input mgn.CreateLaunchConfigurationTemplateInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, launchConfigurationTemplateFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateLaunchConfigurationTemplate(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating MGN Launch Configuration Template", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, launchConfigurationTemplateFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *launchConfigurationTemplateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data launchConfigurationTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	output, err := findLaunchConfigurationTemplateByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MGN Launch Configuration Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, launchConfigurationTemplateFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *launchConfigurationTemplateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new launchConfigurationTemplateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	diff, d := fwflex.Calculate(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input mgn.UpdateLaunchConfigurationTemplateInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input, append(diff.IgnoredFieldNamesOpts(), launchConfigurationTemplateFlexOpt)...)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.LaunchConfigurationTemplateID = fwflex.StringFromFramework(ctx, new.ID)
+
+		output, err := conn.UpdateLaunchConfigurationTemplate(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MGN Launch Configuration Template (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new, launchConfigurationTemplateFlexOpt)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *launchConfigurationTemplateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data launchConfigurationTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	_, err := conn.DeleteLaunchConfigurationTemplate(ctx, &mgn.DeleteLaunchConfigurationTemplateInput{
+		LaunchConfigurationTemplateID: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MGN Launch Configuration Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *launchConfigurationTemplateResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// The API prefixes the template's ID with the resource type name.
+var launchConfigurationTemplateFlexOpt = fwflex.WithFieldNamePrefix("LaunchConfigurationTemplate")
+
+func findLaunchConfigurationTemplateByID(ctx context.Context, conn *mgn.Client, id string) (*awstypes.LaunchConfigurationTemplate, error) {
+	input := &mgn.DescribeLaunchConfigurationTemplatesInput{
+		LaunchConfigurationTemplateIDs: []string{id},
+	}
+
+	return findLaunchConfigurationTemplate(ctx, conn, input)
+}
+
+func findLaunchConfigurationTemplate(ctx context.Context, conn *mgn.Client, input *mgn.DescribeLaunchConfigurationTemplatesInput) (*awstypes.LaunchConfigurationTemplate, error) {
+	output, err := findLaunchConfigurationTemplates(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findLaunchConfigurationTemplates(ctx context.Context, conn *mgn.Client, input *mgn.DescribeLaunchConfigurationTemplatesInput) ([]awstypes.LaunchConfigurationTemplate, error) {
+	var output []awstypes.LaunchConfigurationTemplate
+
+	pages := mgn.NewDescribeLaunchConfigurationTemplatesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+type launchConfigurationTemplateResourceModel struct {
+	ARN                                 types.String                                                     `tfsdk:"arn"`
+	AssociatePublicIPAddress            types.Bool                                                       `tfsdk:"associate_public_ip_address"`
+	BootMode                            fwtypes.StringEnum[awstypes.BootMode]                            `tfsdk:"boot_mode"`
+	CopyPrivateIP                       types.Bool                                                       `tfsdk:"copy_private_ip"`
+	CopyTags                            types.Bool                                                       `tfsdk:"copy_tags"`
+	EC2LaunchTemplateID                 types.String                                                     `tfsdk:"ec2_launch_template_id"`
+	EnableMapAutoTagging                types.Bool                                                       `tfsdk:"enable_map_auto_tagging"`
+	ID                                  types.String                                                     `tfsdk:"id"`
+	LargeVolumeConf                     fwtypes.ListNestedObjectValueOf[launchTemplateDiskConfModel]     `tfsdk:"large_volume_conf"`
+	LaunchDisposition                   fwtypes.StringEnum[awstypes.LaunchDisposition]                   `tfsdk:"launch_disposition"`
+	Licensing                           fwtypes.ListNestedObjectValueOf[licensingModel]                  `tfsdk:"licensing"`
+	MapAutoTaggingMpeID                 types.String                                                     `tfsdk:"map_auto_tagging_mpe_id"`
+	SmallVolumeConf                     fwtypes.ListNestedObjectValueOf[launchTemplateDiskConfModel]     `tfsdk:"small_volume_conf"`
+	SmallVolumeMaxSize                  types.Int64                                                      `tfsdk:"small_volume_max_size"`
+	Tags                                tftags.Map                                                       `tfsdk:"tags"`
+	TagsAll                             tftags.Map                                                       `tfsdk:"tags_all"`
+	TargetInstanceTypeRightSizingMethod fwtypes.StringEnum[awstypes.TargetInstanceTypeRightSizingMethod] `tfsdk:"target_instance_type_right_sizing_method"`
+}
+
+type launchTemplateDiskConfModel struct {
+	IOPS       types.Int64                             `tfsdk:"iops"`
+	Throughput types.Int64                             `tfsdk:"throughput"`
+	VolumeType fwtypes.StringEnum[awstypes.VolumeType] `tfsdk:"volume_type"`
+}
+
+type licensingModel struct {
+	OSByol types.Bool `tfsdk:"os_byol"`
+}
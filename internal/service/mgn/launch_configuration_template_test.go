@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmgn "github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMgnLaunchConfigurationTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_mgn_launch_configuration_template.test"
+	var v awstypes.LaunchConfigurationTemplate
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchConfigurationTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARNFormat(ctx, resourceName, names.AttrARN, "mgn", "launch-configuration-template/{id}"),
+					resource.TestCheckResourceAttrSet(resourceName, "boot_mode"),
+					resource.TestCheckResourceAttrSet(resourceName, "launch_disposition"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMgnLaunchConfigurationTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_mgn_launch_configuration_template.test"
+	var v awstypes.LaunchConfigurationTemplate
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchConfigurationTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmgn.ResourceLaunchConfigurationTemplate, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMgnLaunchConfigurationTemplate_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_mgn_launch_configuration_template.test"
+	var v awstypes.LaunchConfigurationTemplate
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchConfigurationTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_full("STOPPED", "NONE", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "copy_private_ip", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "copy_tags", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "launch_disposition", "STOPPED"),
+					resource.TestCheckResourceAttr(resourceName, "licensing.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "licensing.0.os_byol", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "small_volume_conf.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "small_volume_conf.0.volume_type", "gp3"),
+					resource.TestCheckResourceAttr(resourceName, "target_instance_type_right_sizing_method", "NONE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_full("STARTED", "BASIC", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "copy_private_ip", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "launch_disposition", "STARTED"),
+					resource.TestCheckResourceAttr(resourceName, "licensing.0.os_byol", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "target_instance_type_right_sizing_method", "BASIC"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLaunchConfigurationTemplateExists(ctx context.Context, n string, v *awstypes.LaunchConfigurationTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MgnClient(ctx)
+
+		output, err := tfmgn.FindLaunchConfigurationTemplateByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckLaunchConfigurationTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MgnClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mgn_launch_configuration_template" {
+				continue
+			}
+
+			_, err := tfmgn.FindLaunchConfigurationTemplateByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MGN Launch Configuration Template (%s) still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccLaunchConfigurationTemplateConfig_basic() string {
+	return `
+resource "aws_mgn_launch_configuration_template" "test" {}
+`
+}
+
+func testAccLaunchConfigurationTemplateConfig_full(launchDisposition, rightSizingMethod string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_mgn_launch_configuration_template" "test" {
+  copy_private_ip                          = %[3]t
+  copy_tags                                = true
+  launch_disposition                       = %[1]q
+  target_instance_type_right_sizing_method = %[2]q
+
+  licensing {
+    os_byol = %[3]t
+  }
+
+  small_volume_conf {
+    volume_type = "gp3"
+  }
+}
+`, launchDisposition, rightSizingMethod, enabled)
+}
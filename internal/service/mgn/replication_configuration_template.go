@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mgn_replication_configuration_template", name="Replication Configuration Template")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mgn/types;awstypes;awstypes.ReplicationConfigurationTemplate")
+func newReplicationConfigurationTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &replicationConfigurationTemplateResource{}, nil
+}
+
+type replicationConfigurationTemplateResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*replicationConfigurationTemplateResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_mgn_replication_configuration_template"
+}
+
+func (r *replicationConfigurationTemplateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"associate_default_security_group": schema.BoolAttribute{
+				Required: true,
+			},
+			"bandwidth_throttling": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"create_public_ip": schema.BoolAttribute{
+				Required: true,
+			},
+			"data_plane_routing": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ReplicationConfigurationDataPlaneRouting](),
+				Required:   true,
+			},
+			"default_large_staging_disk_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ReplicationConfigurationDefaultLargeStagingDiskType](),
+				Required:   true,
+			},
+			"ebs_encryption": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ReplicationConfigurationEbsEncryption](),
+				Required:   true,
+			},
+			"ebs_encryption_key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"replication_server_instance_type": schema.StringAttribute{
+				Required: true,
+			},
+			"replication_servers_security_groups_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"staging_area_subnet_id": schema.StringAttribute{
+				Required: true,
+			},
+			"staging_area_tags": tftags.TagsAttributeRequired(),
+			names.AttrTags:      tftags.TagsAttribute(),
+			names.AttrTagsAll:   tftags.TagsAttributeComputedOnly(),
+			"use_dedicated_replication_server": schema.BoolAttribute{
+				Required: true,
+			},
+			"use_fips_endpoint": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *replicationConfigurationTemplateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data replicationConfigurationTemplateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	var input mgn.CreateReplicationConfigurationTemplateInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, replicationConfigurationTemplateFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateReplicationConfigurationTemplate(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating MGN Replication Configuration Template", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, replicationConfigurationTemplateFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *replicationConfigurationTemplateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data replicationConfigurationTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	output, err := findReplicationConfigurationTemplateByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MGN Replication Configuration Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, replicationConfigurationTemplateFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *replicationConfigurationTemplateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new replicationConfigurationTemplateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	diff, d := fwflex.Calculate(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input mgn.UpdateReplicationConfigurationTemplateInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input, append(diff.IgnoredFieldNamesOpts(), replicationConfigurationTemplateFlexOpt)...)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.ReplicationConfigurationTemplateID = fwflex.StringFromFramework(ctx, new.ID)
+
+		output, err := conn.UpdateReplicationConfigurationTemplate(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MGN Replication Configuration Template (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new, replicationConfigurationTemplateFlexOpt)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *replicationConfigurationTemplateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data replicationConfigurationTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	_, err := conn.DeleteReplicationConfigurationTemplate(ctx, &mgn.DeleteReplicationConfigurationTemplateInput{
+		ReplicationConfigurationTemplateID: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MGN Replication Configuration Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *replicationConfigurationTemplateResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// The API prefixes the template's ID with the resource type name.
+var replicationConfigurationTemplateFlexOpt = fwflex.WithFieldNamePrefix("ReplicationConfigurationTemplate")
+
+func findReplicationConfigurationTemplateByID(ctx context.Context, conn *mgn.Client, id string) (*awstypes.ReplicationConfigurationTemplate, error) {
+	input := &mgn.DescribeReplicationConfigurationTemplatesInput{
+		ReplicationConfigurationTemplateIDs: []string{id},
+	}
+
+	return findReplicationConfigurationTemplate(ctx, conn, input)
+}
+
+func findReplicationConfigurationTemplate(ctx context.Context, conn *mgn.Client, input *mgn.DescribeReplicationConfigurationTemplatesInput) (*awstypes.ReplicationConfigurationTemplate, error) {
+	output, err := findReplicationConfigurationTemplates(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findReplicationConfigurationTemplates(ctx context.Context, conn *mgn.Client, input *mgn.DescribeReplicationConfigurationTemplatesInput) ([]awstypes.ReplicationConfigurationTemplate, error) {
+	var output []awstypes.ReplicationConfigurationTemplate
+
+	pages := mgn.NewDescribeReplicationConfigurationTemplatesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+type replicationConfigurationTemplateResourceModel struct {
+	ARN                                 types.String                                                                     `tfsdk:"arn"`
+	AssociateDefaultSecurityGroup       types.Bool                                                                       `tfsdk:"associate_default_security_group"`
+	BandwidthThrottling                 types.Int64                                                                      `tfsdk:"bandwidth_throttling"`
+	CreatePublicIP                      types.Bool                                                                       `tfsdk:"create_public_ip"`
+	DataPlaneRouting                    fwtypes.StringEnum[awstypes.ReplicationConfigurationDataPlaneRouting]            `tfsdk:"data_plane_routing"`
+	DefaultLargeStagingDiskType         fwtypes.StringEnum[awstypes.ReplicationConfigurationDefaultLargeStagingDiskType] `tfsdk:"default_large_staging_disk_type"`
+	EBSEncryption                       fwtypes.StringEnum[awstypes.ReplicationConfigurationEbsEncryption]               `tfsdk:"ebs_encryption"`
+	EBSEncryptionKeyARN                 fwtypes.ARN                                                                      `tfsdk:"ebs_encryption_key_arn"`
+	ID                                  types.String                                                                     `tfsdk:"id"`
+	ReplicationServerInstanceType       types.String                                                                     `tfsdk:"replication_server_instance_type"`
+	ReplicationServersSecurityGroupsIDs fwtypes.ListOfString                                                             `tfsdk:"replication_servers_security_groups_ids"`
+	StagingAreaSubnetID                 types.String                                                                     `tfsdk:"staging_area_subnet_id"`
+	StagingAreaTags                     tftags.Map                                                                       `tfsdk:"staging_area_tags"`
+	Tags                                tftags.Map                                                                       `tfsdk:"tags"`
+	TagsAll                             tftags.Map                                                                       `tfsdk:"tags_all"`
+	UseDedicatedReplicationServer       types.Bool                                                                       `tfsdk:"use_dedicated_replication_server"`
+	UseFIPSEndpoint                     types.Bool                                                                       `tfsdk:"use_fips_endpoint"`
+}
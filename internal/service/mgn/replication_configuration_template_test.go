@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmgn "github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// TestAccMgnReplicationConfigurationTemplate_serial serializes the tests
+// since the staging area resources are shared at the account level.
+func TestAccMgnReplicationConfigurationTemplate_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		acctest.CtBasic:      testAccReplicationConfigurationTemplate_basic,
+		acctest.CtDisappears: testAccReplicationConfigurationTemplate_disappears,
+		"update":             testAccReplicationConfigurationTemplate_update,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 5*time.Second)
+}
+
+func testAccReplicationConfigurationTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mgn_replication_configuration_template.test"
+	var v awstypes.ReplicationConfigurationTemplate
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReplicationConfigurationTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationConfigurationTemplateConfig_basic(rName, 12),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARNFormat(ctx, resourceName, names.AttrARN, "mgn", "replication-configuration-template/{id}"),
+					resource.TestCheckResourceAttr(resourceName, "associate_default_security_group", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_throttling", "12"),
+					resource.TestCheckResourceAttr(resourceName, "create_public_ip", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "data_plane_routing", "PRIVATE_IP"),
+					resource.TestCheckResourceAttr(resourceName, "default_large_staging_disk_type", "GP3"),
+					resource.TestCheckResourceAttr(resourceName, "ebs_encryption", "DEFAULT"),
+					resource.TestCheckResourceAttr(resourceName, "replication_server_instance_type", "t3.small"),
+					resource.TestCheckResourceAttr(resourceName, "replication_servers_security_groups_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "staging_area_subnet_id", "aws_subnet.test.0", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "staging_area_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "staging_area_tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttr(resourceName, "use_dedicated_replication_server", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccReplicationConfigurationTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mgn_replication_configuration_template.test"
+	var v awstypes.ReplicationConfigurationTemplate
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReplicationConfigurationTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationConfigurationTemplateConfig_basic(rName, 12),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmgn.ResourceReplicationConfigurationTemplate, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccReplicationConfigurationTemplate_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mgn_replication_configuration_template.test"
+	var v awstypes.ReplicationConfigurationTemplate
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReplicationConfigurationTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationConfigurationTemplateConfig_basic(rName, 12),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_throttling", "12"),
+				),
+			},
+			{
+				Config: testAccReplicationConfigurationTemplateConfig_basic(rName, 24),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_throttling", "24"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckReplicationConfigurationTemplateExists(ctx context.Context, n string, v *awstypes.ReplicationConfigurationTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MgnClient(ctx)
+
+		output, err := tfmgn.FindReplicationConfigurationTemplateByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckReplicationConfigurationTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MgnClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mgn_replication_configuration_template" {
+				continue
+			}
+
+			_, err := tfmgn.FindReplicationConfigurationTemplateByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MGN Replication Configuration Template (%s) still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccReplicationConfigurationTemplateConfig_basic(rName string, bandwidthThrottling int) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_mgn_replication_configuration_template" "test" {
+  associate_default_security_group        = false
+  bandwidth_throttling                    = %[2]d
+  create_public_ip                        = false
+  data_plane_routing                      = "PRIVATE_IP"
+  default_large_staging_disk_type         = "GP3"
+  ebs_encryption                          = "DEFAULT"
+  replication_server_instance_type        = "t3.small"
+  replication_servers_security_groups_ids = [aws_security_group.test.id]
+  staging_area_subnet_id                  = aws_subnet.test[0].id
+  use_dedicated_replication_server        = false
+
+  staging_area_tags = {
+    Name = %[1]q
+  }
+}
+`, rName, bandwidthThrottling))
+}
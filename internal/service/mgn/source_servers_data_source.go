@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// @FrameworkDataSource("aws_mgn_source_servers", name="Source Servers")
+func newSourceServersDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &sourceServersDataSource{}, nil
+}
+
+type sourceServersDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*sourceServersDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_mgn_source_servers"
+}
+
+func (d *sourceServersDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"is_archived": schema.BoolAttribute{
+				Optional: true,
+			},
+			"lifecycle_states": schema.SetAttribute{
+				CustomType:  fwtypes.NewSetTypeOf[fwtypes.StringEnum[awstypes.LifeCycleState]](ctx),
+				ElementType: fwtypes.StringEnumType[awstypes.LifeCycleState](),
+				Optional:    true,
+			},
+			"replication_types": schema.SetAttribute{
+				CustomType:  fwtypes.NewSetTypeOf[fwtypes.StringEnum[awstypes.ReplicationType]](ctx),
+				ElementType: fwtypes.StringEnumType[awstypes.ReplicationType](),
+				Optional:    true,
+			},
+			"source_server_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"source_servers": framework.DataSourceComputedListOfObjectAttribute[sourceServerModel](ctx),
+		},
+	}
+}
+
+func (d *sourceServersDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data sourceServersDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().MgnClient(ctx)
+
+	var filters awstypes.DescribeSourceServersRequestFilters
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &filters)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := mgn.DescribeSourceServersInput{
+		Filters: &filters,
+	}
+
+	output, err := findSourceServers(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading MGN Source Servers", err.Error())
+
+		return
+	}
+
+	sourceServers := make([]sourceServerModel, 0, len(output))
+	for _, v := range output {
+		var sourceServer sourceServerModel
+		response.Diagnostics.Append(fwflex.Flatten(ctx, v, &sourceServer)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Nested fields.
+		if v := v.LifeCycle; v != nil {
+			sourceServer.LifeCycleState = fwtypes.StringEnumValue(v.State)
+		}
+		if v := v.SourceProperties; v != nil && v.IdentificationHints != nil {
+			sourceServer.Hostname = fwflex.StringToFramework(ctx, v.IdentificationHints.Hostname)
+		}
+
+		sourceServers = append(sourceServers, sourceServer)
+	}
+
+	data.SourceServers = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, sourceServers)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findSourceServers(ctx context.Context, conn *mgn.Client, input *mgn.DescribeSourceServersInput) ([]awstypes.SourceServer, error) {
+	var output []awstypes.SourceServer
+
+	pages := mgn.NewDescribeSourceServersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+type sourceServersDataSourceModel struct {
+	ApplicationIDs   fwtypes.SetOfString                                              `tfsdk:"application_ids"`
+	IsArchived       types.Bool                                                       `tfsdk:"is_archived"`
+	LifeCycleStates  fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.LifeCycleState]]  `tfsdk:"lifecycle_states"`
+	ReplicationTypes fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.ReplicationType]] `tfsdk:"replication_types"`
+	SourceServerIDs  fwtypes.SetOfString                                              `tfsdk:"source_server_ids"`
+	SourceServers    fwtypes.ListNestedObjectValueOf[sourceServerModel]               `tfsdk:"source_servers" autoflex:"-"`
+}
+
+type sourceServerModel struct {
+	ApplicationID   types.String                                 `tfsdk:"application_id"`
+	ARN             types.String                                 `tfsdk:"arn"`
+	Hostname        types.String                                 `tfsdk:"hostname" autoflex:"-"`
+	IsArchived      types.Bool                                   `tfsdk:"is_archived"`
+	LifeCycleState  fwtypes.StringEnum[awstypes.LifeCycleState]  `tfsdk:"lifecycle_state" autoflex:"-"`
+	ReplicationType fwtypes.StringEnum[awstypes.ReplicationType] `tfsdk:"replication_type"`
+	SourceServerID  types.String                                 `tfsdk:"source_server_id"`
+}
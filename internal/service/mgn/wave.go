@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mgn_wave", name="Wave")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mgn/types;awstypes;awstypes.Wave")
+func newWaveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &waveResource{}, nil
+}
+
+type waveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*waveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_mgn_wave"
+}
+
+func (r *waveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(600),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *waveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data waveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	name := data.Name.ValueString()
+	var input mgn.CreateWaveInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, waveFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateWave(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MGN Wave (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, waveFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *waveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data waveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	output, err := findWaveByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MGN Wave (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, waveFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *waveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new waveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	if !new.Description.Equal(old.Description) || !new.Name.Equal(old.Name) {
+		input := mgn.UpdateWaveInput{
+			WaveID:      fwflex.StringFromFramework(ctx, new.ID),
+			Description: aws.String(new.Description.ValueString()),
+			Name:        fwflex.StringFromFramework(ctx, new.Name),
+		}
+
+		_, err := conn.UpdateWave(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MGN Wave (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *waveResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data waveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	// Waves must be archived before they can be deleted.
+	output, err := findWaveByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MGN Wave (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if !aws.ToBool(output.IsArchived) {
+		_, err := conn.ArchiveWave(ctx, &mgn.ArchiveWaveInput{
+			WaveID: fwflex.StringFromFramework(ctx, data.ID),
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("archiving MGN Wave (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	_, err = conn.DeleteWave(ctx, &mgn.DeleteWaveInput{
+		WaveID: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MGN Wave (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *waveResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// The API prefixes the wave's ID with the resource type name.
+var waveFlexOpt = fwflex.WithFieldNamePrefix("Wave")
+
+func findWaveByID(ctx context.Context, conn *mgn.Client, id string) (*awstypes.Wave, error) {
+	input := &mgn.ListWavesInput{
+		Filters: &awstypes.ListWavesRequestFilters{
+			WaveIDs: []string{id},
+		},
+	}
+
+	return findWave(ctx, conn, input)
+}
+
+func findWave(ctx context.Context, conn *mgn.Client, input *mgn.ListWavesInput) (*awstypes.Wave, error) {
+	output, err := findWaves(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findWaves(ctx context.Context, conn *mgn.Client, input *mgn.ListWavesInput) ([]awstypes.Wave, error) {
+	var output []awstypes.Wave
+
+	pages := mgn.NewListWavesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+type waveResourceModel struct {
+	ARN         types.String `tfsdk:"arn"`
+	Description types.String `tfsdk:"description"`
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Tags        tftags.Map   `tfsdk:"tags"`
+	TagsAll     tftags.Map   `tfsdk:"tags_all"`
+}
@@ -0,0 +1,316 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package neptunegraph_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/neptunegraph"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfneptunegraph "github.com/hashicorp/terraform-provider-aws/internal/service/neptunegraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNeptuneGraphGraph_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var graph neptunegraph.GetGraphOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_neptunegraph_graph.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.NeptuneGraph)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.NeptuneGraphServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGraphDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGraphConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGraphExists(ctx, resourceName, &graph),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "neptune-graph", regexache.MustCompile(`graph/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDeletionProtection, acctest.CtFalse),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrEndpoint),
+					resource.TestCheckResourceAttr(resourceName, "graph_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "kms_key_identifier"),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_memory", "16"),
+					resource.TestCheckResourceAttr(resourceName, "public_connectivity", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "replica_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttr(resourceName, "vector_search_configuration.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNeptuneGraphGraph_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var graph neptunegraph.GetGraphOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_neptunegraph_graph.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.NeptuneGraph)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.NeptuneGraphServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGraphDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGraphConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphExists(ctx, resourceName, &graph),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfneptunegraph.ResourceGraph, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccNeptuneGraphGraph_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var graph neptunegraph.GetGraphOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_neptunegraph_graph.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.NeptuneGraph)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.NeptuneGraphServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGraphDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGraphConfig_update(rName, 16, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGraphExists(ctx, resourceName, &graph),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_memory", "16"),
+					resource.TestCheckResourceAttr(resourceName, "public_connectivity", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "vector_search_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vector_search_configuration.0.vector_search_dimension", "128"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGraphConfig_update(rName, 32, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGraphExists(ctx, resourceName, &graph),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_memory", "32"),
+					resource.TestCheckResourceAttr(resourceName, "public_connectivity", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "vector_search_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vector_search_configuration.0.vector_search_dimension", "128"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNeptuneGraphGraph_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var graph neptunegraph.GetGraphOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_neptunegraph_graph.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.NeptuneGraph)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.NeptuneGraphServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGraphDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGraphConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGraphExists(ctx, resourceName, &graph),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGraphConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGraphExists(ctx, resourceName, &graph),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccGraphConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGraphExists(ctx, resourceName, &graph),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGraphDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneGraphClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_neptunegraph_graph" {
+				continue
+			}
+
+			_, err := tfneptunegraph.FindGraphByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Neptune Analytics Graph %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGraphExists(ctx context.Context, n string, v *neptunegraph.GetGraphOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneGraphClient(ctx)
+
+		output, err := tfneptunegraph.FindGraphByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneGraphClient(ctx)
+
+	input := &neptunegraph.ListGraphsInput{}
+	_, err := conn.ListGraphs(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccGraphConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_neptunegraph_graph" "test" {
+  graph_name          = %[1]q
+  deletion_protection = false
+  provisioned_memory  = 16
+  replica_count       = 0
+}
+`, rName)
+}
+
+func testAccGraphConfig_update(rName string, provisionedMemory int, publicConnectivity bool) string {
+	return fmt.Sprintf(`
+resource "aws_neptunegraph_graph" "test" {
+  graph_name          = %[1]q
+  deletion_protection = false
+  provisioned_memory  = %[2]d
+  public_connectivity = %[3]t
+  replica_count       = 0
+
+  vector_search_configuration {
+    vector_search_dimension = 128
+  }
+}
+`, rName, provisionedMemory, publicConnectivity)
+}
+
+func testAccGraphConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_neptunegraph_graph" "test" {
+  graph_name          = %[1]q
+  deletion_protection = false
+  provisioned_memory  = 16
+  replica_count       = 0
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccGraphConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_neptunegraph_graph" "test" {
+  graph_name          = %[1]q
+  deletion_protection = false
+  provisioned_memory  = 16
+  replica_count       = 0
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
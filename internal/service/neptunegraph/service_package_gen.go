@@ -19,7 +19,29 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory:  newGraphResource,
+			TypeName: "aws_neptunegraph_graph",
+			Name:     "Graph",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newGraphSnapshotResource,
+			TypeName: "aws_neptunegraph_graph_snapshot",
+			Name:     "Graph Snapshot",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newPrivateGraphEndpointResource,
+			TypeName: "aws_neptunegraph_private_graph_endpoint",
+			Name:     "Private Graph Endpoint",
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package neptunegraph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/neptunegraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_neptunegraph_graph", sweepGraphs, "aws_neptunegraph_private_graph_endpoint")
+	awsv2.Register("aws_neptunegraph_graph_snapshot", sweepGraphSnapshots)
+	awsv2.Register("aws_neptunegraph_private_graph_endpoint", sweepPrivateGraphEndpoints)
+}
+
+func sweepGraphs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.NeptuneGraphClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := neptunegraph.NewListGraphsPaginator(conn, &neptunegraph.ListGraphsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, graph := range page.Graphs {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newGraphResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(graph.Id))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepGraphSnapshots(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.NeptuneGraphClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := neptunegraph.NewListGraphSnapshotsPaginator(conn, &neptunegraph.ListGraphSnapshotsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, snapshot := range page.GraphSnapshots {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newGraphSnapshotResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(snapshot.Id))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepPrivateGraphEndpoints(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.NeptuneGraphClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := neptunegraph.NewListGraphsPaginator(conn, &neptunegraph.ListGraphsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, graph := range page.Graphs {
+			graphID := aws.ToString(graph.Id)
+			input := neptunegraph.ListPrivateGraphEndpointsInput{
+				GraphIdentifier: aws.String(graphID),
+			}
+			pages := neptunegraph.NewListPrivateGraphEndpointsPaginator(conn, &input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("listing Neptune Analytics Private Graph Endpoints (%s): %w", graphID, err)
+				}
+
+				for _, endpoint := range page.PrivateGraphEndpoints {
+					vpcID := aws.ToString(endpoint.VpcId)
+					sweepResources = append(sweepResources, framework.NewSweepResource(newPrivateGraphEndpointResource, client,
+						framework.NewAttribute("graph_identifier", graphID),
+						framework.NewAttribute(names.AttrVPCID, vpcID),
+						framework.NewAttribute(names.AttrID, fmt.Sprintf("%s,%s", graphID, vpcID))))
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_pcs_cluster", name="Cluster")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newClusterDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &clusterDataSource{}, nil
+}
+
+type clusterDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*clusterDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_pcs_cluster"
+}
+
+func (d *clusterDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrEndpoints: framework.DataSourceComputedListOfObjectAttribute[endpointModel](ctx),
+			names.AttrID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"networking":          framework.DataSourceComputedListOfObjectAttribute[networkingModel](ctx),
+			"scheduler":           framework.DataSourceComputedListOfObjectAttribute[schedulerModel](ctx),
+			"slurm_configuration": framework.DataSourceComputedListOfObjectAttribute[clusterSlurmConfigurationModel](ctx),
+			names.AttrSize: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Size](),
+				Computed:   true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ClusterStatus](),
+				Computed:   true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *clusterDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot(names.AttrID),
+			path.MatchRoot(names.AttrName),
+		),
+	}
+}
+
+func (d *clusterDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data clusterDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().PCSClient(ctx)
+
+	// The cluster identifier can be either the cluster's name or its ID.
+	id := data.ID.ValueString()
+	if data.ID.IsNull() {
+		id = data.Name.ValueString()
+	}
+
+	output, err := findClusterByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCS Cluster (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type clusterDataSourceModel struct {
+	ARN                types.String                                                    `tfsdk:"arn"`
+	Endpoints          fwtypes.ListNestedObjectValueOf[endpointModel]                  `tfsdk:"endpoints"`
+	ID                 types.String                                                    `tfsdk:"id"`
+	Name               types.String                                                    `tfsdk:"name"`
+	Networking         fwtypes.ListNestedObjectValueOf[networkingModel]                `tfsdk:"networking"`
+	Scheduler          fwtypes.ListNestedObjectValueOf[schedulerModel]                 `tfsdk:"scheduler"`
+	Size               fwtypes.StringEnum[awstypes.Size]                               `tfsdk:"size"`
+	SlurmConfiguration fwtypes.ListNestedObjectValueOf[clusterSlurmConfigurationModel] `tfsdk:"slurm_configuration"`
+	Status             fwtypes.StringEnum[awstypes.ClusterStatus]                      `tfsdk:"status"`
+	Tags               tftags.Map                                                      `tfsdk:"tags"`
+}
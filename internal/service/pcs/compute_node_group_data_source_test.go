@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPCSComputeNodeGroupDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	rName := fmt.Sprintf("%s-%s", acctest.ResourcePrefix, sdkacctest.RandString(10))
+	dataSourceName := "data.aws_pcs_compute_node_group.test"
+	resourceName := "aws_pcs_compute_node_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PCS)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComputeNodeGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeNodeGroupDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "ami_id", resourceName, "ami_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster_id", resourceName, "cluster_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "compute_node_group_id", resourceName, "compute_node_group_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "custom_launch_template.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "iam_instance_profile_arn", resourceName, "iam_instance_profile_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_config.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_config.0.instance_type", resourceName, "instance_config.0.instance_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, resourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "purchase_option", resourceName, "purchase_option"),
+					resource.TestCheckResourceAttr(dataSourceName, "scaling_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "scaling_configuration.0.max_instance_count", resourceName, "scaling_configuration.0.max_instance_count"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "scaling_configuration.0.min_instance_count", resourceName, "scaling_configuration.0.min_instance_count"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subnet_ids.#", resourceName, "subnet_ids.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
+				),
+			},
+		},
+	})
+}
+
+func testAccComputeNodeGroupDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccComputeNodeGroupConfig_basic(rName, 0, 1), `
+data "aws_pcs_compute_node_group" "test" {
+  cluster_id = aws_pcs_compute_node_group.test.cluster_id
+  name       = aws_pcs_compute_node_group.test.name
+}
+`)
+}
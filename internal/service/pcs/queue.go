@@ -0,0 +1,439 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pcs_queue", name="Queue")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/pcs/types;awstypes;awstypes.Queue")
+func newQueueResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &queueResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	queueResourceIDPartCount = 2
+)
+
+type queueResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*queueResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_pcs_queue"
+}
+
+func (r *queueResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"cluster_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 25),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"queue_id": framework.IDAttribute(),
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.QueueStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"compute_node_group_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[computeNodeGroupConfigurationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"compute_node_group_id": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *queueResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data queueResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	name := data.Name.ValueString()
+	var input pcs.CreateQueueInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, fwflex.WithFieldNamePrefix("Queue"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.ClusterIdentifier = fwflex.StringFromFramework(ctx, data.ClusterID)
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateQueue(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating PCS Queue (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.QueueID = fwflex.StringToFramework(ctx, output.Queue.Id)
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError("creating resource ID", err.Error())
+
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	queue, err := waitQueueCreated(ctx, conn, data.ClusterID.ValueString(), data.QueueID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Queue (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, queue, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *queueResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data queueResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	output, err := findQueueByTwoPartKey(ctx, conn, data.ClusterID.ValueString(), data.QueueID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCS Queue (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *queueResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new queueResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	if !new.ComputeNodeGroupConfigurations.Equal(old.ComputeNodeGroupConfigurations) {
+		input := pcs.UpdateQueueInput{
+			ClientToken:       aws.String(sdkid.UniqueId()),
+			ClusterIdentifier: fwflex.StringFromFramework(ctx, new.ClusterID),
+			QueueIdentifier:   fwflex.StringFromFramework(ctx, new.QueueID),
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new.ComputeNodeGroupConfigurations, &input.ComputeNodeGroupConfigurations)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// An empty list removes all compute node groups from the queue.
+		if input.ComputeNodeGroupConfigurations == nil {
+			input.ComputeNodeGroupConfigurations = []awstypes.ComputeNodeGroupConfiguration{}
+		}
+
+		_, err := conn.UpdateQueue(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating PCS Queue (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		queue, err := waitQueueUpdated(ctx, conn, new.ClusterID.ValueString(), new.QueueID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Queue (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, queue, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *queueResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data queueResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	_, err := conn.DeleteQueue(ctx, &pcs.DeleteQueueInput{
+		ClientToken:       aws.String(sdkid.UniqueId()),
+		ClusterIdentifier: fwflex.StringFromFramework(ctx, data.ClusterID),
+		QueueIdentifier:   fwflex.StringFromFramework(ctx, data.QueueID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting PCS Queue (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitQueueDeleted(ctx, conn, data.ClusterID.ValueString(), data.QueueID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Queue (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *queueResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findQueueByTwoPartKey(ctx context.Context, conn *pcs.Client, clusterID, queueID string) (*awstypes.Queue, error) {
+	input := &pcs.GetQueueInput{
+		ClusterIdentifier: aws.String(clusterID),
+		QueueIdentifier:   aws.String(queueID),
+	}
+
+	output, err := conn.GetQueue(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Queue == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Queue, nil
+}
+
+func statusQueue(ctx context.Context, conn *pcs.Client, clusterID, queueID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findQueueByTwoPartKey(ctx, conn, clusterID, queueID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitQueueCreated(ctx context.Context, conn *pcs.Client, clusterID, queueID string, timeout time.Duration) (*awstypes.Queue, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.QueueStatusCreating),
+		Target:  enum.Slice(awstypes.QueueStatusActive),
+		Refresh: statusQueue(ctx, conn, clusterID, queueID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Queue); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.ErrorInfo, errorInfoError)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitQueueUpdated(ctx context.Context, conn *pcs.Client, clusterID, queueID string, timeout time.Duration) (*awstypes.Queue, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.QueueStatusUpdating),
+		Target:  enum.Slice(awstypes.QueueStatusActive),
+		Refresh: statusQueue(ctx, conn, clusterID, queueID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Queue); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.ErrorInfo, errorInfoError)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitQueueDeleted(ctx context.Context, conn *pcs.Client, clusterID, queueID string, timeout time.Duration) (*awstypes.Queue, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.QueueStatusDeleting),
+		Target:  []string{},
+		Refresh: statusQueue(ctx, conn, clusterID, queueID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Queue); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.ErrorInfo, errorInfoError)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type queueResourceModel struct {
+	ARN                            types.String                                                        `tfsdk:"arn"`
+	ClusterID                      types.String                                                        `tfsdk:"cluster_id"`
+	ComputeNodeGroupConfigurations fwtypes.ListNestedObjectValueOf[computeNodeGroupConfigurationModel] `tfsdk:"compute_node_group_configuration"`
+	ID                             types.String                                                        `tfsdk:"id" autoflex:"-"`
+	Name                           types.String                                                        `tfsdk:"name"`
+	QueueID                        types.String                                                        `tfsdk:"queue_id" autoflex:"Id"`
+	Status                         fwtypes.StringEnum[awstypes.QueueStatus]                            `tfsdk:"status"`
+	Tags                           tftags.Map                                                          `tfsdk:"tags"`
+	TagsAll                        tftags.Map                                                          `tfsdk:"tags_all"`
+	Timeouts                       timeouts.Value                                                      `tfsdk:"timeouts"`
+}
+
+func (data *queueResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, queueResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.ClusterID = types.StringValue(parts[0])
+	data.QueueID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *queueResourceModel) setID() (string, error) {
+	parts := []string{
+		data.ClusterID.ValueString(),
+		data.QueueID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, queueResourceIDPartCount, false)
+}
+
+type computeNodeGroupConfigurationModel struct {
+	ComputeNodeGroupID types.String `tfsdk:"compute_node_group_id"`
+}
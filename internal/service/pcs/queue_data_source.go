@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_pcs_queue", name="Queue")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newQueueDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &queueDataSource{}, nil
+}
+
+type queueDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*queueDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_pcs_queue"
+}
+
+func (d *queueDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			"cluster_id": schema.StringAttribute{
+				Required: true,
+			},
+			"compute_node_group_configuration": framework.DataSourceComputedListOfObjectAttribute[computeNodeGroupConfigurationModel](ctx),
+			names.AttrName: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"queue_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.QueueStatus](),
+				Computed:   true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *queueDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot(names.AttrName),
+			path.MatchRoot("queue_id"),
+		),
+	}
+}
+
+func (d *queueDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data queueDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().PCSClient(ctx)
+
+	// The queue identifier can be either the queue's name or its ID.
+	id := data.QueueID.ValueString()
+	if data.QueueID.IsNull() {
+		id = data.Name.ValueString()
+	}
+
+	output, err := findQueueByTwoPartKey(ctx, conn, data.ClusterID.ValueString(), id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCS Queue (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type queueDataSourceModel struct {
+	ARN                            types.String                                                        `tfsdk:"arn"`
+	ClusterID                      types.String                                                        `tfsdk:"cluster_id"`
+	ComputeNodeGroupConfigurations fwtypes.ListNestedObjectValueOf[computeNodeGroupConfigurationModel] `tfsdk:"compute_node_group_configuration"`
+	Name                           types.String                                                        `tfsdk:"name"`
+	QueueID                        types.String                                                        `tfsdk:"queue_id" autoflex:"Id"`
+	Status                         fwtypes.StringEnum[awstypes.QueueStatus]                            `tfsdk:"status"`
+	Tags                           tftags.Map                                                          `tfsdk:"tags"`
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpcs "github.com/hashicorp/terraform-provider-aws/internal/service/pcs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPCSQueue_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var queue awstypes.Queue
+	rName := fmt.Sprintf("%s-%s", acctest.ResourcePrefix, sdkacctest.RandString(10))
+	resourceName := "aws_pcs_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PCS)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &queue),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "pcs", regexache.MustCompile(`cluster/.+/queue/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_id", "aws_pcs_cluster.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "compute_node_group_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "queue_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQueueConfig_computeNodeGroup(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &queue),
+					resource.TestCheckResourceAttr(resourceName, "compute_node_group_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "compute_node_group_configuration.0.compute_node_group_id", "aws_pcs_compute_node_group.test", "compute_node_group_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPCSQueue_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var queue awstypes.Queue
+	rName := fmt.Sprintf("%s-%s", acctest.ResourcePrefix, sdkacctest.RandString(10))
+	resourceName := "aws_pcs_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PCS)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &queue),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfpcs.ResourceQueue, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckQueueDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pcs_queue" {
+				continue
+			}
+
+			_, err := tfpcs.FindQueueByTwoPartKey(ctx, conn, rs.Primary.Attributes["cluster_id"], rs.Primary.Attributes["queue_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("PCS Queue %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckQueueExists(ctx context.Context, n string, v *awstypes.Queue) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCSClient(ctx)
+
+		output, err := tfpcs.FindQueueByTwoPartKey(ctx, conn, rs.Primary.Attributes["cluster_id"], rs.Primary.Attributes["queue_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccQueueConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), fmt.Sprintf(`
+resource "aws_pcs_queue" "test" {
+  cluster_id = aws_pcs_cluster.test.id
+  name       = %[1]q
+}
+`, rName))
+}
+
+func testAccQueueConfig_computeNodeGroup(rName string) string {
+	return acctest.ConfigCompose(testAccComputeNodeGroupConfig_basic(rName, 0, 1), fmt.Sprintf(`
+resource "aws_pcs_queue" "test" {
+  cluster_id = aws_pcs_cluster.test.id
+  name       = %[1]q
+
+  compute_node_group_configuration {
+    compute_node_group_id = aws_pcs_compute_node_group.test.compute_node_group_id
+  }
+}
+`, rName))
+}
@@ -0,0 +1,484 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness/document"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_qbusiness_data_source", name="Data Source")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/qbusiness;qbusiness;qbusiness.GetDataSourceOutput")
+func newDataSourceResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &dataSourceResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	dataSourceResourceIDPartCount = 3
+)
+
+type dataSourceResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*dataSourceResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_qbusiness_data_source"
+}
+
+func (r *dataSourceResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrConfiguration: schema.StringAttribute{
+				CustomType: fwtypes.NewSmithyJSONType(ctx, document.NewLazyDocument),
+				Required:   true,
+			},
+			"data_source_id": framework.IDAttribute(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 1000),
+				},
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1000),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"index_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DataSourceStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sync_schedule": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(998),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrVPCConfiguration: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataSourceVPCConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrSecurityGroupIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+						names.AttrSubnetIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *dataSourceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data dataSourceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	name := data.DisplayName.ValueString()
+	var input qbusiness.CreateDataSourceInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateDataSource(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Amazon Q Business Data Source (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.DataSourceID = fwflex.StringToFramework(ctx, output.DataSourceId)
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError("creating resource ID", err.Error())
+
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	dataSource, err := waitDataSourceCreated(ctx, conn, data.ApplicationID.ValueString(), data.IndexID.ValueString(), data.DataSourceID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Data Source (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, dataSource, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *dataSourceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data dataSourceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	output, err := findDataSourceByThreePartKey(ctx, conn, data.ApplicationID.ValueString(), data.IndexID.ValueString(), data.DataSourceID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Amazon Q Business Data Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *dataSourceResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new dataSourceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	diff, d := fwflex.Calculate(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input qbusiness.UpdateDataSourceInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input, diff.IgnoredFieldNamesOpts()...)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateDataSource(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Amazon Q Business Data Source (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		dataSource, err := waitDataSourceUpdated(ctx, conn, new.ApplicationID.ValueString(), new.IndexID.ValueString(), new.DataSourceID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Data Source (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, dataSource, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *dataSourceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data dataSourceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	_, err := conn.DeleteDataSource(ctx, &qbusiness.DeleteDataSourceInput{
+		ApplicationId: fwflex.StringFromFramework(ctx, data.ApplicationID),
+		DataSourceId:  fwflex.StringFromFramework(ctx, data.DataSourceID),
+		IndexId:       fwflex.StringFromFramework(ctx, data.IndexID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Amazon Q Business Data Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitDataSourceDeleted(ctx, conn, data.ApplicationID.ValueString(), data.IndexID.ValueString(), data.DataSourceID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Data Source (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *dataSourceResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findDataSourceByThreePartKey(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID string) (*qbusiness.GetDataSourceOutput, error) {
+	input := &qbusiness.GetDataSourceInput{
+		ApplicationId: aws.String(applicationID),
+		DataSourceId:  aws.String(dataSourceID),
+		IndexId:       aws.String(indexID),
+	}
+
+	output, err := conn.GetDataSource(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusDataSource(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDataSourceByThreePartKey(ctx, conn, applicationID, indexID, dataSourceID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitDataSourceCreated(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID string, timeout time.Duration) (*qbusiness.GetDataSourceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DataSourceStatusPendingCreation, awstypes.DataSourceStatusCreating),
+		Target:  enum.Slice(awstypes.DataSourceStatusActive),
+		Refresh: statusDataSource(ctx, conn, applicationID, indexID, dataSourceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetDataSourceOutput); ok {
+		tfresource.SetLastError(err, errorDetailError(output.Error))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataSourceUpdated(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID string, timeout time.Duration) (*qbusiness.GetDataSourceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DataSourceStatusUpdating),
+		Target:  enum.Slice(awstypes.DataSourceStatusActive),
+		Refresh: statusDataSource(ctx, conn, applicationID, indexID, dataSourceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetDataSourceOutput); ok {
+		tfresource.SetLastError(err, errorDetailError(output.Error))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataSourceDeleted(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID string, timeout time.Duration) (*qbusiness.GetDataSourceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DataSourceStatusDeleting),
+		Target:  []string{},
+		Refresh: statusDataSource(ctx, conn, applicationID, indexID, dataSourceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetDataSourceOutput); ok {
+		tfresource.SetLastError(err, errorDetailError(output.Error))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type dataSourceResourceModel struct {
+	ApplicationID    types.String                                                     `tfsdk:"application_id"`
+	ARN              types.String                                                     `tfsdk:"arn" autoflex:"DataSourceArn"`
+	Configuration    fwtypes.SmithyJSON[document.Interface]                           `tfsdk:"configuration"`
+	DataSourceID     types.String                                                     `tfsdk:"data_source_id"`
+	Description      types.String                                                     `tfsdk:"description"`
+	DisplayName      types.String                                                     `tfsdk:"display_name"`
+	ID               types.String                                                     `tfsdk:"id" autoflex:"-"`
+	IndexID          types.String                                                     `tfsdk:"index_id"`
+	RoleARN          fwtypes.ARN                                                      `tfsdk:"role_arn"`
+	Status           fwtypes.StringEnum[awstypes.DataSourceStatus]                    `tfsdk:"status"`
+	SyncSchedule     types.String                                                     `tfsdk:"sync_schedule"`
+	Tags             tftags.Map                                                       `tfsdk:"tags"`
+	TagsAll          tftags.Map                                                       `tfsdk:"tags_all"`
+	Timeouts         timeouts.Value                                                   `tfsdk:"timeouts"`
+	Type             types.String                                                     `tfsdk:"type"`
+	VPCConfiguration fwtypes.ListNestedObjectValueOf[dataSourceVPCConfigurationModel] `tfsdk:"vpc_configuration"`
+}
+
+func (data *dataSourceResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, dataSourceResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.ApplicationID = types.StringValue(parts[0])
+	data.IndexID = types.StringValue(parts[1])
+	data.DataSourceID = types.StringValue(parts[2])
+
+	return nil
+}
+
+func (data *dataSourceResourceModel) setID() (string, error) {
+	parts := []string{
+		data.ApplicationID.ValueString(),
+		data.IndexID.ValueString(),
+		data.DataSourceID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, dataSourceResourceIDPartCount, false)
+}
+
+type dataSourceVPCConfigurationModel struct {
+	SecurityGroupIDs fwtypes.SetOfString `tfsdk:"security_group_ids"`
+	SubnetIDs        fwtypes.SetOfString `tfsdk:"subnet_ids"`
+}
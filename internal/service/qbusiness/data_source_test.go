@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfqbusiness "github.com/hashicorp/terraform-provider-aws/internal/service/qbusiness"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccQBusinessDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataSource qbusiness.GetDataSourceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_data_source.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConfig_basic(rName, "FULL_CRAWL"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataSourceExists(ctx, resourceName, &dataSource),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_qbusiness_application.test", names.AttrID),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "qbusiness", regexache.MustCompile(`application/.+/index/.+/data-source/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrConfiguration),
+					resource.TestCheckResourceAttrSet(resourceName, "data_source_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "index_id", "aws_qbusiness_index.test", "index_id"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.data_source", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "S3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataSourceConfig_basic(rName, "FORCED_FULL_CRAWL"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataSourceExists(ctx, resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQBusinessDataSource_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataSource qbusiness.GetDataSourceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_data_source.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConfig_basic(rName, "FULL_CRAWL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceExists(ctx, resourceName, &dataSource),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfqbusiness.ResourceDataSource, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QBusinessClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_qbusiness_data_source" {
+				continue
+			}
+
+			_, err := tfqbusiness.FindDataSourceByThreePartKey(ctx, conn, rs.Primary.Attributes["application_id"], rs.Primary.Attributes["index_id"], rs.Primary.Attributes["data_source_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Amazon Q Business Data Source %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDataSourceExists(ctx context.Context, n string, v *qbusiness.GetDataSourceOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QBusinessClient(ctx)
+
+		output, err := tfqbusiness.FindDataSourceByThreePartKey(ctx, conn, rs.Primary.Attributes["application_id"], rs.Primary.Attributes["index_id"], rs.Primary.Attributes["data_source_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDataSourceConfig_basic(rName, syncMode string) string {
+	return acctest.ConfigCompose(testAccIndexConfig_basic(rName, 1), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "data_source" {
+  name = "%[1]s-ds"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "qbusiness.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "data_source" {
+  name = %[1]q
+  role = aws_iam_role.data_source.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["s3:GetObject", "s3:ListBucket"]
+      Effect   = "Allow"
+      Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+    }, {
+      Action   = ["qbusiness:BatchPutDocument", "qbusiness:BatchDeleteDocument", "qbusiness:PutGroup", "qbusiness:CreateUser", "qbusiness:DeleteGroup", "qbusiness:UpdateUser", "qbusiness:ListGroups"]
+      Effect   = "Allow"
+      Resource = [aws_qbusiness_application.test.arn, "${aws_qbusiness_application.test.arn}/*"]
+    }]
+  })
+}
+
+resource "aws_qbusiness_data_source" "test" {
+  application_id = aws_qbusiness_application.test.id
+  display_name   = %[1]q
+  index_id       = aws_qbusiness_index.test.index_id
+  role_arn       = aws_iam_role.data_source.arn
+
+  configuration = jsonencode({
+    type     = "S3"
+    syncMode = %[2]q
+    connectionConfiguration = {
+      repositoryEndpointMetadata = {
+        BucketName = aws_s3_bucket.test.bucket
+      }
+    }
+    repositoryConfigurations = {
+      document = {
+        fieldMappings = [{
+          dataSourceFieldName = "s3_document_id"
+          indexFieldName      = "s3_document_id"
+          indexFieldType      = "STRING"
+        }]
+      }
+    }
+  })
+
+  depends_on = [aws_iam_role_policy.data_source]
+}
+`, rName, syncMode))
+}
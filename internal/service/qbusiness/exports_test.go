@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+// Exports for use in tests only.
+var (
+	ResourceApplication   = newApplicationResource
+	ResourceDataSource    = newDataSourceResource
+	ResourceIndex         = newIndexResource
+	ResourceRetriever     = newRetrieverResource
+	ResourceWebExperience = newWebExperienceResource
+
+	FindApplicationByID           = findApplicationByID
+	FindDataSourceByThreePartKey  = findDataSourceByThreePartKey
+	FindIndexByTwoPartKey         = findIndexByTwoPartKey
+	FindRetrieverByTwoPartKey     = findRetrieverByTwoPartKey
+	FindWebExperienceByTwoPartKey = findWebExperienceByTwoPartKey
+)
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfqbusiness "github.com/hashicorp/terraform-provider-aws/internal/service/qbusiness"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccQBusinessRetriever_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var retriever qbusiness.GetRetrieverOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_retriever.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRetrieverDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRetrieverConfig_basic(rName, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRetrieverExists(ctx, resourceName, &retriever),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_qbusiness_application.test", names.AttrID),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "qbusiness", regexache.MustCompile(`application/.+/retriever/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.kendra_index_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.native_index_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.native_index_configuration.0.index_id", "aws_qbusiness_index.test", "index_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "retriever_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "NATIVE_INDEX"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRetrieverConfig_basic(rName, rName+"-updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRetrieverExists(ctx, resourceName, &retriever),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQBusinessRetriever_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var retriever qbusiness.GetRetrieverOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_retriever.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRetrieverDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRetrieverConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRetrieverExists(ctx, resourceName, &retriever),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfqbusiness.ResourceRetriever, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRetrieverDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QBusinessClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_qbusiness_retriever" {
+				continue
+			}
+
+			_, err := tfqbusiness.FindRetrieverByTwoPartKey(ctx, conn, rs.Primary.Attributes["application_id"], rs.Primary.Attributes["retriever_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Amazon Q Business Retriever %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRetrieverExists(ctx context.Context, n string, v *qbusiness.GetRetrieverOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QBusinessClient(ctx)
+
+		output, err := tfqbusiness.FindRetrieverByTwoPartKey(ctx, conn, rs.Primary.Attributes["application_id"], rs.Primary.Attributes["retriever_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccRetrieverConfig_basic(rName, displayName string) string {
+	return acctest.ConfigCompose(testAccIndexConfig_basic(rName, 1), fmt.Sprintf(`
+resource "aws_qbusiness_retriever" "test" {
+  application_id = aws_qbusiness_application.test.id
+  display_name   = %[1]q
+  type           = "NATIVE_INDEX"
+
+  configuration {
+    native_index_configuration {
+      index_id = aws_qbusiness_index.test.index_id
+    }
+  }
+}
+`, displayName))
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	// Deleting an application also deletes its indexes, retrievers, data sources and web experiences.
+	awsv2.Register("aws_qbusiness_application", sweepApplications)
+}
+
+func sweepApplications(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.QBusinessClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := qbusiness.NewListApplicationsPaginator(conn, &qbusiness.ListApplicationsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, application := range page.Applications {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newApplicationResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(application.ApplicationId))))
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfqbusiness "github.com/hashicorp/terraform-provider-aws/internal/service/qbusiness"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccQBusinessWebExperience_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var webExperience qbusiness.GetWebExperienceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_web_experience.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebExperienceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebExperienceConfig_basic(rName, "Test title"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWebExperienceExists(ctx, resourceName, &webExperience),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_qbusiness_application.test", names.AttrID),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "qbusiness", regexache.MustCompile(`application/.+/web-experience/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "default_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "sample_prompts_control_mode", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "title", "Test title"),
+					resource.TestCheckResourceAttrSet(resourceName, "web_experience_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWebExperienceConfig_basic(rName, "Updated title"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWebExperienceExists(ctx, resourceName, &webExperience),
+					resource.TestCheckResourceAttr(resourceName, "title", "Updated title"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQBusinessWebExperience_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var webExperience qbusiness.GetWebExperienceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_web_experience.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebExperienceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebExperienceConfig_basic(rName, "Test title"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebExperienceExists(ctx, resourceName, &webExperience),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfqbusiness.ResourceWebExperience, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckWebExperienceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QBusinessClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_qbusiness_web_experience" {
+				continue
+			}
+
+			_, err := tfqbusiness.FindWebExperienceByTwoPartKey(ctx, conn, rs.Primary.Attributes["application_id"], rs.Primary.Attributes["web_experience_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Amazon Q Business Web Experience %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckWebExperienceExists(ctx context.Context, n string, v *qbusiness.GetWebExperienceOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QBusinessClient(ctx)
+
+		output, err := tfqbusiness.FindWebExperienceByTwoPartKey(ctx, conn, rs.Primary.Attributes["application_id"], rs.Primary.Attributes["web_experience_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccWebExperienceConfig_basic(rName, title string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iam_role" "web_experience" {
+  name = "%[1]s-web"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = ["sts:AssumeRole", "sts:SetContext"]
+      Effect = "Allow"
+      Principal = {
+        Service = "application.qbusiness.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "web_experience" {
+  name = %[1]q
+  role = aws_iam_role.web_experience.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["qbusiness:Chat", "qbusiness:ChatSync", "qbusiness:ListMessages", "qbusiness:ListConversations", "qbusiness:DeleteConversation", "qbusiness:PutFeedback", "qbusiness:GetWebExperience", "qbusiness:GetApplication", "qbusiness:ListPlugins", "qbusiness:GetChatControlsConfiguration"]
+      Effect   = "Allow"
+      Resource = [aws_qbusiness_application.test.arn]
+    }]
+  })
+}
+
+resource "aws_qbusiness_web_experience" "test" {
+  application_id              = aws_qbusiness_application.test.id
+  role_arn                    = aws_iam_role.web_experience.arn
+  sample_prompts_control_mode = "ENABLED"
+  title                       = %[2]q
+
+  depends_on = [aws_iam_role_policy.web_experience]
+}
+`, rName, title))
+}
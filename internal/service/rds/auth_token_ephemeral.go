@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/feature/rds/auth"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_rds_auth_token", name="Auth Token")
+func newAuthTokenEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &authTokenEphemeralResource{}, nil
+}
+
+type authTokenEphemeralResource struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (*authTokenEphemeralResource) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_rds_auth_token"
+}
+
+func (e *authTokenEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrEndpoint: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrRegion: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrUsername: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *authTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data authTokenEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Region.IsNull() || data.Region.IsUnknown() {
+		data.Region = types.StringValue(e.Meta().Region(ctx))
+	}
+
+	// The token is a presigned rds-db:connect request with the URL scheme removed, valid for 15 minutes.
+	// See https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/UsingWithRDS.IAMDBAuth.Connecting.html.
+	endpoint := data.Endpoint.ValueString()
+	token, err := auth.BuildAuthToken(ctx, endpoint, data.Region.ValueString(), data.Username.ValueString(), e.Meta().CredentialsProvider(ctx))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating RDS IAM authentication token (%s)", endpoint), err.Error())
+
+		return
+	}
+
+	data.Token = types.StringValue(token)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type authTokenEphemeralResourceModel struct {
+	Endpoint types.String `tfsdk:"endpoint"`
+	Region   types.String `tfsdk:"region"`
+	Token    types.String `tfsdk:"token"`
+	Username types.String `tfsdk:"username"`
+}
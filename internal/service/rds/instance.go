@@ -32,6 +32,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -416,7 +417,7 @@ func resourceInstance() *schema.Resource {
 			"manage_master_user_password": {
 				Type:          schema.TypeBool,
 				Optional:      true,
-				ConflictsWith: []string{names.AttrPassword},
+				ConflictsWith: []string{names.AttrPassword, "password_wo"},
 			},
 			"master_user_secret": {
 				Type:     schema.TypeList,
@@ -497,8 +498,10 @@ func resourceInstance() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"manage_master_user_password"},
+				ConflictsWith: []string{"manage_master_user_password", "password_wo"},
 			},
+			"password_wo":         sdkv2.WriteOnlyStringSchema("password_wo", "manage_master_user_password", names.AttrPassword),
+			"password_wo_version": sdkv2.WriteOnlyVersionSchema("password_wo"),
 			"performance_insights_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -1001,6 +1004,11 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			modifyDbInstanceInput.MasterUserPassword = aws.String(v.(string))
 			requiresModifyDbInstance = true
 		}
+
+		if v := sdkv2.GetWriteOnlyString(d, "password_wo"); v != "" {
+			modifyDbInstanceInput.MasterUserPassword = aws.String(v)
+			requiresModifyDbInstance = true
+		}
 	} else if v, ok := d.GetOk("s3_import"); ok {
 		if _, ok := d.GetOk(names.AttrAllocatedStorage); !ok {
 			diags = sdkdiag.AppendErrorf(diags, `"allocated_storage": required field is not set`)
@@ -1114,6 +1122,10 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			input.MasterUserPassword = aws.String(v.(string))
 		}
 
+		if v := sdkv2.GetWriteOnlyString(d, "password_wo"); v != "" {
+			input.MasterUserPassword = aws.String(v)
+		}
+
 		if v, ok := d.GetOk("performance_insights_enabled"); ok {
 			input.EnablePerformanceInsights = aws.Bool(v.(bool))
 		}
@@ -1357,6 +1369,11 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			requiresModifyDbInstance = true
 		}
 
+		if v := sdkv2.GetWriteOnlyString(d, "password_wo"); v != "" {
+			modifyDbInstanceInput.MasterUserPassword = aws.String(v)
+			requiresModifyDbInstance = true
+		}
+
 		if v, ok := d.GetOk("performance_insights_enabled"); ok {
 			modifyDbInstanceInput.EnablePerformanceInsights = aws.Bool(v.(bool))
 			requiresModifyDbInstance = true
@@ -1583,6 +1600,11 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			requiresModifyDbInstance = true
 		}
 
+		if v := sdkv2.GetWriteOnlyString(d, "password_wo"); v != "" {
+			modifyDbInstanceInput.MasterUserPassword = aws.String(v)
+			requiresModifyDbInstance = true
+		}
+
 		if v, ok := d.GetOk(names.AttrPort); ok {
 			input.Port = aws.Int32(int32(v.(int)))
 		}
@@ -1779,6 +1801,10 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			input.MasterUserPassword = aws.String(v.(string))
 		}
 
+		if v := sdkv2.GetWriteOnlyString(d, "password_wo"); v != "" {
+			input.MasterUserPassword = aws.String(v)
+		}
+
 		if v, ok := d.GetOk(names.AttrParameterGroupName); ok {
 			input.DBParameterGroupName = aws.String(v.(string))
 		}
@@ -2115,6 +2141,8 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			names.AttrTags, names.AttrTagsAll,
 			names.AttrDeletionProtection,
 			names.AttrPassword,
+			"password_wo",
+			"password_wo_version",
 		) {
 			orchestrator := newBlueGreenOrchestrator(conn)
 			defer orchestrator.CleanUp(ctx)
@@ -2582,6 +2610,13 @@ func dbInstancePopulateModify(input *rds.ModifyDBInstanceInput, d *schema.Resour
 		}
 	}
 
+	if sdkv2.HasWriteOnlyChange(d, "password_wo") {
+		if v := sdkv2.GetWriteOnlyString(d, "password_wo"); v != "" {
+			needsModify = true
+			input.MasterUserPassword = aws.String(v)
+		}
+	}
+
 	if d.HasChanges("performance_insights_enabled", "performance_insights_kms_key_id", "performance_insights_retention_period") {
 		needsModify = true
 		input.EnablePerformanceInsights = aws.Bool(d.Get("performance_insights_enabled").(bool))
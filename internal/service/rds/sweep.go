@@ -4,9 +4,11 @@
 package rds
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
@@ -20,7 +22,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_rds_cluster_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_rds_cluster_parameter_group", &resource.Sweeper{
 		Name: "aws_rds_cluster_parameter_group",
 		F:    sweepClusterParameterGroups,
 		Dependencies: []string{
@@ -28,7 +30,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_cluster_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_cluster_snapshot", &resource.Sweeper{
 		Name: "aws_db_cluster_snapshot",
 		F:    sweepClusterSnapshots,
 		Dependencies: []string{
@@ -36,7 +38,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_rds_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_rds_cluster", &resource.Sweeper{
 		Name: "aws_rds_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -44,17 +46,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_event_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_event_subscription", &resource.Sweeper{
 		Name: "aws_db_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_rds_global_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_rds_global_cluster", &resource.Sweeper{
 		Name: "aws_rds_global_cluster",
 		F:    sweepGlobalClusters,
 	})
 
-	resource.AddTestSweepers("aws_db_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_instance", &resource.Sweeper{
 		Name: "aws_db_instance",
 		F:    sweepInstances,
 		Dependencies: []string{
@@ -62,7 +64,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_option_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_option_group", &resource.Sweeper{
 		Name: "aws_db_option_group",
 		F:    sweepOptionGroups,
 		Dependencies: []string{
@@ -71,7 +73,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_parameter_group", &resource.Sweeper{
 		Name: "aws_db_parameter_group",
 		F:    sweepParameterGroups,
 		Dependencies: []string{
@@ -79,12 +81,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_proxy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_proxy", &resource.Sweeper{
 		Name: "aws_db_proxy",
 		F:    sweepProxies,
 	})
 
-	resource.AddTestSweepers("aws_db_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_snapshot", &resource.Sweeper{
 		Name: "aws_db_snapshot",
 		F:    sweepSnapshots,
 		Dependencies: []string{
@@ -92,7 +94,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_subnet_group", &resource.Sweeper{
 		Name: "aws_db_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -100,7 +102,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_instance_automated_backups_replication", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_instance_automated_backups_replication", &resource.Sweeper{
 		Name: "aws_db_instance_automated_backups_replication",
 		F:    sweepInstanceAutomatedBackups,
 		Dependencies: []string{
@@ -644,7 +646,6 @@ func sweepInstanceAutomatedBackups(region string) error {
 	conn := client.RDSClient(ctx)
 	input := &rds.DescribeDBInstanceAutomatedBackupsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
-	var backupARNs []string
 
 	pages := rds.NewDescribeDBInstanceAutomatedBackupsPaginator(conn, input)
 	for pages.HasMorePages() {
@@ -665,9 +666,12 @@ func sweepInstanceAutomatedBackups(region string) error {
 			d := r.Data(nil)
 			d.SetId(arn)
 			d.Set("source_db_instance_arn", v.DBInstanceArn)
-			backupARNs = append(backupARNs, arn)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, &instanceAutomatedBackupSweeper{
+				SweepableWithMetadata: sweep.NewSweepResource(r, d, client),
+				conn:                  conn,
+				arn:                   arn,
+			})
 		}
 	}
 
@@ -677,20 +681,33 @@ func sweepInstanceAutomatedBackups(region string) error {
 		return fmt.Errorf("error sweeping RDS Instance Automated Backups (%s): %w", region, err)
 	}
 
-	// Since there is no resource for automated backups themselves, they are swept here.
-	for _, v := range backupARNs {
-		log.Printf("[DEBUG] Deleting RDS Instance Automated Backup: %s", v)
-		_, err = conn.DeleteDBInstanceAutomatedBackup(ctx, &rds.DeleteDBInstanceAutomatedBackupInput{
-			DBInstanceAutomatedBackupsArn: aws.String(v),
-		})
+	return nil
+}
 
-		if errs.IsA[*types.DBInstanceAutomatedBackupNotFoundFault](err) {
-			continue
-		}
+// instanceAutomatedBackupSweeper deletes an RDS Instance Automated Backup's replication and then the backup itself,
+// for which there is no resource.
+type instanceAutomatedBackupSweeper struct {
+	sweep.SweepableWithMetadata
+	conn *rds.Client
+	arn  string
+}
 
-		if err != nil {
-			log.Printf("[WARN] Deleting RDS Instance Automated Backup (%s): %s", v, err)
-		}
+func (s *instanceAutomatedBackupSweeper) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	if err := s.SweepableWithMetadata.Delete(ctx, timeout, optFns...); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting RDS Instance Automated Backup: %s", s.arn)
+	_, err := s.conn.DeleteDBInstanceAutomatedBackup(ctx, &rds.DeleteDBInstanceAutomatedBackupInput{
+		DBInstanceAutomatedBackupsArn: aws.String(s.arn),
+	})
+
+	if errs.IsA[*types.DBInstanceAutomatedBackupNotFoundFault](err) {
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[WARN] Deleting RDS Instance Automated Backup (%s): %s", s.arn, err)
 	}
 
 	return nil
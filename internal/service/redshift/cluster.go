@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -267,7 +268,7 @@ func resourceCluster() *schema.Resource {
 			"manage_master_password": {
 				Type:          schema.TypeBool,
 				Optional:      true,
-				ConflictsWith: []string{"master_password"},
+				ConflictsWith: []string{"master_password", "master_password_wo"},
 			},
 			"manual_snapshot_retention_period": {
 				Type:         schema.TypeInt,
@@ -286,7 +287,7 @@ func resourceCluster() *schema.Resource {
 					validation.StringMatch(regexache.MustCompile(`^.*[0-9].*`), "must contain at least one number"),
 					validation.StringMatch(regexache.MustCompile(`^[^\@\/'" ]*$`), "cannot contain [/@\"' ]"),
 				),
-				ConflictsWith: []string{"manage_master_password"},
+				ConflictsWith: []string{"manage_master_password", "master_password_wo"},
 			},
 			"master_password_secret_arn": {
 				Type:     schema.TypeString,
@@ -298,6 +299,18 @@ func resourceCluster() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: verify.ValidKMSKeyID,
 			},
+			"master_password_wo": func() *schema.Schema {
+				schema := sdkv2.WriteOnlyStringSchema("master_password_wo", "manage_master_password", "master_password")
+				schema.ValidateFunc = validation.All(
+					validation.StringLenBetween(8, 64),
+					validation.StringMatch(regexache.MustCompile(`^.*[a-z].*`), "must contain at least one lowercase letter"),
+					validation.StringMatch(regexache.MustCompile(`^.*[A-Z].*`), "must contain at least one uppercase letter"),
+					validation.StringMatch(regexache.MustCompile(`^.*[0-9].*`), "must contain at least one number"),
+					validation.StringMatch(regexache.MustCompile(`^[^\@\/'" ]*$`), "cannot contain [/@\"' ]"),
+				)
+				return schema
+			}(),
+			"master_password_wo_version": sdkv2.WriteOnlyVersionSchema("master_password_wo"),
 			"master_username": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -529,6 +542,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		inputC.MasterUserPassword = aws.String(v.(string))
 	}
 
+	if v := sdkv2.GetWriteOnlyString(d, "master_password_wo"); v != "" {
+		inputC.MasterUserPassword = aws.String(v)
+	}
+
 	if v, ok := d.GetOk("master_password_secret_kms_key_id"); ok {
 		inputR.MasterPasswordSecretKmsKeyId = aws.String(v.(string))
 		inputC.MasterPasswordSecretKmsKeyId = aws.String(v.(string))
@@ -579,9 +596,9 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 		d.SetId(aws.ToString(output.Cluster.ClusterIdentifier))
 	} else {
-		if _, ok := d.GetOk("master_password"); !ok {
+		if inputC.MasterUserPassword == nil {
 			if _, ok := d.GetOk("manage_master_password"); !ok {
-				return sdkdiag.AppendErrorf(diags, `provider.aws: aws_redshift_cluster: %s: one of "manage_master_password" or "master_password" is required`, d.Get(names.AttrClusterIdentifier).(string))
+				return sdkdiag.AppendErrorf(diags, `provider.aws: aws_redshift_cluster: %s: one of "manage_master_password", "master_password" or "master_password_wo" is required`, d.Get(names.AttrClusterIdentifier).(string))
 			}
 		}
 
@@ -808,6 +825,12 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.MasterUserPassword = aws.String(d.Get("master_password").(string))
 		}
 
+		if sdkv2.HasWriteOnlyChange(d, "master_password_wo") {
+			if v := sdkv2.GetWriteOnlyString(d, "master_password_wo"); v != "" {
+				input.MasterUserPassword = aws.String(v)
+			}
+		}
+
 		if d.HasChange("master_password_secret_kms_key_id") {
 			input.MasterPasswordSecretKmsKeyId = aws.String(d.Get("master_password_secret_kms_key_id").(string))
 		}
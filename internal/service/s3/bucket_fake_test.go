@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// TestBucket_fakeLifecycle exercises the bucket's CRUD logic, waiters and flatteners against an in-process fake S3.
+func TestBucket_fakeLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	backend := fakeaws.New()
+	buckets := newFakeBuckets(backend)
+	resourceName := "aws_s3_bucket.test"
+
+	acctest.FakeTest(ctx, t, backend, resource.TestCase{
+		CheckDestroy: func(*terraform.State) error {
+			if n := buckets.len(); n != 0 {
+				return fmt.Errorf("%d S3 Buckets still exist", n)
+			}
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testBucketConfig_fake("key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrARN, "arn:aws:s3:::tf-fake"), //lintignore:AWSAT005
+					resource.TestCheckResourceAttr(resourceName, names.AttrBucket, "tf-fake"),
+					resource.TestCheckResourceAttr(resourceName, "bucket_regional_domain_name", "tf-fake.s3.us-west-2.amazonaws.com"), //lintignore:AWSAT003
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrRegion, "us-west-2"), //lintignore:AWSAT003
+					resource.TestCheckResourceAttr(resourceName, "request_payer", "BucketOwner"),
+					resource.TestCheckResourceAttr(resourceName, "server_side_encryption_configuration.0.rule.0.apply_server_side_encryption_by_default.0.sse_algorithm", "AES256"),
+					resource.TestCheckResourceAttr(resourceName, "versioning.0.enabled", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config: testBucketConfig_fake("key1", "value1updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+				),
+			},
+		},
+	})
+}
+
+// fakeBuckets is a minimal stateful fake of the S3 bucket API.
+// Bucket subresources other than tags are never configured.
+type fakeBuckets struct {
+	mutex sync.Mutex
+	tags  map[string]map[string]string
+}
+
+func newFakeBuckets(backend *fakeaws.Backend) *fakeBuckets {
+	b := &fakeBuckets{
+		tags: make(map[string]map[string]string),
+	}
+
+	backend.Handle("S3", "CreateBucket", b.createBucket)
+	backend.Handle("S3", "DeleteBucket", b.deleteBucket)
+	backend.Handle("S3", "DeleteBucketTagging", b.deleteBucketTagging)
+	backend.Handle("S3", "GetBucketTagging", b.getBucketTagging)
+	backend.Handle("S3", "HeadBucket", b.headBucket)
+	backend.Handle("S3", "PutBucketTagging", b.putBucketTagging)
+
+	for operation, body := range map[string]string{
+		"GetBucketAccelerateConfiguration": `<AccelerateConfiguration/>`,
+		"GetBucketAcl":                     `<AccessControlPolicy><Owner><ID>fakeowner</ID></Owner><AccessControlList><Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser"><ID>fakeowner</ID></Grantee><Permission>FULL_CONTROL</Permission></Grant></AccessControlList></AccessControlPolicy>`,
+		"GetBucketEncryption":              `<ServerSideEncryptionConfiguration><Rule><ApplyServerSideEncryptionByDefault><SSEAlgorithm>AES256</SSEAlgorithm></ApplyServerSideEncryptionByDefault><BucketKeyEnabled>false</BucketKeyEnabled></Rule></ServerSideEncryptionConfiguration>`,
+		"GetBucketLogging":                 `<BucketLoggingStatus/>`,
+		"GetBucketRequestPayment":          `<RequestPaymentConfiguration><Payer>BucketOwner</Payer></RequestPaymentConfiguration>`,
+		"GetBucketVersioning":              `<VersioningConfiguration/>`,
+	} {
+		backend.Handle("S3", operation, b.withBucket(func(string) *fakeaws.Response {
+			return fakeaws.RESTXMLResponse(http.StatusOK, body)
+		}))
+	}
+
+	for operation, code := range map[string]string{
+		"GetBucketCors":                   "NoSuchCORSConfiguration",
+		"GetBucketLifecycleConfiguration": "NoSuchLifecycleConfiguration",
+		"GetBucketPolicy":                 "NoSuchBucketPolicy",
+		"GetBucketReplication":            "ReplicationConfigurationNotFoundError",
+		"GetBucketWebsite":                "NoSuchWebsiteConfiguration",
+		"GetObjectLockConfiguration":      "ObjectLockConfigurationNotFoundError",
+	} {
+		backend.Handle("S3", operation, b.withBucket(func(string) *fakeaws.Response {
+			return fakeaws.RESTXMLErrorResponse(http.StatusNotFound, code, "The configuration does not exist")
+		}))
+	}
+
+	return b
+}
+
+type fakeBucketTagging struct {
+	XMLName xml.Name `xml:"Tagging"`
+	TagSet  []struct {
+		Key   string
+		Value string
+	} `xml:"TagSet>Tag"`
+}
+
+func (b *fakeBuckets) len() int {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return len(b.tags)
+}
+
+func (b *fakeBuckets) createBucket(r *fakeaws.Request) *fakeaws.Response {
+	bucket := fakeBucketName(r)
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if _, ok := b.tags[bucket]; ok {
+		return fakeaws.RESTXMLErrorResponse(http.StatusConflict, "BucketAlreadyOwnedByYou", "Your previous request to create the named bucket succeeded and you already own it.")
+	}
+
+	b.tags[bucket] = make(map[string]string)
+
+	return fakeaws.RESTXMLResponse(http.StatusOK, "").WithHeader("Location", "/"+bucket)
+}
+
+func (b *fakeBuckets) deleteBucket(r *fakeaws.Request) *fakeaws.Response {
+	return b.withBucket(func(bucket string) *fakeaws.Response {
+		delete(b.tags, bucket)
+
+		return fakeaws.RESTXMLResponse(http.StatusNoContent, "")
+	})(r)
+}
+
+func (b *fakeBuckets) deleteBucketTagging(r *fakeaws.Request) *fakeaws.Response {
+	return b.withBucket(func(bucket string) *fakeaws.Response {
+		clear(b.tags[bucket])
+
+		return fakeaws.RESTXMLResponse(http.StatusNoContent, "")
+	})(r)
+}
+
+func (b *fakeBuckets) getBucketTagging(r *fakeaws.Request) *fakeaws.Response {
+	return b.withBucket(func(bucket string) *fakeaws.Response {
+		if len(b.tags[bucket]) == 0 {
+			return fakeaws.RESTXMLErrorResponse(http.StatusNotFound, "NoSuchTagSet", "The TagSet does not exist")
+		}
+
+		var tagging fakeBucketTagging
+		for _, k := range slices.Sorted(maps.Keys(b.tags[bucket])) {
+			tagging.TagSet = append(tagging.TagSet, struct {
+				Key   string
+				Value string
+			}{k, b.tags[bucket][k]})
+		}
+
+		body, err := xml.Marshal(tagging)
+		if err != nil {
+			return fakeaws.RESTXMLErrorResponse(http.StatusInternalServerError, "InternalError", err.Error())
+		}
+
+		return fakeaws.RESTXMLResponse(http.StatusOK, string(body))
+	})(r)
+}
+
+func (b *fakeBuckets) headBucket(r *fakeaws.Request) *fakeaws.Response {
+	bucket := fakeBucketName(r)
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	// HEAD responses have no body, so only the status code identifies the error.
+	if _, ok := b.tags[bucket]; !ok {
+		return fakeaws.RESTXMLResponse(http.StatusNotFound, "")
+	}
+
+	return fakeaws.RESTXMLResponse(http.StatusOK, "").WithHeader("X-Amz-Bucket-Region", "us-west-2") //lintignore:AWSAT003
+}
+
+func (b *fakeBuckets) putBucketTagging(r *fakeaws.Request) *fakeaws.Response {
+	var tagging fakeBucketTagging
+	if err := r.DecodeXML(&tagging); err != nil {
+		return fakeaws.RESTXMLErrorResponse(http.StatusBadRequest, "MalformedXML", err.Error())
+	}
+
+	return b.withBucket(func(bucket string) *fakeaws.Response {
+		tags := make(map[string]string, len(tagging.TagSet))
+		for _, tag := range tagging.TagSet {
+			tags[tag.Key] = tag.Value
+		}
+		b.tags[bucket] = tags
+
+		return fakeaws.RESTXMLResponse(http.StatusNoContent, "")
+	})(r)
+}
+
+// withBucket returns a handler that calls f if the request's bucket exists.
+func (b *fakeBuckets) withBucket(f func(string) *fakeaws.Response) fakeaws.Handler {
+	return func(r *fakeaws.Request) *fakeaws.Response {
+		bucket := fakeBucketName(r)
+
+		b.mutex.Lock()
+		defer b.mutex.Unlock()
+
+		if _, ok := b.tags[bucket]; !ok {
+			return fakeaws.RESTXMLErrorResponse(http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist")
+		}
+
+		return f(bucket)
+	}
+}
+
+// fakeBucketName returns the name of the bucket addressed by a virtual-hosted-style or path-style request.
+func fakeBucketName(r *fakeaws.Request) string {
+	if bucket, _, ok := strings.Cut(r.HTTPRequest.URL.Host, ".s3."); ok {
+		return bucket
+	}
+
+	bucket, _, _ := strings.Cut(strings.TrimPrefix(r.HTTPRequest.URL.Path, "/"), "/")
+
+	return bucket
+}
+
+func testBucketConfig_fake(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = "tf-fake"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
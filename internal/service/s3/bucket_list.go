@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/list"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @ListResource("aws_s3_bucket", name="Bucket")
+func listBuckets(ctx context.Context, meta any, filter list.Filter) iter.Seq2[list.Result, error] {
+	return func(yield func(list.Result, error) bool) {
+		c := meta.(*conns.AWSClient)
+		conn := c.S3Client(ctx)
+
+		input := s3.ListBucketsInput{
+			BucketRegion: aws.String(c.Region(ctx)),
+		}
+		if filter.NamePrefix != "" {
+			input.Prefix = aws.String(filter.NamePrefix)
+		}
+
+		buckets, err := findBuckets(ctx, conn, &input, func(v types.Bucket) bool {
+			return filter.MatchName(aws.ToString(v.Name))
+		})
+
+		if err != nil {
+			yield(list.Result{}, fmt.Errorf("listing S3 Buckets: %w", err))
+			return
+		}
+
+		for _, v := range buckets {
+			bucket := aws.ToString(v.Name)
+
+			if filter.HasTags() {
+				tags, err := bucketListTags(ctx, conn, bucket)
+
+				if err != nil {
+					yield(list.Result{}, fmt.Errorf("listing tags for S3 Bucket (%s): %w", bucket, err))
+					return
+				}
+
+				if !filter.MatchTags(tags.Map()) {
+					continue
+				}
+			}
+
+			result := list.Result{
+				DisplayName: bucket,
+				Identity: map[string]string{
+					names.AttrBucket: bucket,
+				},
+				ImportID: bucket,
+			}
+
+			if !yield(result, nil) {
+				return
+			}
+		}
+	}
+}
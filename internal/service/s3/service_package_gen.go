@@ -32,6 +32,16 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 	}
 }
 
+func (p *servicePackage) ListResources(ctx context.Context) []*types.ServicePackageListResource {
+	return []*types.ServicePackageListResource{
+		{
+			List:     listBuckets,
+			TypeName: "aws_s3_bucket",
+			Name:     "Bucket",
+		},
+	}
+}
+
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
 		{
@@ -90,6 +100,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrBucket,
 				ResourceType:        "Bucket",
 			},
+			Identity: types.RegionalSingleParameterIdentity(names.AttrBucket),
 		},
 		{
 			Factory:  resourceBucketAccelerateConfiguration,
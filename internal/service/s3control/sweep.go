@@ -19,12 +19,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_s3control_access_grant", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3control_access_grant", &resource.Sweeper{
 		Name: "aws_s3control_access_grant",
 		F:    sweepAccessGrants,
 	})
 
-	resource.AddTestSweepers("aws_s3control_access_grants_location", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3control_access_grants_location", &resource.Sweeper{
 		Name: "aws_s3control_access_grants_location",
 		F:    sweepAccessGrantsLocations,
 		Dependencies: []string{
@@ -32,7 +32,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_s3control_access_grants_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3control_access_grants_instance", &resource.Sweeper{
 		Name: "aws_s3control_access_grants_instance",
 		F:    sweepAccessGrantsInstances,
 		Dependencies: []string{
@@ -40,7 +40,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_s3_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_access_point", &resource.Sweeper{
 		Name: "aws_s3_access_point",
 		F:    sweepAccessPoints,
 		Dependencies: []string{
@@ -48,17 +48,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_s3control_multi_region_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3control_multi_region_access_point", &resource.Sweeper{
 		Name: "aws_s3control_multi_region_access_point",
 		F:    sweepMultiRegionAccessPoints,
 	})
 
-	resource.AddTestSweepers("aws_s3control_object_lambda_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3control_object_lambda_access_point", &resource.Sweeper{
 		Name: "aws_s3control_object_lambda_access_point",
 		F:    sweepObjectLambdaAccessPoints,
 	})
 
-	resource.AddTestSweepers("aws_s3control_storage_lens_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3control_storage_lens_configuration", &resource.Sweeper{
 		Name: "aws_s3control_storage_lens_configuration",
 		F:    sweepStorageLensConfigurations,
 	})
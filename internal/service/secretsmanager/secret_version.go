@@ -16,10 +16,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -58,7 +60,7 @@ func resourceSecretVersion() *schema.Resource {
 				Optional:      true,
 				ForceNew:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"secret_string"},
+				ConflictsWith: []string{"secret_string", "secret_string_wo"},
 				ValidateFunc:  verify.ValidBase64String,
 			},
 			"secret_string": {
@@ -66,7 +68,15 @@ func resourceSecretVersion() *schema.Resource {
 				Optional:      true,
 				ForceNew:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"secret_binary"},
+				ConflictsWith: []string{"secret_binary", "secret_string_wo"},
+			},
+			"secret_string_wo": sdkv2.WriteOnlyStringSchema("secret_string_wo", "secret_binary", "secret_string"),
+			"secret_string_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"secret_string_wo"},
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 			"version_id": {
 				Type:     schema.TypeString,
@@ -100,6 +110,8 @@ func resourceSecretVersionCreate(ctx context.Context, d *schema.ResourceData, me
 		}
 	} else if v, ok := d.GetOk("secret_string"); ok {
 		input.SecretString = aws.String(v.(string))
+	} else if v := sdkv2.GetWriteOnlyString(d, "secret_string_wo"); v != "" {
+		input.SecretString = aws.String(v)
 	}
 
 	if v, ok := d.GetOk("version_stages"); ok && v.(*schema.Set).Len() > 0 {
@@ -150,7 +162,10 @@ func resourceSecretVersionRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set(names.AttrARN, output.ARN)
 	d.Set("secret_binary", itypes.Base64EncodeOnce(output.SecretBinary))
 	d.Set("secret_id", secretID)
-	d.Set("secret_string", output.SecretString)
+	// The secret value is never persisted in state when it's written from a write-only argument.
+	if _, ok := d.GetOk("secret_string_wo_version"); !ok {
+		d.Set("secret_string", output.SecretString)
+	}
 	d.Set("version_id", output.VersionId)
 	d.Set("version_stages", output.VersionStages)
 
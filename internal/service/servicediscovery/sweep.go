@@ -18,7 +18,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_service_discovery_http_namespace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_service_discovery_http_namespace", &resource.Sweeper{
 		Name: "aws_service_discovery_http_namespace",
 		F:    sweepHTTPNamespaces,
 		Dependencies: []string{
@@ -26,7 +26,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_service_discovery_private_dns_namespace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_service_discovery_private_dns_namespace", &resource.Sweeper{
 		Name: "aws_service_discovery_private_dns_namespace",
 		F:    sweepPrivateDNSNamespaces,
 		Dependencies: []string{
@@ -34,7 +34,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_service_discovery_public_dns_namespace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_service_discovery_public_dns_namespace", &resource.Sweeper{
 		Name: "aws_service_discovery_public_dns_namespace",
 		F:    sweepPublicDNSNamespaces,
 		Dependencies: []string{
@@ -42,7 +42,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_service_discovery_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_service_discovery_service", &resource.Sweeper{
 		Name: "aws_service_discovery_service",
 		F:    sweepServices,
 	})
@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicequotas
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/preflight"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	preflightCheckServiceQuota = "service_quota"
+)
+
+const (
+	quotaCodeElasticIPs                 = "L-0263D0A3"
+	quotaCodeLambdaConcurrentExecutions = "L-B99A9384"
+	quotaCodeRulesPerSecurityGroup      = "L-0EA8095F"
+	quotaCodeVPCsPerRegion              = "L-F678F1CE"
+)
+
+// Lambda requires that at least this many concurrent executions remain unreserved.
+const lambdaMinimumUnreservedConcurrentExecutions = 100
+
+// quota describes a quota consumed by planned resources.
+type quota struct {
+	// code uniquely identifies the quota.
+	code string
+	name string
+	// lookup returns the applied quota value and current usage in the specified scope.
+	lookup func(ctx context.Context, meta *conns.AWSClient, scope string) (float64, float64, error)
+}
+
+// quotaUse describes how a planned resource consumes a quota.
+// It returns the quota scope, e.g. a security group ID for a per-security group quota,
+// the number of units consumed, and whether the planned resource consumes the quota.
+type quotaUse func(request preflight.Request) (string, float64, bool)
+
+var (
+	quotaVPCsPerRegion = &quota{
+		code:   quotaCodeVPCsPerRegion,
+		name:   "VPCs per Region",
+		lookup: vpcsPerRegionLookup,
+	}
+	quotaElasticIPs = &quota{
+		code:   quotaCodeElasticIPs,
+		name:   "EC2-VPC Elastic IPs",
+		lookup: elasticIPsLookup,
+	}
+	quotaRulesPerSecurityGroup = &quota{
+		code:   quotaCodeRulesPerSecurityGroup,
+		name:   "Inbound or outbound rules per security group",
+		lookup: rulesPerSecurityGroupLookup,
+	}
+	quotaLambdaConcurrentExecutions = &quota{
+		code:   quotaCodeLambdaConcurrentExecutions,
+		name:   "Lambda concurrent executions available for reservation",
+		lookup: lambdaConcurrentExecutionsLookup,
+	}
+)
+
+func (p *servicePackage) PreflightChecks(ctx context.Context) []*preflight.Check {
+	return []*preflight.Check{
+		quotaPreflightCheck("aws_eip", quotaElasticIPs, createUse),
+		quotaPreflightCheck("aws_lambda_function", quotaLambdaConcurrentExecutions, lambdaReservedConcurrencyUse),
+		quotaPreflightCheck("aws_security_group_rule", quotaRulesPerSecurityGroup, securityGroupRuleUse("security_group_id", names.AttrType)),
+		quotaPreflightCheck("aws_vpc", quotaVPCsPerRegion, createUse),
+		quotaPreflightCheck("aws_vpc_security_group_egress_rule", quotaRulesPerSecurityGroup, securityGroupRuleUse("security_group_id", "egress")),
+		quotaPreflightCheck("aws_vpc_security_group_ingress_rule", quotaRulesPerSecurityGroup, securityGroupRuleUse("security_group_id", "ingress")),
+	}
+}
+
+// plannedQuotaUsage accumulates the planned use of quotas across all resources planned by this provider instance.
+// Terraform starts a new provider instance for each plan, so usage is not carried over between plans.
+type plannedQuotaUsage struct {
+	mutex  sync.Mutex
+	usages map[string]*quotaUsage
+}
+
+type quotaUsage struct {
+	limit   float64
+	current float64
+	planned float64
+}
+
+var plannedUsage = newPlannedQuotaUsage()
+
+func newPlannedQuotaUsage() *plannedQuotaUsage {
+	return &plannedQuotaUsage{
+		usages: make(map[string]*quotaUsage),
+	}
+}
+
+// add records the planned use of n units of the specified quota.
+// The applied quota value and current usage are looked up on first use of each quota scope.
+func (u *plannedQuotaUsage) add(ctx context.Context, key string, n float64, lookup func(context.Context) (float64, float64, error)) (quotaUsage, error) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	usage, ok := u.usages[key]
+	if !ok {
+		limit, current, err := lookup(ctx)
+		if err != nil {
+			return quotaUsage{}, err
+		}
+
+		usage = &quotaUsage{
+			limit:   limit,
+			current: current,
+		}
+		u.usages[key] = usage
+	}
+
+	usage.planned += n
+
+	return *usage, nil
+}
+
+// quotaPreflightCheck returns a preflight check that the planned resource does not exceed the specified quota.
+func quotaPreflightCheck(typeName string, q *quota, use quotaUse) *preflight.Check {
+	return &preflight.Check{
+		Name:     preflightCheckServiceQuota,
+		TypeName: typeName,
+		Func: func(ctx context.Context, meta *conns.AWSClient, request preflight.Request) (diag.Diagnostics, error) {
+			var diags diag.Diagnostics
+
+			scope, n, ok := use(request)
+			if !ok || n <= 0 {
+				return diags, nil
+			}
+
+			key := strings.Join([]string{meta.AccountID(ctx), meta.Region(ctx), q.code, scope}, "/")
+			usage, err := plannedUsage.add(ctx, key, n, func(ctx context.Context) (float64, float64, error) {
+				return q.lookup(ctx, meta, scope)
+			})
+
+			if err != nil {
+				return diags, fmt.Errorf("reading %s quota: %w", q.name, err)
+			}
+
+			if usage.current+usage.planned > usage.limit {
+				detail := fmt.Sprintf("The plan would exceed the %s quota of %g in %s: %g in use and %g planned.", q.name, usage.limit, meta.Region(ctx), usage.current, usage.planned)
+				if scope != "" {
+					detail = fmt.Sprintf("The plan would exceed the %s quota of %g for %s: %g in use and %g planned.", q.name, usage.limit, scope, usage.current, usage.planned)
+				}
+				diags.AddError("Service quota exceeded", detail+
+					"\n\nRequest a quota increase using the Service Quotas console or the aws_servicequotas_service_quota resource, "+
+					"or skip this check by adding \""+preflightCheckServiceQuota+"\" to the provider's preflight.skip_checks argument.")
+			}
+
+			return diags, nil
+		},
+	}
+}
+
+// createUse consumes one unit of a quota for each resource created.
+func createUse(request preflight.Request) (string, float64, bool) {
+	return "", 1, request.IsCreate
+}
+
+// securityGroupRuleUse consumes one unit of a per-security group, per-direction quota for each rule created.
+// directionAttrName is either the name of the attribute containing the rule's direction or the fixed direction.
+func securityGroupRuleUse(securityGroupIDAttrName, directionAttrName string) quotaUse {
+	return func(request preflight.Request) (string, float64, bool) {
+		if !request.IsCreate {
+			return "", 0, false
+		}
+
+		securityGroupID, ok := request.Plan.GetString(securityGroupIDAttrName)
+		if !ok {
+			return "", 0, false
+		}
+
+		direction := directionAttrName
+		if direction != "ingress" && direction != "egress" {
+			if direction, ok = request.Plan.GetString(directionAttrName); !ok {
+				return "", 0, false
+			}
+		}
+
+		return securityGroupID + " " + direction, 1, true
+	}
+}
+
+// lambdaReservedConcurrencyUse consumes a new function's reserved concurrent executions.
+func lambdaReservedConcurrencyUse(request preflight.Request) (string, float64, bool) {
+	if !request.IsCreate {
+		return "", 0, false
+	}
+
+	n, ok := request.Plan.GetInt64("reserved_concurrent_executions")
+	if !ok || n <= 0 {
+		return "", 0, false
+	}
+
+	return "", float64(n), true
+}
+
+func vpcsPerRegionLookup(ctx context.Context, meta *conns.AWSClient, _ string) (float64, float64, error) {
+	limit, err := findAppliedServiceQuotaValue(ctx, meta.ServiceQuotasClient(ctx), "vpc", quotaCodeVPCsPerRegion)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var n int
+	pages := ec2.NewDescribeVpcsPaginator(meta.EC2Client(ctx), &ec2.DescribeVpcsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		n += len(page.Vpcs)
+	}
+
+	return limit, float64(n), nil
+}
+
+func elasticIPsLookup(ctx context.Context, meta *conns.AWSClient, _ string) (float64, float64, error) {
+	limit, err := findAppliedServiceQuotaValue(ctx, meta.ServiceQuotasClient(ctx), "ec2", quotaCodeElasticIPs)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	output, err := meta.EC2Client(ctx).DescribeAddresses(ctx, &ec2.DescribeAddressesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("domain"),
+				Values: []string{string(ec2types.DomainTypeVpc)},
+			},
+		},
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return limit, float64(len(output.Addresses)), nil
+}
+
+func rulesPerSecurityGroupLookup(ctx context.Context, meta *conns.AWSClient, scope string) (float64, float64, error) {
+	securityGroupID, direction, _ := strings.Cut(scope, " ")
+
+	limit, err := findAppliedServiceQuotaValue(ctx, meta.ServiceQuotasClient(ctx), "vpc", quotaCodeRulesPerSecurityGroup)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var n int
+	pages := ec2.NewDescribeSecurityGroupRulesPaginator(meta.EC2Client(ctx), &ec2.DescribeSecurityGroupRulesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("group-id"),
+				Values: []string{securityGroupID},
+			},
+		},
+	})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		for _, v := range page.SecurityGroupRules {
+			if aws.ToBool(v.IsEgress) == (direction == "egress") {
+				n++
+			}
+		}
+	}
+
+	return limit, float64(n), nil
+}
+
+func lambdaConcurrentExecutionsLookup(ctx context.Context, meta *conns.AWSClient, _ string) (float64, float64, error) {
+	output, err := meta.LambdaClient(ctx).GetAccountSettings(ctx, &lambda.GetAccountSettingsInput{})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if output.AccountLimit == nil {
+		return 0, 0, tfresource.NewEmptyResultError(nil)
+	}
+
+	limit := float64(output.AccountLimit.ConcurrentExecutions - lambdaMinimumUnreservedConcurrentExecutions)
+	reserved := float64(output.AccountLimit.ConcurrentExecutions - aws.ToInt32(output.AccountLimit.UnreservedConcurrentExecutions))
+
+	return limit, reserved, nil
+}
+
+// findAppliedServiceQuotaValue returns the applied value of the specified quota, or its default value if no value has been applied.
+func findAppliedServiceQuotaValue(ctx context.Context, conn *servicequotas.Client, serviceCode, quotaCode string) (float64, error) {
+	output, err := findServiceQuotaByID(ctx, conn, serviceCode, quotaCode)
+
+	if tfresource.NotFound(err) {
+		output, err = findServiceQuotaDefaultByID(ctx, conn, serviceCode, quotaCode)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return aws.ToFloat64(output.Value), nil
+}
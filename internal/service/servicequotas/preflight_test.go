@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicequotas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/preflight"
+)
+
+type testValues map[string]any
+
+func (v testValues) GetInt64(name string) (int64, bool) {
+	n, ok := v[name].(int64)
+	return n, ok
+}
+
+func (v testValues) GetString(name string) (string, bool) {
+	s, ok := v[name].(string)
+	return s, ok
+}
+
+func (v testValues) HasChange(name string) bool {
+	return true
+}
+
+func TestPlannedQuotaUsageAdd(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	usage := newPlannedQuotaUsage()
+
+	var lookups int
+	lookup := func(context.Context) (float64, float64, error) {
+		lookups++
+		return 5, 3, nil
+	}
+
+	for i, want := range []float64{1, 2, 3} {
+		got, err := usage.add(ctx, "key", 1, lookup)
+		if err != nil {
+			t.Fatalf("add %d: %s", i, err)
+		}
+
+		if got.limit != 5 || got.current != 3 || got.planned != want {
+			t.Errorf("add %d = %+v, want limit 5, current 3, planned %g", i, got, want)
+		}
+	}
+
+	if _, err := usage.add(ctx, "other", 2, lookup); err != nil {
+		t.Fatalf("add other: %s", err)
+	}
+
+	if lookups != 2 {
+		t.Errorf("lookups = %d, want 2", lookups)
+	}
+}
+
+func TestQuotaUses(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		use           quotaUse
+		request       preflight.Request
+		expectedScope string
+		expectedUnits float64
+		expectedOK    bool
+	}{
+		"create": {
+			use:           createUse,
+			request:       preflight.Request{IsCreate: true},
+			expectedUnits: 1,
+			expectedOK:    true,
+		},
+		"update": {
+			use:     createUse,
+			request: preflight.Request{},
+		},
+		"security group rule": {
+			use: securityGroupRuleUse("security_group_id", "type"),
+			request: preflight.Request{
+				IsCreate: true,
+				Plan: testValues{
+					"security_group_id": "sg-12345678",
+					"type":              "egress",
+				},
+			},
+			expectedScope: "sg-12345678 egress",
+			expectedUnits: 1,
+			expectedOK:    true,
+		},
+		"security group ingress rule": {
+			use: securityGroupRuleUse("security_group_id", "ingress"),
+			request: preflight.Request{
+				IsCreate: true,
+				Plan: testValues{
+					"security_group_id": "sg-12345678",
+				},
+			},
+			expectedScope: "sg-12345678 ingress",
+			expectedUnits: 1,
+			expectedOK:    true,
+		},
+		"security group ID unknown": {
+			use: securityGroupRuleUse("security_group_id", "ingress"),
+			request: preflight.Request{
+				IsCreate: true,
+				Plan:     testValues{},
+			},
+		},
+		"Lambda reserved concurrency": {
+			use: lambdaReservedConcurrencyUse,
+			request: preflight.Request{
+				IsCreate: true,
+				Plan: testValues{
+					"reserved_concurrent_executions": int64(50),
+				},
+			},
+			expectedUnits: 50,
+			expectedOK:    true,
+		},
+		"Lambda unreserved concurrency": {
+			use: lambdaReservedConcurrencyUse,
+			request: preflight.Request{
+				IsCreate: true,
+				Plan: testValues{
+					"reserved_concurrent_executions": int64(-1),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			scope, n, ok := testCase.use(testCase.request)
+
+			if ok != testCase.expectedOK {
+				t.Fatalf("ok = %t, want %t", ok, testCase.expectedOK)
+			}
+			if !ok {
+				return
+			}
+			if scope != testCase.expectedScope {
+				t.Errorf("scope = %q, want %q", scope, testCase.expectedScope)
+			}
+			if n != testCase.expectedUnits {
+				t.Errorf("units = %g, want %g", n, testCase.expectedUnits)
+			}
+		})
+	}
+}
@@ -4,37 +4,40 @@
 package ses
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ses"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ses/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
-	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_ses_configuration_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_configuration_set", &resource.Sweeper{
 		Name: "aws_ses_configuration_set",
 		F:    sweepConfigurationSets,
 	})
 
-	resource.AddTestSweepers("aws_ses_domain_identity", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_domain_identity", &resource.Sweeper{
 		Name: "aws_ses_domain_identity",
-		F:    func(region string) error { return sweepIdentities(region, string(awstypes.IdentityTypeDomain)) },
+		F:    func(region string) error { return sweepIdentities(region, awstypes.IdentityTypeDomain) },
 	})
 
-	resource.AddTestSweepers("aws_ses_email_identity", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_email_identity", &resource.Sweeper{
 		Name: "aws_ses_email_identity",
-		F:    func(region string) error { return sweepIdentities(region, string(awstypes.IdentityTypeEmailAddress)) },
+		F:    func(region string) error { return sweepIdentities(region, awstypes.IdentityTypeEmailAddress) },
 	})
 
-	resource.AddTestSweepers("aws_ses_receipt_rule_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_receipt_rule_set", &resource.Sweeper{
 		Name: "aws_ses_receipt_rule_set",
 		F:    sweepReceiptRuleSets,
 	})
@@ -48,35 +51,26 @@ func sweepConfigurationSets(region string) error {
 	}
 	conn := client.SESClient(ctx)
 	input := &ses.ListConfigurationSetsInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	for {
 		output, err := conn.ListConfigurationSets(ctx, input)
+
 		if awsv2.SkipSweepError(err) {
 			log.Printf("[WARN] Skipping SES Configuration Sets sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+			return nil
 		}
+
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("retrieving SES Configuration Sets: %w", err))
-			return sweeperErrs
+			return fmt.Errorf("listing SES Configuration Sets (%s): %w", region, err)
 		}
 
-		for _, configurationSet := range output.ConfigurationSets {
-			name := aws.ToString(configurationSet.Name)
+		for _, v := range output.ConfigurationSets {
+			r := resourceConfigurationSet()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.Name))
 
-			log.Printf("[INFO] Deleting SES Configuration Set: %s", name)
-			_, err := conn.DeleteConfigurationSet(ctx, &ses.DeleteConfigurationSetInput{
-				ConfigurationSetName: aws.String(name),
-			})
-			if errs.IsA[*awstypes.ConfigurationSetDoesNotExistException](err) {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("deleting SES Configuration Set (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.ToString(output.NextToken) == "" {
@@ -85,10 +79,16 @@ func sweepConfigurationSets(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping SES Configuration Sets (%s): %w", region, err)
+	}
+
+	return nil
 }
 
-func sweepIdentities(region, identityType string) error {
+func sweepIdentities(region string, identityType awstypes.IdentityType) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
@@ -96,41 +96,44 @@ func sweepIdentities(region, identityType string) error {
 	}
 	conn := client.SESClient(ctx)
 	input := &ses.ListIdentitiesInput{
-		IdentityType: awstypes.IdentityType(identityType),
+		IdentityType: identityType,
 	}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
-	paginator := ses.NewListIdentitiesPaginator(conn, input)
+	pages := ses.NewListIdentitiesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping SES Identities sweep for %s: %s", region, err)
+			return nil
+		}
 
-	for paginator.HasMorePages() {
-		output, err := paginator.NextPage(ctx)
 		if err != nil {
-			log.Printf("[ERROR] %s", sweeperErrs)
+			return fmt.Errorf("listing SES Identities (%s): %w", region, err)
 		}
 
-		for _, identity := range output.Identities {
-			log.Printf("[INFO] Deleting SES Identity: %s", identity)
-			_, err = conn.DeleteIdentity(ctx, &ses.DeleteIdentityInput{
-				Identity: aws.String(identity),
-			})
-			if err != nil {
-				sweeperErr := fmt.Errorf("deleting SES Identity (%s): %w", identity, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
+		for _, v := range page.Identities {
+			var r *schema.Resource
+			if identityType == awstypes.IdentityTypeDomain {
+				r = resourceDomainIdentity()
+			} else {
+				r = resourceEmailIdentity()
 			}
+			d := r.Data(nil)
+			d.SetId(v)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 	}
 
-	if awsv2.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping SES Identities sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-	}
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("retrieving SES Identities: %w", err))
+		return fmt.Errorf("sweeping SES Identities (%s): %w", region, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return nil
 }
 
 func sweepReceiptRuleSets(region string) error {
@@ -141,49 +144,46 @@ func sweepReceiptRuleSets(region string) error {
 	}
 	conn := client.SESClient(ctx)
 
-	// You cannot delete the receipt rule set that is currently active.
-	// Setting the name of the active receipt rule set to null disables all email receiving.
-	log.Printf("[INFO] Disabling any currently active SES Receipt Rule Set")
-	_, err = conn.SetActiveReceiptRuleSet(ctx, &ses.SetActiveReceiptRuleSetInput{})
+	output, err := conn.DescribeActiveReceiptRuleSet(ctx, &ses.DescribeActiveReceiptRuleSetInput{})
+
 	// In some regions, this will return "InvalidAction" with no message
 	if awsv2.SkipSweepError(err) || tfawserr.ErrCodeEquals(err, "InvalidAction") {
 		log.Printf("[WARN] Skipping SES Receipt Rule Sets sweep for %s: %s", region, err)
 		return nil
 	}
+
 	if err != nil {
-		return fmt.Errorf("disabling any currently active SES Receipt Rule Set: %w", err)
+		return fmt.Errorf("reading active SES Receipt Rule Set (%s): %w", region, err)
+	}
+
+	var activeRuleSetName string
+	if output.Metadata != nil {
+		activeRuleSetName = aws.ToString(output.Metadata.Name)
 	}
 
 	input := &ses.ListReceiptRuleSetsInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	for {
 		output, err := conn.ListReceiptRuleSets(ctx, input)
+
 		if awsv2.SkipSweepError(err) {
 			log.Printf("[WARN] Skipping SES Receipt Rule Sets sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+			return nil
 		}
+
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("retrieving SES Receipt Rule Sets: %w", err))
-			return sweeperErrs
+			return fmt.Errorf("listing SES Receipt Rule Sets (%s): %w", region, err)
 		}
 
-		for _, ruleSet := range output.RuleSets {
-			name := aws.ToString(ruleSet.Name)
+		for _, v := range output.RuleSets {
+			name := aws.ToString(v.Name)
 
-			log.Printf("[INFO] Deleting SES Receipt Rule Set: %s", name)
-			_, err := conn.DeleteReceiptRuleSet(ctx, &ses.DeleteReceiptRuleSetInput{
-				RuleSetName: aws.String(name),
+			sweepResources = append(sweepResources, &receiptRuleSetSweeper{
+				conn:    conn,
+				ruleSet: v,
+				active:  name == activeRuleSetName,
 			})
-			if errs.IsA[*awstypes.RuleSetDoesNotExistException](err) {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("deleting SES Receipt Rule Set (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
 		}
 
 		if aws.ToString(output.NextToken) == "" {
@@ -192,5 +192,54 @@ func sweepReceiptRuleSets(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping SES Receipt Rule Sets (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+// receiptRuleSetSweeper deletes an SES Receipt Rule Set, first disabling it if it is active.
+type receiptRuleSetSweeper struct {
+	conn    *ses.Client
+	ruleSet awstypes.ReceiptRuleSetMetadata
+	active  bool
+}
+
+func (s *receiptRuleSetSweeper) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	name := aws.ToString(s.ruleSet.Name)
+
+	if s.active {
+		// You cannot delete the receipt rule set that is currently active.
+		// Setting the name of the active receipt rule set to null disables all email receiving.
+		log.Printf("[INFO] Disabling active SES Receipt Rule Set: %s", name)
+		if _, err := s.conn.SetActiveReceiptRuleSet(ctx, &ses.SetActiveReceiptRuleSetInput{}); err != nil {
+			return fmt.Errorf("disabling active SES Receipt Rule Set (%s): %w", name, err)
+		}
+	}
+
+	log.Printf("[INFO] Deleting SES Receipt Rule Set: %s", name)
+	_, err := s.conn.DeleteReceiptRuleSet(ctx, &ses.DeleteReceiptRuleSetInput{
+		RuleSetName: aws.String(name),
+	})
+
+	if errs.IsA[*awstypes.RuleSetDoesNotExistException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting SES Receipt Rule Set (%s): %w", name, err)
+	}
+
+	return nil
+}
+
+func (s *receiptRuleSetSweeper) Metadata(ctx context.Context, refresh bool) (sweep.Metadata, error) {
+	return sweep.Metadata{
+		ID:        aws.ToString(s.ruleSet.Name),
+		Name:      aws.ToString(s.ruleSet.Name),
+		CreatedAt: aws.ToTime(s.ruleSet.CreatedTimestamp),
+	}, nil
 }
@@ -54,6 +54,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Identity: types.RegionalARNIdentity(names.AttrARN),
 		},
 		{
 			Factory:  resourceTopicDataProtectionPolicy,
@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs_test
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// TestQueue_fakeLifecycle exercises the queue's CRUD logic, waiters and flatteners against an in-process fake SQS.
+func TestQueue_fakeLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	backend := fakeaws.New()
+	queues := newFakeQueues(backend)
+	resourceName := "aws_sqs_queue.test"
+
+	acctest.FakeTest(ctx, t, backend, resource.TestCase{
+		CheckDestroy: func(*terraform.State) error {
+			if n := queues.len(); n != 0 {
+				return fmt.Errorf("%d SQS Queues still exist", n)
+			}
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testQueueConfig_fake("key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrARN, "arn:aws:sqs:us-west-2:123456789012:tf-fake"), //lintignore:AWSAT003,AWSAT005
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, "tf-fake"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrURL, "https://sqs.us-west-2.amazonaws.com/123456789012/tf-fake"), //lintignore:AWSAT003
+					resource.TestCheckResourceAttr(resourceName, "visibility_timeout_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config: testQueueConfig_fake("key1", "value1updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+				),
+			},
+		},
+	})
+}
+
+// fakeQueues is a minimal stateful fake of the SQS queue API.
+type fakeQueues struct {
+	mutex      sync.Mutex
+	attributes map[string]map[string]string
+	tags       map[string]map[string]string
+}
+
+func newFakeQueues(backend *fakeaws.Backend) *fakeQueues {
+	q := &fakeQueues{
+		attributes: make(map[string]map[string]string),
+		tags:       make(map[string]map[string]string),
+	}
+
+	backend.Handle("SQS", "CreateQueue", q.createQueue)
+	backend.Handle("SQS", "DeleteQueue", q.deleteQueue)
+	backend.Handle("SQS", "GetQueueAttributes", q.getQueueAttributes)
+	backend.Handle("SQS", "ListQueueTags", q.listQueueTags)
+	backend.Handle("SQS", "TagQueue", q.tagQueue)
+	backend.Handle("SQS", "UntagQueue", q.untagQueue)
+
+	return q
+}
+
+type fakeQueueInput struct {
+	Attributes map[string]string
+	QueueName  string
+	QueueURL   string `json:"QueueUrl"`
+	TagKeys    []string
+	Tags       map[string]string
+}
+
+func (q *fakeQueues) len() int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	return len(q.attributes)
+}
+
+func (q *fakeQueues) createQueue(r *fakeaws.Request) *fakeaws.Response {
+	var input fakeQueueInput
+	if err := r.DecodeJSON(&input); err != nil {
+		return fakeaws.JSONErrorResponse(http.StatusBadRequest, "InvalidParameterValue", err.Error())
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	url := "https://sqs.us-west-2.amazonaws.com/" + fakeaws.DefaultAccountID + "/" + input.QueueName //lintignore:AWSAT003
+	attributes := maps.Clone(input.Attributes)
+	if attributes == nil {
+		attributes = make(map[string]string)
+	}
+	attributes["QueueArn"] = "arn:aws:sqs:us-west-2:" + fakeaws.DefaultAccountID + ":" + input.QueueName //lintignore:AWSAT003,AWSAT005
+	q.attributes[url] = attributes
+	q.tags[url] = maps.Clone(input.Tags)
+
+	return fakeaws.JSONResponse(map[string]any{
+		"QueueUrl": url,
+	})
+}
+
+func (q *fakeQueues) deleteQueue(r *fakeaws.Request) *fakeaws.Response {
+	return q.withQueue(r, func(input fakeQueueInput) any {
+		delete(q.attributes, input.QueueURL)
+		delete(q.tags, input.QueueURL)
+
+		return struct{}{}
+	})
+}
+
+func (q *fakeQueues) getQueueAttributes(r *fakeaws.Request) *fakeaws.Response {
+	return q.withQueue(r, func(input fakeQueueInput) any {
+		return map[string]any{
+			"Attributes": q.attributes[input.QueueURL],
+		}
+	})
+}
+
+func (q *fakeQueues) listQueueTags(r *fakeaws.Request) *fakeaws.Response {
+	return q.withQueue(r, func(input fakeQueueInput) any {
+		return map[string]any{
+			"Tags": q.tags[input.QueueURL],
+		}
+	})
+}
+
+func (q *fakeQueues) tagQueue(r *fakeaws.Request) *fakeaws.Response {
+	return q.withQueue(r, func(input fakeQueueInput) any {
+		if q.tags[input.QueueURL] == nil {
+			q.tags[input.QueueURL] = make(map[string]string)
+		}
+		maps.Copy(q.tags[input.QueueURL], input.Tags)
+
+		return struct{}{}
+	})
+}
+
+func (q *fakeQueues) untagQueue(r *fakeaws.Request) *fakeaws.Response {
+	return q.withQueue(r, func(input fakeQueueInput) any {
+		for _, k := range input.TagKeys {
+			delete(q.tags[input.QueueURL], k)
+		}
+
+		return struct{}{}
+	})
+}
+
+// withQueue decodes the request and calls f if the specified queue exists.
+func (q *fakeQueues) withQueue(r *fakeaws.Request, f func(fakeQueueInput) any) *fakeaws.Response {
+	var input fakeQueueInput
+	if err := r.DecodeJSON(&input); err != nil {
+		return fakeaws.JSONErrorResponse(http.StatusBadRequest, "InvalidParameterValue", err.Error())
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if _, ok := q.attributes[input.QueueURL]; !ok {
+		return fakeaws.JSONErrorResponse(http.StatusBadRequest, "QueueDoesNotExist", "The specified queue does not exist.").
+			WithHeader("x-amzn-query-error", "AWS.SimpleQueueService.NonExistentQueue;Sender")
+	}
+
+	return fakeaws.JSONResponse(f(input))
+}
+
+func testQueueConfig_fake(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name                       = "tf-fake"
+  visibility_timeout_seconds = 60
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
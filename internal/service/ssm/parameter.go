@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -72,7 +73,7 @@ func resourceParameter() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ExactlyOneOf: []string{"insecure_value", names.AttrValue},
+				ExactlyOneOf: []string{"insecure_value", names.AttrValue, "value_wo"},
 			},
 			names.AttrKeyID: {
 				Type:     schema.TypeString,
@@ -114,8 +115,10 @@ func resourceParameter() *schema.Resource {
 				Optional:     true,
 				Sensitive:    true,
 				Computed:     true,
-				ExactlyOneOf: []string{"insecure_value", names.AttrValue},
+				ExactlyOneOf: []string{"insecure_value", names.AttrValue, "value_wo"},
 			},
+			"value_wo":         sdkv2.WriteOnlyStringSchema("value_wo", "insecure_value", names.AttrValue),
+			"value_wo_version": sdkv2.WriteOnlyVersionSchema("value_wo"),
 			names.AttrVersion: {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -129,7 +132,7 @@ func resourceParameter() *schema.Resource {
 				return awstypes.ParameterTier(old.(string)) == awstypes.ParameterTierAdvanced && awstypes.ParameterTier(new.(string)) == awstypes.ParameterTierStandard
 			}),
 			customdiff.ComputedIf(names.AttrVersion, func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
-				return diff.HasChange(names.AttrValue)
+				return diff.HasChanges(names.AttrValue, "value_wo_version")
 			}),
 			customdiff.ComputedIf(names.AttrValue, func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("insecure_value")
@@ -153,6 +156,9 @@ func resourceParameterCreate(ctx context.Context, d *schema.ResourceData, meta i
 	if v, ok := d.Get("insecure_value").(string); ok && v != "" {
 		value = v
 	}
+	if v := sdkv2.GetWriteOnlyString(d, "value_wo"); v != "" {
+		value = v
+	}
 	input := &ssm.PutParameterInput{
 		AllowedPattern: aws.String(d.Get("allowed_pattern").(string)),
 		Name:           aws.String(name),
@@ -250,7 +256,8 @@ func resourceParameterRead(ctx context.Context, d *schema.ResourceData, meta int
 
 	if _, ok := d.GetOk("insecure_value"); ok && param.Type != awstypes.ParameterTypeSecureString {
 		d.Set("insecure_value", param.Value)
-	} else {
+	} else if _, ok := d.GetOk("value_wo_version"); !ok {
+		// The value is never persisted in state when it's written from a write-only argument.
 		d.Set(names.AttrValue, param.Value)
 	}
 
@@ -289,6 +296,10 @@ func resourceParameterUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		if v, ok := d.Get("insecure_value").(string); ok && v != "" {
 			value = v
 		}
+		// The value of a write-only argument is always available from configuration.
+		if v := sdkv2.GetWriteOnlyString(d, "value_wo"); v != "" {
+			value = v
+		}
 		input := &ssm.PutParameterInput{
 			AllowedPattern: aws.String(d.Get("allowed_pattern").(string)),
 			Name:           aws.String(d.Id()),
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sso
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sso"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_sso_role_credentials", name="Role Credentials")
+func newRoleCredentialsEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &roleCredentialsEphemeralResource{}, nil
+}
+
+type roleCredentialsEphemeralResource struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (*roleCredentialsEphemeralResource) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_sso_role_credentials"
+}
+
+func (e *roleCredentialsEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"access_token": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			names.AttrAccountID: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"role_name": schema.StringAttribute{
+				Required: true,
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"session_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *roleCredentialsEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data roleCredentialsEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().SSOClient(ctx)
+
+	accountID, roleName := data.AccountID.ValueString(), data.RoleName.ValueString()
+	input := sso.GetRoleCredentialsInput{
+		AccessToken: fwflex.StringFromFramework(ctx, data.AccessToken),
+		AccountId:   fwflex.StringFromFramework(ctx, data.AccountID),
+		RoleName:    fwflex.StringFromFramework(ctx, data.RoleName),
+	}
+
+	output, err := conn.GetRoleCredentials(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Role Credentials (%s/%s)", accountID, roleName), err.Error())
+
+		return
+	}
+
+	if output.RoleCredentials == nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Role Credentials (%s/%s)", accountID, roleName), "empty result")
+
+		return
+	}
+
+	credentials := output.RoleCredentials
+	data.AccessKeyID = fwflex.StringToFramework(ctx, credentials.AccessKeyId)
+	// Expiration is in milliseconds since the Unix epoch.
+	data.Expiration = timetypes.NewRFC3339TimeValue(time.UnixMilli(credentials.Expiration))
+	data.SecretAccessKey = fwflex.StringToFramework(ctx, credentials.SecretAccessKey)
+	data.SessionToken = fwflex.StringToFramework(ctx, credentials.SessionToken)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type roleCredentialsEphemeralResourceModel struct {
+	AccessKeyID     types.String      `tfsdk:"access_key_id"`
+	AccessToken     types.String      `tfsdk:"access_token"`
+	AccountID       types.String      `tfsdk:"account_id"`
+	Expiration      timetypes.RFC3339 `tfsdk:"expiration"`
+	RoleName        types.String      `tfsdk:"role_name"`
+	SecretAccessKey types.String      `tfsdk:"secret_access_key"`
+	SessionToken    types.String      `tfsdk:"session_token"`
+}
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_sts_assume_role", name="Assume Role")
+func newAssumeRoleEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &assumeRoleEphemeralResource{}, nil
+}
+
+type assumeRoleEphemeralResource struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (*assumeRoleEphemeralResource) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_sts_assume_role"
+}
+
+func (e *assumeRoleEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"assumed_role_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"assumed_role_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(900, 43200),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrExternalID: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 1224),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[\w+=,.@:\/\-]*$`), ""),
+				},
+			},
+			names.AttrPolicy: schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Optional:   true,
+			},
+			"policy_arns": schema.SetAttribute{
+				CustomType: fwtypes.SetOfARNType,
+				Optional:   true,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"role_session_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[\w+=,.@\-]*$`), ""),
+				},
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"session_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"source_identity": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[\w+=,.@\-]*$`), ""),
+				},
+			},
+			names.AttrTags: schema.MapAttribute{
+				CustomType: fwtypes.MapOfStringType,
+				Optional:   true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtMost(50),
+				},
+			},
+			"transitive_tag_keys": schema.SetAttribute{
+				CustomType: fwtypes.SetOfStringType,
+				Optional:   true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(50),
+				},
+			},
+		},
+	}
+}
+
+func (e *assumeRoleEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data assumeRoleEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().STSClient(ctx)
+
+	roleARN := data.RoleARN.ValueString()
+	input := sts.AssumeRoleInput{
+		DurationSeconds:   fwflex.Int32FromFramework(ctx, data.DurationSeconds),
+		ExternalId:        fwflex.StringFromFramework(ctx, data.ExternalID),
+		Policy:            fwflex.StringFromFramework(ctx, data.Policy),
+		RoleArn:           aws.String(roleARN),
+		RoleSessionName:   fwflex.StringFromFramework(ctx, data.RoleSessionName),
+		SourceIdentity:    fwflex.StringFromFramework(ctx, data.SourceIdentity),
+		TransitiveTagKeys: fwflex.ExpandFrameworkStringValueSet(ctx, data.TransitiveTagKeys),
+	}
+
+	for _, v := range fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyARNs) {
+		input.PolicyArns = append(input.PolicyArns, awstypes.PolicyDescriptorType{
+			Arn: aws.String(v),
+		})
+	}
+
+	for k, v := range fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags) {
+		input.Tags = append(input.Tags, awstypes.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	output, err := conn.AssumeRole(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("assuming IAM Role (%s)", roleARN), err.Error())
+
+		return
+	}
+
+	if v := output.AssumedRoleUser; v != nil {
+		data.AssumedRoleARN = fwflex.StringToFramework(ctx, v.Arn)
+		data.AssumedRoleID = fwflex.StringToFramework(ctx, v.AssumedRoleId)
+	}
+	response.Diagnostics.Append(flattenCredentials(ctx, output.Credentials, &data.credentialsModel)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type assumeRoleEphemeralResourceModel struct {
+	credentialsModel
+	AssumedRoleARN    types.String                     `tfsdk:"assumed_role_arn"`
+	AssumedRoleID     types.String                     `tfsdk:"assumed_role_id"`
+	DurationSeconds   types.Int64                      `tfsdk:"duration_seconds"`
+	ExternalID        types.String                     `tfsdk:"external_id"`
+	Policy            fwtypes.IAMPolicy                `tfsdk:"policy"`
+	PolicyARNs        fwtypes.SetValueOf[fwtypes.ARN]  `tfsdk:"policy_arns"`
+	RoleARN           fwtypes.ARN                      `tfsdk:"role_arn"`
+	RoleSessionName   types.String                     `tfsdk:"role_session_name"`
+	SourceIdentity    types.String                     `tfsdk:"source_identity"`
+	Tags              fwtypes.MapOfString              `tfsdk:"tags"`
+	TransitiveTagKeys fwtypes.SetValueOf[types.String] `tfsdk:"transitive_tag_keys"`
+}
+
+// credentialsModel is the temporary security credentials returned by STS.
+type credentialsModel struct {
+	AccessKeyID     types.String      `tfsdk:"access_key_id"`
+	Expiration      timetypes.RFC3339 `tfsdk:"expiration"`
+	SecretAccessKey types.String      `tfsdk:"secret_access_key"`
+	SessionToken    types.String      `tfsdk:"session_token"`
+}
+
+func flattenCredentials(ctx context.Context, apiObject *awstypes.Credentials, data *credentialsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError("flattening STS credentials", "no credentials returned")
+		return diags
+	}
+
+	data.AccessKeyID = fwflex.StringToFramework(ctx, apiObject.AccessKeyId)
+	data.Expiration = timetypes.NewRFC3339TimePointerValue(apiObject.Expiration)
+	data.SecretAccessKey = fwflex.StringToFramework(ctx, apiObject.SecretAccessKey)
+	data.SessionToken = fwflex.StringToFramework(ctx, apiObject.SessionToken)
+
+	return diags
+}
@@ -12,6 +12,21 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) EphemeralResources(ctx context.Context) []*types.ServicePackageEphemeralResource {
+	return []*types.ServicePackageEphemeralResource{
+		{
+			Factory:  newAssumeRoleEphemeralResource,
+			TypeName: "aws_sts_assume_role",
+			Name:     "Assume Role",
+		},
+		{
+			Factory:  newSessionTokenEphemeralResource,
+			TypeName: "aws_sts_session_token",
+			Name:     "Session Token",
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
 		{
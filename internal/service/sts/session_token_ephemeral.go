@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+// @EphemeralResource("aws_sts_session_token", name="Session Token")
+func newSessionTokenEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &sessionTokenEphemeralResource{}, nil
+}
+
+type sessionTokenEphemeralResource struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (*sessionTokenEphemeralResource) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_sts_session_token"
+}
+
+func (e *sessionTokenEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(900, 129600),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"serial_number": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(9, 256),
+					stringvalidator.AlsoRequires(path.MatchRoot("token_code")),
+				},
+			},
+			"session_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"token_code": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9]{6}$`), "must be a 6-digit code"),
+					stringvalidator.AlsoRequires(path.MatchRoot("serial_number")),
+				},
+			},
+		},
+	}
+}
+
+func (e *sessionTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data sessionTokenEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().STSClient(ctx)
+
+	input := sts.GetSessionTokenInput{
+		DurationSeconds: fwflex.Int32FromFramework(ctx, data.DurationSeconds),
+		SerialNumber:    fwflex.StringFromFramework(ctx, data.SerialNumber),
+		TokenCode:       fwflex.StringFromFramework(ctx, data.TokenCode),
+	}
+
+	output, err := conn.GetSessionToken(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("getting STS session token", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenCredentials(ctx, output.Credentials, &data.credentialsModel)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type sessionTokenEphemeralResourceModel struct {
+	credentialsModel
+	DurationSeconds types.Int64  `tfsdk:"duration_seconds"`
+	SerialNumber    types.String `tfsdk:"serial_number"`
+	TokenCode       types.String `tfsdk:"token_code"`
+}
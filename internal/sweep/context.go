@@ -10,6 +10,15 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
 )
 
+type contextKey int
+
+const (
+	regionContextKey contextKey = iota
+	resourceTypeContextKey
+	optionsContextKey
+	orchestratedContextKey
+)
+
 func Context(region string) context.Context {
 	ctx := context.Background()
 
@@ -17,5 +26,43 @@ func Context(region string) context.Context {
 
 	ctx = log.Logger(ctx, "sweeper", region)
 
+	ctx = context.WithValue(ctx, regionContextKey, region)
+
 	return ctx
 }
+
+// WithResourceType returns a new context recording the resource type being swept.
+func WithResourceType(ctx context.Context, resourceType string) context.Context {
+	ctx = log.WithResourceType(ctx, resourceType)
+
+	return context.WithValue(ctx, resourceTypeContextKey, resourceType)
+}
+
+// WithOptions returns a new context with the specified sweeper options.
+// The options override those configured via environment variables.
+func WithOptions(ctx context.Context, options *Options) context.Context {
+	return context.WithValue(ctx, optionsContextKey, options)
+}
+
+func regionFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(regionContextKey).(string)
+
+	return v
+}
+
+func resourceTypeFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(resourceTypeContextKey).(string)
+
+	return v
+}
+
+// withOrchestrated returns a new context recording that resources are being deleted by SweepOrchestrator.
+func withOrchestrated(ctx context.Context) context.Context {
+	return context.WithValue(ctx, orchestratedContextKey, true)
+}
+
+func orchestratedFromContext(ctx context.Context) bool {
+	v, _ := ctx.Value(orchestratedContextKey).(bool)
+
+	return v
+}
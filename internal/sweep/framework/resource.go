@@ -6,20 +6,26 @@ package framework
 import (
 	"context"
 	"math/rand"
+	"slices"
 	"strings"
 	"time"
 
 	awsretry "github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/metadata"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type attribute struct {
@@ -55,25 +61,10 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 		return err
 	}
 
-	metadata := resourceMetadata(ctx, resource)
-	ctx = tflog.SetField(ctx, "resource_type", metadata.TypeName)
+	ctx, state, err := sr.configure(ctx, resource)
 
-	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
-
-	schemaResp := fwresource.SchemaResponse{}
-	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
-
-	state := tfsdk.State{
-		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
-		Schema: schemaResp.Schema,
-	}
-
-	for _, attr := range sr.attributes {
-		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
-		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
-		}
-		ctx = tflog.SetField(ctx, attr.path, attr.value)
+	if err != nil {
+		return err
 	}
 
 	tflog.Info(ctx, "Sweeping resource")
@@ -118,6 +109,122 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// Metadata returns the swept resource's metadata from the attributes set by the sweeper's lister.
+// If refresh is true the resource is read first.
+func (sr *sweepResource) Metadata(ctx context.Context, refresh bool) (metadata.Metadata, error) {
+	resource, err := sr.factory(ctx)
+
+	if err != nil {
+		return metadata.Metadata{}, err
+	}
+
+	md := metadata.Metadata{
+		TypeName: resourceMetadata(ctx, resource).TypeName,
+	}
+
+	if !refresh {
+		for _, a := range sr.attributes {
+			v, ok := a.value.(string)
+			if !ok {
+				continue
+			}
+
+			switch {
+			case a.path == names.AttrID:
+				md.ID = v
+			case a.path == names.AttrName:
+				md.Name = v
+			case slices.Contains(metadata.CreationTimeAttributes, a.path):
+				if t, ok := metadata.ParseCreationTime(v); ok {
+					md.CreatedAt = t
+				}
+			}
+		}
+
+		return md, nil
+	}
+
+	ctx, state, err := sr.configure(ctx, resource)
+
+	if err != nil {
+		return md, err
+	}
+
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+	if response.Diagnostics.HasError() {
+		return md, fwdiag.DiagnosticsError(response.Diagnostics)
+	}
+
+	// The resource has been removed from state, i.e. it no longer exists.
+	if response.State.Raw.IsNull() {
+		return md, nil
+	}
+
+	getString := func(name string) string {
+		if _, ok := state.Schema.GetAttributes()[name]; !ok {
+			return ""
+		}
+
+		var v types.String
+		if d := response.State.GetAttribute(ctx, path.Root(name), &v); d.HasError() {
+			return ""
+		}
+
+		return v.ValueString()
+	}
+
+	md.ID = getString(names.AttrID)
+	md.Name = getString(names.AttrName)
+
+	for _, k := range metadata.CreationTimeAttributes {
+		if t, ok := metadata.ParseCreationTime(getString(k)); ok {
+			md.CreatedAt = t
+			break
+		}
+	}
+
+	if _, ok := state.Schema.GetAttributes()[names.AttrTags]; ok {
+		var v attr.Value
+		if d := response.State.GetAttribute(ctx, path.Root(names.AttrTags), &v); !d.HasError() {
+			if v, ok := v.(basetypes.MapValuable); ok {
+				if v, d := v.ToMapValue(ctx); !d.HasError() && !v.IsNull() && !v.IsUnknown() {
+					md.Tags = make(map[string]string)
+					v.ElementsAs(ctx, &md.Tags, false)
+				}
+			}
+		}
+	}
+
+	return md, nil
+}
+
+// configure configures the resource and returns its initial state, populated from the sweeper's attributes.
+func (sr *sweepResource) configure(ctx context.Context, resource fwresource.ResourceWithConfigure) (context.Context, tfsdk.State, error) {
+	ctx = tflog.SetField(ctx, "resource_type", resourceMetadata(ctx, resource).TypeName)
+
+	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
+
+	schemaResp := fwresource.SchemaResponse{}
+	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		Schema: schemaResp.Schema,
+	}
+
+	for _, attr := range sr.attributes {
+		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
+		if d.HasError() {
+			return ctx, state, fwdiag.DiagnosticsError(d)
+		}
+		ctx = tflog.SetField(ctx, attr.path, attr.value)
+	}
+
+	return ctx, state, nil
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
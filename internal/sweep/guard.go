@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// ErrUnsupported is returned for AWS API calls that would change resources outside SweepOrchestrator
+// while a dry run or filters are configured.
+var ErrUnsupported = errors.New("changing resources outside SweepOrchestrator is unsupported with dry-run or filters")
+
+// readOnlyOperationRegexp matches AWS API operations that do not change resources.
+var readOnlyOperationRegexp = regexp.MustCompile(`^(BatchDescribe|BatchGet|Describe|Filter|Get|Head|List|Lookup|Query|Scan|Search|Select)[A-Z]`)
+
+// guardAPIOption adds middleware that rejects AWS API calls which may change resources unless they are made
+// by SweepOrchestrator deleting a resource that is neither filtered out nor part of a dry run.
+func guardAPIOption(stack *middleware.Stack) error {
+	return stack.Initialize.Add(guardMiddleware{}, middleware.After)
+}
+
+type guardMiddleware struct{}
+
+func (guardMiddleware) ID() string {
+	return "TerraformSweeperGuard"
+}
+
+func (guardMiddleware) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	if operation := awsmiddleware.GetOperationName(ctx); !readOnlyOperationRegexp.MatchString(operation) {
+		options, err := optionsFromContext(ctx)
+		if err != nil {
+			return middleware.InitializeOutput{}, middleware.Metadata{}, err
+		}
+
+		if options.DryRun || (!options.Filters.IsEmpty() && !orchestratedFromContext(ctx)) {
+			return middleware.InitializeOutput{}, middleware.Metadata{}, fmt.Errorf("%s %s: %w", awsmiddleware.GetServiceID(ctx), operation, ErrUnsupported)
+		}
+	}
+
+	return next.HandleInitialize(ctx, in)
+}
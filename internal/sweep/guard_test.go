@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+var errSent = errors.New("request sent")
+
+type testHTTPClient struct{}
+
+func (testHTTPClient) Do(*http.Request) (*http.Response, error) {
+	return nil, errSent
+}
+
+func newGuardedTestClient() *sqs.Client {
+	return sqs.New(sqs.Options{
+		APIOptions:  []func(*middleware.Stack) error{guardAPIOption},
+		Credentials: aws.AnonymousCredentials{},
+		HTTPClient:  testHTTPClient{},
+		Region:      "us-west-2",
+		Retryer:     aws.NopRetryer{},
+	})
+}
+
+type queueSweepable struct {
+	conn *sqs.Client
+	err  error
+}
+
+func (s *queueSweepable) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	_, s.err = s.conn.DeleteQueue(ctx, &sqs.DeleteQueueInput{QueueUrl: aws.String("https://sqs.us-west-2.amazonaws.com/123456789012/" + ResourcePrefix)})
+
+	return nil
+}
+
+func (s *queueSweepable) Metadata(ctx context.Context, refresh bool) (Metadata, error) {
+	return Metadata{TypeName: "aws_sqs_queue", ID: ResourcePrefix, Name: ResourcePrefix}, nil
+}
+
+func TestGuard(t *testing.T) {
+	t.Parallel()
+
+	conn := newGuardedTestClient()
+
+	testCases := map[string]struct {
+		options      Options
+		orchestrated bool
+		expectRead   error
+		expectDelete error
+	}{
+		"no options": {
+			expectRead:   errSent,
+			expectDelete: errSent,
+		},
+		"dry run": {
+			options:      Options{DryRun: true},
+			expectRead:   errSent,
+			expectDelete: ErrUnsupported,
+		},
+		"dry run orchestrated": {
+			options:      Options{DryRun: true},
+			orchestrated: true,
+			expectRead:   errSent,
+			expectDelete: ErrUnsupported,
+		},
+		"filters": {
+			options:      Options{Filters: Filters{NamePrefix: ResourcePrefix}},
+			expectRead:   errSent,
+			expectDelete: ErrUnsupported,
+		},
+		"filters orchestrated": {
+			options:      Options{Filters: Filters{NamePrefix: ResourcePrefix}},
+			orchestrated: true,
+			expectRead:   errSent,
+			expectDelete: errSent,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := WithOptions(context.Background(), &testCase.options)
+			if testCase.orchestrated {
+				ctx = withOrchestrated(ctx)
+			}
+
+			_, err := conn.ListQueues(ctx, &sqs.ListQueuesInput{})
+			if !errors.Is(err, testCase.expectRead) {
+				t.Errorf("ListQueues: got error %v, want %v", err, testCase.expectRead)
+			}
+
+			_, err = conn.DeleteQueue(ctx, &sqs.DeleteQueueInput{QueueUrl: aws.String("https://sqs.us-west-2.amazonaws.com/123456789012/test")})
+			if !errors.Is(err, testCase.expectDelete) {
+				t.Errorf("DeleteQueue: got error %v, want %v", err, testCase.expectDelete)
+			}
+		})
+	}
+}
+
+func TestGuardSweepOrchestrator(t *testing.T) {
+	t.Parallel()
+
+	sweepable := &queueSweepable{conn: newGuardedTestClient()}
+	ctx := WithOptions(context.Background(), &Options{Filters: Filters{NamePrefix: ResourcePrefix}})
+
+	if err := SweepOrchestrator(ctx, []Sweepable{sweepable}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !errors.Is(sweepable.err, errSent) {
+		t.Errorf("DeleteQueue: got error %v, want %v", sweepable.err, errSent)
+	}
+}
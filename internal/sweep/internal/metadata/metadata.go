@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metadata
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Metadata describes a resource to be swept.
+// Zero values indicate that the corresponding value is unknown.
+type Metadata struct {
+	TypeName string
+	ID       string
+	Name     string
+	// Tags is nil if the resource's tags are unknown.
+	Tags      map[string]string
+	CreatedAt time.Time
+}
+
+// CreationTimeAttributes are the names of the attributes commonly used to record a resource's creation time.
+var CreationTimeAttributes = []string{
+	names.AttrCreateTime,
+	names.AttrCreatedAt,
+	names.AttrCreatedDate,
+	names.AttrCreatedTime,
+	names.AttrCreationDate,
+	names.AttrCreationTime,
+}
+
+// ParseCreationTime parses a creation time attribute value.
+func ParseCreationTime(s string) (time.Time, bool) {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return t, true
+}
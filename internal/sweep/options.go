@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+// Options configures how SweepOrchestrator deletes resources.
+type Options struct {
+	// Concurrency is the maximum number of resources of a single type deleted concurrently.
+	// Zero means unbounded.
+	Concurrency int
+	// DryRun lists the resources that would be deleted without deleting them.
+	DryRun  bool
+	Filters Filters
+	// Report, if not nil, records the resources that were (or would be) deleted.
+	Report *Report
+}
+
+// Filters restrict the resources that are swept.
+// A resource is swept only if it matches all configured filters.
+type Filters struct {
+	MinimumAge time.Duration
+	NamePrefix string
+	TagKey     string
+	// TagValue is only used if TagKey is set.
+	TagValue string
+}
+
+// IsEmpty returns whether no filters are configured.
+func (f Filters) IsEmpty() bool {
+	return f.MinimumAge == 0 && f.NamePrefix == "" && f.TagKey == ""
+}
+
+// match returns whether the resource matches the filters.
+// known is false if the resource's metadata is insufficient to determine a match.
+func (f Filters) match(md Metadata, now time.Time) (matched bool, known bool) {
+	if f.NamePrefix != "" {
+		if md.Name == "" {
+			return false, false
+		}
+
+		if !strings.HasPrefix(md.Name, f.NamePrefix) {
+			return false, true
+		}
+	}
+
+	if f.TagKey != "" {
+		if md.Tags == nil {
+			return false, false
+		}
+
+		v, ok := md.Tags[f.TagKey]
+		if !ok || (f.TagValue != "" && v != f.TagValue) {
+			return false, true
+		}
+	}
+
+	if f.MinimumAge != 0 {
+		if md.CreatedAt.IsZero() {
+			return false, false
+		}
+
+		if now.Sub(md.CreatedAt) < f.MinimumAge {
+			return false, true
+		}
+	}
+
+	return true, true
+}
+
+// OptionsFromEnv returns the sweeper options configured via environment variables.
+func OptionsFromEnv() (*Options, error) {
+	options := &Options{
+		Filters: Filters{
+			NamePrefix: os.Getenv(envvar.SweepNamePrefix),
+			TagKey:     os.Getenv(envvar.SweepTagKey),
+			TagValue:   os.Getenv(envvar.SweepTagValue),
+		},
+	}
+
+	if v := os.Getenv(envvar.SweepConcurrency); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("environment variable %s: invalid concurrency %q", envvar.SweepConcurrency, v)
+		}
+		options.Concurrency = n
+	}
+
+	if v := os.Getenv(envvar.SweepDryRun); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepDryRun, err)
+		}
+		options.DryRun = b
+	}
+
+	if v := os.Getenv(envvar.SweepMinimumAge); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepMinimumAge, err)
+		}
+		options.Filters.MinimumAge = d
+	}
+
+	if v := os.Getenv(envvar.SweepReport); v != "" {
+		options.Report = NewReport(v)
+	}
+
+	return options, nil
+}
+
+// defaultOptions are loaded once so that all sweepers share a single report.
+var defaultOptions = sync.OnceValues(OptionsFromEnv)
+
+// SetDefaultOptions sets the sweeper options used when none are set in the context,
+// overriding those configured via environment variables.
+// It must be called before any sweepers are run.
+func SetDefaultOptions(options *Options) {
+	defaultOptions = func() (*Options, error) {
+		return options, nil
+	}
+}
+
+// optionsFromContext returns the sweeper options set in the context or, if none are set, the default options.
+func optionsFromContext(ctx context.Context) (*Options, error) {
+	if v, ok := ctx.Value(optionsContextKey).(*Options); ok && v != nil {
+		return v, nil
+	}
+
+	return defaultOptions()
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+func TestFiltersMatch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		filters         Filters
+		metadata        Metadata
+		expectedMatched bool
+		expectedKnown   bool
+	}{
+		"no filters": {
+			expectedMatched: true,
+			expectedKnown:   true,
+		},
+		"name prefix matches": {
+			filters:         Filters{NamePrefix: ResourcePrefix},
+			metadata:        Metadata{Name: ResourcePrefix + "-123"},
+			expectedMatched: true,
+			expectedKnown:   true,
+		},
+		"name prefix does not match": {
+			filters:       Filters{NamePrefix: ResourcePrefix},
+			metadata:      Metadata{Name: "production"},
+			expectedKnown: true,
+		},
+		"name unknown": {
+			filters: Filters{NamePrefix: ResourcePrefix},
+		},
+		"tag key matches": {
+			filters:         Filters{TagKey: "Owner"},
+			metadata:        Metadata{Tags: map[string]string{"Owner": "ci"}},
+			expectedMatched: true,
+			expectedKnown:   true,
+		},
+		"tag value does not match": {
+			filters:       Filters{TagKey: "Owner", TagValue: "ci"},
+			metadata:      Metadata{Tags: map[string]string{"Owner": "me"}},
+			expectedKnown: true,
+		},
+		"no tags": {
+			filters:       Filters{TagKey: "Owner"},
+			metadata:      Metadata{Tags: map[string]string{}},
+			expectedKnown: true,
+		},
+		"tags unknown": {
+			filters: Filters{TagKey: "Owner"},
+		},
+		"old enough": {
+			filters:         Filters{MinimumAge: 24 * time.Hour},
+			metadata:        Metadata{CreatedAt: now.Add(-25 * time.Hour)},
+			expectedMatched: true,
+			expectedKnown:   true,
+		},
+		"too new": {
+			filters:       Filters{MinimumAge: 24 * time.Hour},
+			metadata:      Metadata{CreatedAt: now.Add(-time.Hour)},
+			expectedKnown: true,
+		},
+		"creation time unknown": {
+			filters: Filters{MinimumAge: 24 * time.Hour},
+		},
+		"all filters match": {
+			filters: Filters{NamePrefix: ResourcePrefix, TagKey: "Owner", TagValue: "ci", MinimumAge: time.Hour},
+			metadata: Metadata{
+				Name:      ResourcePrefix + "-123",
+				Tags:      map[string]string{"Owner": "ci"},
+				CreatedAt: now.Add(-2 * time.Hour),
+			},
+			expectedMatched: true,
+			expectedKnown:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			matched, known := testCase.filters.match(testCase.metadata, now)
+
+			if got, want := matched, testCase.expectedMatched; got != want {
+				t.Errorf("matched = %t, want %t", got, want)
+			}
+			if got, want := known, testCase.expectedKnown; got != want {
+				t.Errorf("known = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestOptionsFromEnv(t *testing.T) { //nolint:paralleltest // Sets environment variables
+	t.Setenv(envvar.SweepConcurrency, "4")
+	t.Setenv(envvar.SweepDryRun, "true")
+	t.Setenv(envvar.SweepMinimumAge, "36h")
+	t.Setenv(envvar.SweepNamePrefix, ResourcePrefix)
+	t.Setenv(envvar.SweepTagKey, "Owner")
+	t.Setenv(envvar.SweepTagValue, "ci")
+
+	got, err := OptionsFromEnv()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := &Options{
+		Concurrency: 4,
+		DryRun:      true,
+		Filters: Filters{
+			MinimumAge: 36 * time.Hour,
+			NamePrefix: ResourcePrefix,
+			TagKey:     "Owner",
+			TagValue:   "ci",
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	t.Setenv(envvar.SweepConcurrency, "-1")
+
+	if _, err := OptionsFromEnv(); err == nil {
+		t.Error("expected error for invalid concurrency")
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testSweepable struct {
+	metadata Metadata
+	// refreshed is returned when the metadata is refreshed.
+	refreshed *Metadata
+	err       error
+	deleted   func(Metadata)
+}
+
+func (s *testSweepable) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	if s.err != nil {
+		return s.err
+	}
+
+	s.deleted(s.metadata)
+
+	return nil
+}
+
+func (s *testSweepable) Metadata(ctx context.Context, refresh bool) (Metadata, error) {
+	if refresh && s.refreshed != nil {
+		return *s.refreshed, nil
+	}
+
+	return s.metadata, nil
+}
+
+type testDeletions struct {
+	mutex sync.Mutex
+	ids   []string
+}
+
+func (d *testDeletions) add(md Metadata) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.ids = append(d.ids, md.ID)
+}
+
+func TestSweepOrchestrator(t *testing.T) { //nolint:paralleltest // Modifies the global sweeper registry
+	saved := sweepers
+	t.Cleanup(func() {
+		sweepers = saved
+	})
+	sweepers = map[string]Sweeper{
+		"test_network_interface": {Sweeper: &resource.Sweeper{Name: "test_network_interface"}},
+		"test_subnet":            {Sweeper: &resource.Sweeper{Name: "test_subnet", Dependencies: []string{"test_network_interface"}}},
+		"test_vpc":               {Sweeper: &resource.Sweeper{Name: "test_vpc", Dependencies: []string{"test_subnet"}}},
+	}
+
+	newSweepables := func(deletions *testDeletions) []Sweepable {
+		return []Sweepable{
+			&testSweepable{metadata: Metadata{TypeName: "test_vpc", ID: "vpc-1", Name: ResourcePrefix + "-vpc"}, deleted: deletions.add},
+			&testSweepable{metadata: Metadata{TypeName: "test_subnet", ID: "subnet-1", Name: "production"}, deleted: deletions.add},
+			&testSweepable{
+				metadata:  Metadata{TypeName: "test_network_interface", ID: "eni-1"},
+				refreshed: &Metadata{TypeName: "test_network_interface", ID: "eni-1", Name: ResourcePrefix + "-eni"},
+				deleted:   deletions.add,
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		options           Options
+		expectedDeletions []string
+		expectedActions   map[string]string
+	}{
+		"dependency order": {
+			expectedDeletions: []string{"eni-1", "subnet-1", "vpc-1"},
+			expectedActions: map[string]string{
+				"eni-1":    ReportActionDeleted,
+				"subnet-1": ReportActionDeleted,
+				"vpc-1":    ReportActionDeleted,
+			},
+		},
+		"dry run": {
+			options: Options{DryRun: true},
+			expectedActions: map[string]string{
+				"eni-1":    ReportActionWouldDelete,
+				"subnet-1": ReportActionWouldDelete,
+				"vpc-1":    ReportActionWouldDelete,
+			},
+		},
+		"name prefix": {
+			options:           Options{Filters: Filters{NamePrefix: ResourcePrefix}, Concurrency: 1},
+			expectedDeletions: []string{"eni-1", "vpc-1"},
+			expectedActions: map[string]string{
+				"eni-1":    ReportActionDeleted,
+				"subnet-1": ReportActionSkipped,
+				"vpc-1":    ReportActionDeleted,
+			},
+		},
+	}
+
+	for name, testCase := range testCases { //nolint:paralleltest // Modifies the global sweeper registry
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "report.json")
+			options := testCase.options
+			options.Report = NewReport(path)
+			ctx := WithOptions(Context("us-west-2"), &options) //lintignore:AWSAT003
+			var deletions testDeletions
+
+			if err := SweepOrchestrator(ctx, newSweepables(&deletions)); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(deletions.ids, testCase.expectedDeletions); diff != "" {
+				t.Errorf("unexpected deletions diff (+wanted, -got): %s", diff)
+			}
+
+			b, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("reading report: %s", err)
+			}
+
+			var report struct {
+				Resources []ReportEntry `json:"resources"`
+			}
+			if err := json.Unmarshal(b, &report); err != nil {
+				t.Fatalf("decoding report: %s", err)
+			}
+
+			actions := make(map[string]string)
+			for _, v := range report.Resources {
+				if v.Region != "us-west-2" { //lintignore:AWSAT003
+					t.Errorf("unexpected region %q for %s", v.Region, v.ID)
+				}
+				actions[v.ID] = v.Action
+			}
+
+			if diff := cmp.Diff(actions, testCase.expectedActions); diff != "" {
+				t.Errorf("unexpected report diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSweepOrchestratorError(t *testing.T) {
+	t.Parallel()
+
+	options := Options{Report: NewReport("")}
+	ctx := WithOptions(Context("us-west-2"), &options) //lintignore:AWSAT003
+	sweepables := []Sweepable{
+		&testSweepable{metadata: Metadata{ID: "1"}, err: errors.New("failed")},
+		&testSweepable{metadata: Metadata{ID: "2"}, deleted: func(Metadata) {}},
+	}
+
+	if err := SweepOrchestrator(ctx, sweepables); err == nil {
+		t.Fatal("expected error")
+	}
+
+	actions := make(map[string]string)
+	for _, v := range options.Report.Entries() {
+		actions[v.ID] = v.Action
+	}
+
+	if diff := cmp.Diff(actions, map[string]string{"1": ReportActionFailed, "2": ReportActionDeleted}); diff != "" {
+		t.Errorf("unexpected report diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestSweepOrchestratorConcurrency(t *testing.T) {
+	t.Parallel()
+
+	const concurrency = 2
+
+	var mutex sync.Mutex
+	var current, maximum int
+
+	sweepables := make([]Sweepable, 0)
+	for range 10 {
+		sweepables = append(sweepables, &testSweepable{deleted: func(Metadata) {
+			mutex.Lock()
+			current++
+			maximum = max(maximum, current)
+			mutex.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			mutex.Lock()
+			current--
+			mutex.Unlock()
+		}})
+	}
+
+	ctx := WithOptions(Context("us-west-2"), &Options{Concurrency: concurrency}) //lintignore:AWSAT003
+
+	if err := SweepOrchestrator(ctx, sweepables); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if maximum > concurrency {
+		t.Errorf("maximum concurrent deletions = %d, want at most %d", maximum, concurrency)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+)
+
+// Sweeper is a registered sweeper.
+type Sweeper struct {
+	*resource.Sweeper
+	// ServicePackage is the name of the service package that registered the sweeper.
+	ServicePackage string
+}
+
+var (
+	// sweepers holds all registered sweepers by name.
+	sweepers = make(map[string]Sweeper)
+	// servicePackage is the name of the service package currently registering sweepers.
+	servicePackage string
+)
+
+// RegisterServiceSweepers registers a service package's sweepers by calling the package's RegisterSweepers function.
+func RegisterServiceSweepers(servicePackageName string, f func()) {
+	servicePackage = servicePackageName
+	defer func() {
+		servicePackage = ""
+	}()
+
+	f()
+}
+
+// AddTestSweepers registers a sweeper with the test sweeper framework.
+// The sweeper's dependencies are also used to order the deletion of resources of different types.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	sweepers[name] = Sweeper{
+		Sweeper:        s,
+		ServicePackage: servicePackage,
+	}
+
+	resource.AddTestSweepers(name, s)
+}
+
+// Sweepers returns all registered sweepers by name.
+func Sweepers() map[string]Sweeper {
+	return maps.Clone(sweepers)
+}
+
+// dependencyGraph returns a graph of the registered sweepers and their dependencies.
+// A sweeper's dependencies are swept before the sweeper itself.
+func dependencyGraph() (*depgraph.Graph, error) {
+	g := depgraph.New()
+
+	// Iterate in a stable order so that the overall order is deterministic.
+	names := slices.Sorted(maps.Keys(sweepers))
+
+	for _, name := range names {
+		g.AddNode(name)
+		for _, dependency := range sweepers[name].Dependencies {
+			g.AddNode(dependency)
+		}
+	}
+
+	for _, name := range names {
+		for _, dependency := range sweepers[name].Dependencies {
+			if err := g.AddDependency(name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// DeletionOrder returns the specified sweepers or resource types in the order in which they should be swept.
+// A sweeper's dependencies are swept before the sweeper itself, e.g. ENIs before subnets before VPCs.
+func DeletionOrder(names []string) ([]string, error) {
+	g, err := dependencyGraph()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range names {
+		g.AddNode(name)
+	}
+
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(order, func(v string) bool {
+		return !slices.Contains(names, v)
+	}), nil
+}
@@ -109,17 +109,21 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mq"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/neptunegraph"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/pcs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpointsmsvoicev2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pipes"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/qbusiness"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ram"
@@ -170,174 +174,179 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/xray"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func registerSweepers() {
-	accessanalyzer.RegisterSweepers()
-	acm.RegisterSweepers()
-	acmpca.RegisterSweepers()
-	amp.RegisterSweepers()
-	amplify.RegisterSweepers()
-	apigateway.RegisterSweepers()
-	apigatewayv2.RegisterSweepers()
-	appautoscaling.RegisterSweepers()
-	appconfig.RegisterSweepers()
-	appfabric.RegisterSweepers()
-	appflow.RegisterSweepers()
-	applicationinsights.RegisterSweepers()
-	appmesh.RegisterSweepers()
-	apprunner.RegisterSweepers()
-	appstream.RegisterSweepers()
-	appsync.RegisterSweepers()
-	athena.RegisterSweepers()
-	auditmanager.RegisterSweepers()
-	autoscaling.RegisterSweepers()
-	autoscalingplans.RegisterSweepers()
-	backup.RegisterSweepers()
-	batch.RegisterSweepers()
-	bcmdataexports.RegisterSweepers()
-	budgets.RegisterSweepers()
-	chime.RegisterSweepers()
-	cleanrooms.RegisterSweepers()
-	cloud9.RegisterSweepers()
-	cloudformation.RegisterSweepers()
-	cloudfront.RegisterSweepers()
-	cloudhsmv2.RegisterSweepers()
-	cloudtrail.RegisterSweepers()
-	cloudwatch.RegisterSweepers()
-	codeartifact.RegisterSweepers()
-	codebuild.RegisterSweepers()
-	codegurureviewer.RegisterSweepers()
-	codepipeline.RegisterSweepers()
-	codestarconnections.RegisterSweepers()
-	codestarnotifications.RegisterSweepers()
-	cognitoidentity.RegisterSweepers()
-	cognitoidp.RegisterSweepers()
-	configservice.RegisterSweepers()
-	connect.RegisterSweepers()
-	cur.RegisterSweepers()
-	dataexchange.RegisterSweepers()
-	datasync.RegisterSweepers()
-	dax.RegisterSweepers()
-	deploy.RegisterSweepers()
-	devicefarm.RegisterSweepers()
-	directconnect.RegisterSweepers()
-	dlm.RegisterSweepers()
-	dms.RegisterSweepers()
-	docdb.RegisterSweepers()
-	docdbelastic.RegisterSweepers()
-	ds.RegisterSweepers()
-	dynamodb.RegisterSweepers()
-	ec2.RegisterSweepers()
-	ecr.RegisterSweepers()
-	ecrpublic.RegisterSweepers()
-	ecs.RegisterSweepers()
-	efs.RegisterSweepers()
-	eks.RegisterSweepers()
-	elasticache.RegisterSweepers()
-	elasticbeanstalk.RegisterSweepers()
-	elasticsearch.RegisterSweepers()
-	elb.RegisterSweepers()
-	elbv2.RegisterSweepers()
-	emr.RegisterSweepers()
-	emrcontainers.RegisterSweepers()
-	emrserverless.RegisterSweepers()
-	events.RegisterSweepers()
-	evidently.RegisterSweepers()
-	finspace.RegisterSweepers()
-	firehose.RegisterSweepers()
-	fis.RegisterSweepers()
-	fms.RegisterSweepers()
-	fsx.RegisterSweepers()
-	gamelift.RegisterSweepers()
-	glacier.RegisterSweepers()
-	globalaccelerator.RegisterSweepers()
-	glue.RegisterSweepers()
-	grafana.RegisterSweepers()
-	guardduty.RegisterSweepers()
-	iam.RegisterSweepers()
-	imagebuilder.RegisterSweepers()
-	internetmonitor.RegisterSweepers()
-	iot.RegisterSweepers()
-	kafka.RegisterSweepers()
-	kafkaconnect.RegisterSweepers()
-	kendra.RegisterSweepers()
-	keyspaces.RegisterSweepers()
-	kinesis.RegisterSweepers()
-	kinesisanalytics.RegisterSweepers()
-	kinesisanalyticsv2.RegisterSweepers()
-	kms.RegisterSweepers()
-	lakeformation.RegisterSweepers()
-	lambda.RegisterSweepers()
-	lexmodels.RegisterSweepers()
-	lexv2models.RegisterSweepers()
-	licensemanager.RegisterSweepers()
-	lightsail.RegisterSweepers()
-	location.RegisterSweepers()
-	logs.RegisterSweepers()
-	m2.RegisterSweepers()
-	medialive.RegisterSweepers()
-	mediapackage.RegisterSweepers()
-	memorydb.RegisterSweepers()
-	mq.RegisterSweepers()
-	mwaa.RegisterSweepers()
-	neptune.RegisterSweepers()
-	networkfirewall.RegisterSweepers()
-	networkmanager.RegisterSweepers()
-	opensearch.RegisterSweepers()
-	opensearchserverless.RegisterSweepers()
-	organizations.RegisterSweepers()
-	pinpoint.RegisterSweepers()
-	pinpointsmsvoicev2.RegisterSweepers()
-	pipes.RegisterSweepers()
-	qldb.RegisterSweepers()
-	quicksight.RegisterSweepers()
-	ram.RegisterSweepers()
-	rds.RegisterSweepers()
-	redshift.RegisterSweepers()
-	redshiftserverless.RegisterSweepers()
-	resiliencehub.RegisterSweepers()
-	resourceexplorer2.RegisterSweepers()
-	resourcegroups.RegisterSweepers()
-	route53.RegisterSweepers()
-	route53profiles.RegisterSweepers()
-	route53recoverycontrolconfig.RegisterSweepers()
-	route53resolver.RegisterSweepers()
-	rum.RegisterSweepers()
-	s3.RegisterSweepers()
-	s3control.RegisterSweepers()
-	s3tables.RegisterSweepers()
-	sagemaker.RegisterSweepers()
-	scheduler.RegisterSweepers()
-	schemas.RegisterSweepers()
-	secretsmanager.RegisterSweepers()
-	servicecatalog.RegisterSweepers()
-	servicecatalogappregistry.RegisterSweepers()
-	servicediscovery.RegisterSweepers()
-	ses.RegisterSweepers()
-	sesv2.RegisterSweepers()
-	sfn.RegisterSweepers()
-	shield.RegisterSweepers()
-	signer.RegisterSweepers()
-	simpledb.RegisterSweepers()
-	sns.RegisterSweepers()
-	sqs.RegisterSweepers()
-	ssm.RegisterSweepers()
-	ssmcontacts.RegisterSweepers()
-	ssmincidents.RegisterSweepers()
-	ssoadmin.RegisterSweepers()
-	storagegateway.RegisterSweepers()
-	swf.RegisterSweepers()
-	synthetics.RegisterSweepers()
-	timestreaminfluxdb.RegisterSweepers()
-	timestreamwrite.RegisterSweepers()
-	transcribe.RegisterSweepers()
-	transfer.RegisterSweepers()
-	verifiedpermissions.RegisterSweepers()
-	vpclattice.RegisterSweepers()
-	waf.RegisterSweepers()
-	wafregional.RegisterSweepers()
-	wafv2.RegisterSweepers()
-	workspaces.RegisterSweepers()
-	xray.RegisterSweepers()
+	sweep.RegisterServiceSweepers("accessanalyzer", accessanalyzer.RegisterSweepers)
+	sweep.RegisterServiceSweepers("acm", acm.RegisterSweepers)
+	sweep.RegisterServiceSweepers("acmpca", acmpca.RegisterSweepers)
+	sweep.RegisterServiceSweepers("amp", amp.RegisterSweepers)
+	sweep.RegisterServiceSweepers("amplify", amplify.RegisterSweepers)
+	sweep.RegisterServiceSweepers("apigateway", apigateway.RegisterSweepers)
+	sweep.RegisterServiceSweepers("apigatewayv2", apigatewayv2.RegisterSweepers)
+	sweep.RegisterServiceSweepers("appautoscaling", appautoscaling.RegisterSweepers)
+	sweep.RegisterServiceSweepers("appconfig", appconfig.RegisterSweepers)
+	sweep.RegisterServiceSweepers("appfabric", appfabric.RegisterSweepers)
+	sweep.RegisterServiceSweepers("appflow", appflow.RegisterSweepers)
+	sweep.RegisterServiceSweepers("applicationinsights", applicationinsights.RegisterSweepers)
+	sweep.RegisterServiceSweepers("appmesh", appmesh.RegisterSweepers)
+	sweep.RegisterServiceSweepers("apprunner", apprunner.RegisterSweepers)
+	sweep.RegisterServiceSweepers("appstream", appstream.RegisterSweepers)
+	sweep.RegisterServiceSweepers("appsync", appsync.RegisterSweepers)
+	sweep.RegisterServiceSweepers("athena", athena.RegisterSweepers)
+	sweep.RegisterServiceSweepers("auditmanager", auditmanager.RegisterSweepers)
+	sweep.RegisterServiceSweepers("autoscaling", autoscaling.RegisterSweepers)
+	sweep.RegisterServiceSweepers("autoscalingplans", autoscalingplans.RegisterSweepers)
+	sweep.RegisterServiceSweepers("backup", backup.RegisterSweepers)
+	sweep.RegisterServiceSweepers("batch", batch.RegisterSweepers)
+	sweep.RegisterServiceSweepers("bcmdataexports", bcmdataexports.RegisterSweepers)
+	sweep.RegisterServiceSweepers("budgets", budgets.RegisterSweepers)
+	sweep.RegisterServiceSweepers("chime", chime.RegisterSweepers)
+	sweep.RegisterServiceSweepers("cleanrooms", cleanrooms.RegisterSweepers)
+	sweep.RegisterServiceSweepers("cloud9", cloud9.RegisterSweepers)
+	sweep.RegisterServiceSweepers("cloudformation", cloudformation.RegisterSweepers)
+	sweep.RegisterServiceSweepers("cloudfront", cloudfront.RegisterSweepers)
+	sweep.RegisterServiceSweepers("cloudhsmv2", cloudhsmv2.RegisterSweepers)
+	sweep.RegisterServiceSweepers("cloudtrail", cloudtrail.RegisterSweepers)
+	sweep.RegisterServiceSweepers("cloudwatch", cloudwatch.RegisterSweepers)
+	sweep.RegisterServiceSweepers("codeartifact", codeartifact.RegisterSweepers)
+	sweep.RegisterServiceSweepers("codebuild", codebuild.RegisterSweepers)
+	sweep.RegisterServiceSweepers("codegurureviewer", codegurureviewer.RegisterSweepers)
+	sweep.RegisterServiceSweepers("codepipeline", codepipeline.RegisterSweepers)
+	sweep.RegisterServiceSweepers("codestarconnections", codestarconnections.RegisterSweepers)
+	sweep.RegisterServiceSweepers("codestarnotifications", codestarnotifications.RegisterSweepers)
+	sweep.RegisterServiceSweepers("cognitoidentity", cognitoidentity.RegisterSweepers)
+	sweep.RegisterServiceSweepers("cognitoidp", cognitoidp.RegisterSweepers)
+	sweep.RegisterServiceSweepers("configservice", configservice.RegisterSweepers)
+	sweep.RegisterServiceSweepers("connect", connect.RegisterSweepers)
+	sweep.RegisterServiceSweepers("cur", cur.RegisterSweepers)
+	sweep.RegisterServiceSweepers("dataexchange", dataexchange.RegisterSweepers)
+	sweep.RegisterServiceSweepers("datasync", datasync.RegisterSweepers)
+	sweep.RegisterServiceSweepers("dax", dax.RegisterSweepers)
+	sweep.RegisterServiceSweepers("deploy", deploy.RegisterSweepers)
+	sweep.RegisterServiceSweepers("devicefarm", devicefarm.RegisterSweepers)
+	sweep.RegisterServiceSweepers("directconnect", directconnect.RegisterSweepers)
+	sweep.RegisterServiceSweepers("dlm", dlm.RegisterSweepers)
+	sweep.RegisterServiceSweepers("dms", dms.RegisterSweepers)
+	sweep.RegisterServiceSweepers("docdb", docdb.RegisterSweepers)
+	sweep.RegisterServiceSweepers("docdbelastic", docdbelastic.RegisterSweepers)
+	sweep.RegisterServiceSweepers("ds", ds.RegisterSweepers)
+	sweep.RegisterServiceSweepers("dynamodb", dynamodb.RegisterSweepers)
+	sweep.RegisterServiceSweepers("ec2", ec2.RegisterSweepers)
+	sweep.RegisterServiceSweepers("ecr", ecr.RegisterSweepers)
+	sweep.RegisterServiceSweepers("ecrpublic", ecrpublic.RegisterSweepers)
+	sweep.RegisterServiceSweepers("ecs", ecs.RegisterSweepers)
+	sweep.RegisterServiceSweepers("efs", efs.RegisterSweepers)
+	sweep.RegisterServiceSweepers("eks", eks.RegisterSweepers)
+	sweep.RegisterServiceSweepers("elasticache", elasticache.RegisterSweepers)
+	sweep.RegisterServiceSweepers("elasticbeanstalk", elasticbeanstalk.RegisterSweepers)
+	sweep.RegisterServiceSweepers("elasticsearch", elasticsearch.RegisterSweepers)
+	sweep.RegisterServiceSweepers("elb", elb.RegisterSweepers)
+	sweep.RegisterServiceSweepers("elbv2", elbv2.RegisterSweepers)
+	sweep.RegisterServiceSweepers("emr", emr.RegisterSweepers)
+	sweep.RegisterServiceSweepers("emrcontainers", emrcontainers.RegisterSweepers)
+	sweep.RegisterServiceSweepers("emrserverless", emrserverless.RegisterSweepers)
+	sweep.RegisterServiceSweepers("events", events.RegisterSweepers)
+	sweep.RegisterServiceSweepers("evidently", evidently.RegisterSweepers)
+	sweep.RegisterServiceSweepers("finspace", finspace.RegisterSweepers)
+	sweep.RegisterServiceSweepers("firehose", firehose.RegisterSweepers)
+	sweep.RegisterServiceSweepers("fis", fis.RegisterSweepers)
+	sweep.RegisterServiceSweepers("fms", fms.RegisterSweepers)
+	sweep.RegisterServiceSweepers("fsx", fsx.RegisterSweepers)
+	sweep.RegisterServiceSweepers("gamelift", gamelift.RegisterSweepers)
+	sweep.RegisterServiceSweepers("glacier", glacier.RegisterSweepers)
+	sweep.RegisterServiceSweepers("globalaccelerator", globalaccelerator.RegisterSweepers)
+	sweep.RegisterServiceSweepers("glue", glue.RegisterSweepers)
+	sweep.RegisterServiceSweepers("grafana", grafana.RegisterSweepers)
+	sweep.RegisterServiceSweepers("guardduty", guardduty.RegisterSweepers)
+	sweep.RegisterServiceSweepers("iam", iam.RegisterSweepers)
+	sweep.RegisterServiceSweepers("imagebuilder", imagebuilder.RegisterSweepers)
+	sweep.RegisterServiceSweepers("internetmonitor", internetmonitor.RegisterSweepers)
+	sweep.RegisterServiceSweepers("iot", iot.RegisterSweepers)
+	sweep.RegisterServiceSweepers("kafka", kafka.RegisterSweepers)
+	sweep.RegisterServiceSweepers("kafkaconnect", kafkaconnect.RegisterSweepers)
+	sweep.RegisterServiceSweepers("kendra", kendra.RegisterSweepers)
+	sweep.RegisterServiceSweepers("keyspaces", keyspaces.RegisterSweepers)
+	sweep.RegisterServiceSweepers("kinesis", kinesis.RegisterSweepers)
+	sweep.RegisterServiceSweepers("kinesisanalytics", kinesisanalytics.RegisterSweepers)
+	sweep.RegisterServiceSweepers("kinesisanalyticsv2", kinesisanalyticsv2.RegisterSweepers)
+	sweep.RegisterServiceSweepers("kms", kms.RegisterSweepers)
+	sweep.RegisterServiceSweepers("lakeformation", lakeformation.RegisterSweepers)
+	sweep.RegisterServiceSweepers("lambda", lambda.RegisterSweepers)
+	sweep.RegisterServiceSweepers("lexmodels", lexmodels.RegisterSweepers)
+	sweep.RegisterServiceSweepers("lexv2models", lexv2models.RegisterSweepers)
+	sweep.RegisterServiceSweepers("licensemanager", licensemanager.RegisterSweepers)
+	sweep.RegisterServiceSweepers("lightsail", lightsail.RegisterSweepers)
+	sweep.RegisterServiceSweepers("location", location.RegisterSweepers)
+	sweep.RegisterServiceSweepers("logs", logs.RegisterSweepers)
+	sweep.RegisterServiceSweepers("m2", m2.RegisterSweepers)
+	sweep.RegisterServiceSweepers("medialive", medialive.RegisterSweepers)
+	sweep.RegisterServiceSweepers("mediapackage", mediapackage.RegisterSweepers)
+	sweep.RegisterServiceSweepers("memorydb", memorydb.RegisterSweepers)
+	sweep.RegisterServiceSweepers("mgn", mgn.RegisterSweepers)
+	sweep.RegisterServiceSweepers("mq", mq.RegisterSweepers)
+	sweep.RegisterServiceSweepers("mwaa", mwaa.RegisterSweepers)
+	sweep.RegisterServiceSweepers("neptune", neptune.RegisterSweepers)
+	sweep.RegisterServiceSweepers("neptunegraph", neptunegraph.RegisterSweepers)
+	sweep.RegisterServiceSweepers("networkfirewall", networkfirewall.RegisterSweepers)
+	sweep.RegisterServiceSweepers("networkmanager", networkmanager.RegisterSweepers)
+	sweep.RegisterServiceSweepers("opensearch", opensearch.RegisterSweepers)
+	sweep.RegisterServiceSweepers("opensearchserverless", opensearchserverless.RegisterSweepers)
+	sweep.RegisterServiceSweepers("organizations", organizations.RegisterSweepers)
+	sweep.RegisterServiceSweepers("pcs", pcs.RegisterSweepers)
+	sweep.RegisterServiceSweepers("pinpoint", pinpoint.RegisterSweepers)
+	sweep.RegisterServiceSweepers("pinpointsmsvoicev2", pinpointsmsvoicev2.RegisterSweepers)
+	sweep.RegisterServiceSweepers("pipes", pipes.RegisterSweepers)
+	sweep.RegisterServiceSweepers("qbusiness", qbusiness.RegisterSweepers)
+	sweep.RegisterServiceSweepers("qldb", qldb.RegisterSweepers)
+	sweep.RegisterServiceSweepers("quicksight", quicksight.RegisterSweepers)
+	sweep.RegisterServiceSweepers("ram", ram.RegisterSweepers)
+	sweep.RegisterServiceSweepers("rds", rds.RegisterSweepers)
+	sweep.RegisterServiceSweepers("redshift", redshift.RegisterSweepers)
+	sweep.RegisterServiceSweepers("redshiftserverless", redshiftserverless.RegisterSweepers)
+	sweep.RegisterServiceSweepers("resiliencehub", resiliencehub.RegisterSweepers)
+	sweep.RegisterServiceSweepers("resourceexplorer2", resourceexplorer2.RegisterSweepers)
+	sweep.RegisterServiceSweepers("resourcegroups", resourcegroups.RegisterSweepers)
+	sweep.RegisterServiceSweepers("route53", route53.RegisterSweepers)
+	sweep.RegisterServiceSweepers("route53profiles", route53profiles.RegisterSweepers)
+	sweep.RegisterServiceSweepers("route53recoverycontrolconfig", route53recoverycontrolconfig.RegisterSweepers)
+	sweep.RegisterServiceSweepers("route53resolver", route53resolver.RegisterSweepers)
+	sweep.RegisterServiceSweepers("rum", rum.RegisterSweepers)
+	sweep.RegisterServiceSweepers("s3", s3.RegisterSweepers)
+	sweep.RegisterServiceSweepers("s3control", s3control.RegisterSweepers)
+	sweep.RegisterServiceSweepers("s3tables", s3tables.RegisterSweepers)
+	sweep.RegisterServiceSweepers("sagemaker", sagemaker.RegisterSweepers)
+	sweep.RegisterServiceSweepers("scheduler", scheduler.RegisterSweepers)
+	sweep.RegisterServiceSweepers("schemas", schemas.RegisterSweepers)
+	sweep.RegisterServiceSweepers("secretsmanager", secretsmanager.RegisterSweepers)
+	sweep.RegisterServiceSweepers("servicecatalog", servicecatalog.RegisterSweepers)
+	sweep.RegisterServiceSweepers("servicecatalogappregistry", servicecatalogappregistry.RegisterSweepers)
+	sweep.RegisterServiceSweepers("servicediscovery", servicediscovery.RegisterSweepers)
+	sweep.RegisterServiceSweepers("ses", ses.RegisterSweepers)
+	sweep.RegisterServiceSweepers("sesv2", sesv2.RegisterSweepers)
+	sweep.RegisterServiceSweepers("sfn", sfn.RegisterSweepers)
+	sweep.RegisterServiceSweepers("shield", shield.RegisterSweepers)
+	sweep.RegisterServiceSweepers("signer", signer.RegisterSweepers)
+	sweep.RegisterServiceSweepers("simpledb", simpledb.RegisterSweepers)
+	sweep.RegisterServiceSweepers("sns", sns.RegisterSweepers)
+	sweep.RegisterServiceSweepers("sqs", sqs.RegisterSweepers)
+	sweep.RegisterServiceSweepers("ssm", ssm.RegisterSweepers)
+	sweep.RegisterServiceSweepers("ssmcontacts", ssmcontacts.RegisterSweepers)
+	sweep.RegisterServiceSweepers("ssmincidents", ssmincidents.RegisterSweepers)
+	sweep.RegisterServiceSweepers("ssoadmin", ssoadmin.RegisterSweepers)
+	sweep.RegisterServiceSweepers("storagegateway", storagegateway.RegisterSweepers)
+	sweep.RegisterServiceSweepers("swf", swf.RegisterSweepers)
+	sweep.RegisterServiceSweepers("synthetics", synthetics.RegisterSweepers)
+	sweep.RegisterServiceSweepers("timestreaminfluxdb", timestreaminfluxdb.RegisterSweepers)
+	sweep.RegisterServiceSweepers("timestreamwrite", timestreamwrite.RegisterSweepers)
+	sweep.RegisterServiceSweepers("transcribe", transcribe.RegisterSweepers)
+	sweep.RegisterServiceSweepers("transfer", transfer.RegisterSweepers)
+	sweep.RegisterServiceSweepers("verifiedpermissions", verifiedpermissions.RegisterSweepers)
+	sweep.RegisterServiceSweepers("vpclattice", vpclattice.RegisterSweepers)
+	sweep.RegisterServiceSweepers("waf", waf.RegisterSweepers)
+	sweep.RegisterServiceSweepers("wafregional", wafregional.RegisterSweepers)
+	sweep.RegisterServiceSweepers("wafv2", wafv2.RegisterSweepers)
+	sweep.RegisterServiceSweepers("workspaces", workspaces.RegisterSweepers)
+	sweep.RegisterServiceSweepers("xray", xray.RegisterSweepers)
 }
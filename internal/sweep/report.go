@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"encoding/json"
+	"os"
+	"slices"
+	"sync"
+)
+
+// Actions recorded in a sweeper report.
+const (
+	ReportActionDeleted     = "deleted"
+	ReportActionFailed      = "failed"
+	ReportActionSkipped     = "skipped"
+	ReportActionWouldDelete = "would_delete"
+)
+
+// ReportEntry records the outcome of sweeping a single resource.
+type ReportEntry struct {
+	Region   string `json:"region"`
+	TypeName string `json:"type_name,omitempty"`
+	ID       string `json:"id,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Action   string `json:"action"`
+	// Reason explains why a resource was skipped.
+	Reason string `json:"reason,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Report is a machine-readable record of the resources that were (or would be) deleted by sweepers.
+type Report struct {
+	mutex   sync.Mutex
+	path    string
+	entries []ReportEntry
+}
+
+// NewReport returns a new report.
+// If path is not empty, the report is written to that file as JSON each time resources are swept.
+func NewReport(path string) *Report {
+	return &Report{
+		path: path,
+	}
+}
+
+// Entries returns the entries recorded in the report.
+func (r *Report) Entries() []ReportEntry {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return slices.Clone(r.entries)
+}
+
+func (r *Report) add(entry ReportEntry) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.entries = append(r.entries, entry)
+}
+
+// write writes the report to its file.
+// The whole report is rewritten so that the file is complete even if the sweeper process exits abruptly.
+func (r *Report) write() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.path == "" {
+		return nil
+	}
+
+	entries := r.entries
+	if entries == nil {
+		entries = []ReportEntry{}
+	}
+
+	b, err := json.MarshalIndent(struct {
+		Resources []ReportEntry `json:"resources"`
+	}{
+		Resources: entries,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(r.path, b, 0644)
+}
@@ -17,7 +17,9 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/metadata"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -89,6 +91,54 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// Metadata returns the swept resource's metadata from the values set by the sweeper's lister.
+// If refresh is true the resource is read first.
+func (sr *sweepResource) Metadata(ctx context.Context, refresh bool) (metadata.Metadata, error) {
+	if refresh {
+		ctx = tflog.SetField(ctx, "id", sr.d.Id())
+
+		if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+			return metadata.Metadata{}, err
+		}
+	}
+
+	md := metadata.Metadata{
+		ID: sr.d.Id(),
+	}
+
+	schema := sr.resource.SchemaMap()
+
+	if _, ok := schema[names.AttrName]; ok {
+		if v, ok := sr.d.Get(names.AttrName).(string); ok {
+			md.Name = v
+		}
+	}
+
+	if _, ok := schema[names.AttrTags]; ok {
+		if v, ok := sr.d.GetOk(names.AttrTags); ok {
+			md.Tags = make(map[string]string)
+			for k, v := range v.(map[string]any) {
+				md.Tags[k], _ = v.(string)
+			}
+		}
+	}
+
+	for _, k := range metadata.CreationTimeAttributes {
+		if _, ok := schema[k]; !ok {
+			continue
+		}
+
+		if v, ok := sr.d.Get(k).(string); ok {
+			if t, ok := metadata.ParseCreationTime(v); ok {
+				md.CreatedAt = t
+				break
+			}
+		}
+	}
+
+	return md, nil
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
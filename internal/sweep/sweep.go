@@ -10,11 +10,14 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/aws/smithy-go/middleware"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/metadata"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -60,6 +63,9 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	meta.ServicePackages = servicePackageMap
 
 	conf := &conns.Config{
+		APIOptions: []func(*middleware.Stack) error{
+			guardAPIOption,
+		},
 		MaxRetries:       5,
 		Region:           region,
 		SuppressDebugLog: true,
@@ -106,20 +112,189 @@ type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
+// Metadata describes a resource to be swept.
+type Metadata = metadata.Metadata
+
+// SweepableWithMetadata is implemented by sweepables that can describe the resource they delete.
+// Only these sweepables are swept when filters are configured.
+type SweepableWithMetadata interface {
+	Sweepable
+	// Metadata returns the resource's metadata.
+	// If refresh is true the resource's current state is read first.
+	Metadata(ctx context.Context, refresh bool) (Metadata, error)
+}
+
+// SweepOrchestrator deletes the specified resources according to the sweeper options set in the context
+// or configured via environment variables.
+// Resources of different types are deleted in dependency order.
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
-	var g multierror.Group
+	options, err := optionsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	batches, err := batchByResourceType(ctx, sweepables)
+	if err != nil {
+		return err
+	}
+
+	var errs *multierror.Error
+
+	for _, batch := range batches {
+		errs = multierror.Append(errs, sweepBatch(ctx, options, batch, optFns...))
+	}
+
+	if options.Report != nil {
+		if err := options.Report.write(); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("writing sweeper report: %w", err))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+type sweepItem struct {
+	sweepable Sweepable
+	metadata  Metadata
+}
+
+// batchByResourceType groups sweepables by resource type, in the order in which the resource types should be deleted.
+func batchByResourceType(ctx context.Context, sweepables []Sweepable) ([][]sweepItem, error) {
+	typeName := resourceTypeFromContext(ctx)
+	var typeNames []string
+	batches := make(map[string][]sweepItem)
 
 	for _, sweepable := range sweepables {
+		item := sweepItem{
+			sweepable: sweepable,
+		}
+
+		if v, ok := sweepable.(SweepableWithMetadata); ok {
+			// Metadata that doesn't require a refresh can't fail.
+			item.metadata, _ = v.Metadata(ctx, false)
+		}
+		if item.metadata.TypeName == "" {
+			item.metadata.TypeName = typeName
+		}
+
+		if _, ok := batches[item.metadata.TypeName]; !ok {
+			typeNames = append(typeNames, item.metadata.TypeName)
+		}
+		batches[item.metadata.TypeName] = append(batches[item.metadata.TypeName], item)
+	}
+
+	if len(typeNames) > 1 {
+		var err error
+		if typeNames, err = DeletionOrder(typeNames); err != nil {
+			return nil, fmt.Errorf("ordering resource types: %w", err)
+		}
+	}
+
+	return tfslices.ApplyToAll(typeNames, func(v string) []sweepItem {
+		return batches[v]
+	}), nil
+}
+
+// sweepBatch sweeps resources of a single type concurrently.
+func sweepBatch(ctx context.Context, options *Options, batch []sweepItem, optFns ...tfresource.OptionsFunc) error {
+	var g multierror.Group
+	var semaphore chan struct{}
+
+	if options.Concurrency > 0 {
+		semaphore = make(chan struct{}, options.Concurrency)
+	}
+
+	for _, item := range batch {
 		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+			if semaphore != nil {
+				semaphore <- struct{}{}
+				defer func() { <-semaphore }()
+			}
+
+			return sweepOne(ctx, options, item, optFns...)
 		})
 	}
 
 	return g.Wait().ErrorOrNil()
 }
 
+func sweepOne(ctx context.Context, options *Options, item sweepItem, optFns ...tfresource.OptionsFunc) error {
+	md := item.metadata
+	entry := ReportEntry{
+		Region:   regionFromContext(ctx),
+		TypeName: md.TypeName,
+		ID:       md.ID,
+		Name:     md.Name,
+	}
+	report := func(action string) {
+		if options.Report != nil {
+			entry.Action = action
+			options.Report.add(entry)
+		}
+	}
+
+	if !options.Filters.IsEmpty() {
+		v, ok := item.sweepable.(SweepableWithMetadata)
+		if !ok {
+			entry.Reason = "resource metadata not available for filtering"
+			report(ReportActionSkipped)
+			return nil
+		}
+
+		matched, known := options.Filters.match(md, time.Now())
+		if !known {
+			var err error
+			if md, err = v.Metadata(ctx, true); err != nil {
+				entry.Error = err.Error()
+				report(ReportActionFailed)
+				return fmt.Errorf("reading %s (%s): %w", entry.TypeName, entry.ID, err)
+			}
+
+			if md.ID == "" {
+				entry.Reason = "resource not found"
+				report(ReportActionSkipped)
+				return nil
+			}
+
+			entry.ID, entry.Name = md.ID, md.Name
+			matched, known = options.Filters.match(md, time.Now())
+		}
+
+		if !known {
+			entry.Reason = "resource metadata not available for filtering"
+			report(ReportActionSkipped)
+			return nil
+		}
+
+		if !matched {
+			entry.Reason = "filtered"
+			report(ReportActionSkipped)
+			return nil
+		}
+	}
+
+	if options.DryRun {
+		tflog.Info(ctx, "Dry run, not sweeping resource", map[string]any{
+			"id":   entry.ID,
+			"name": entry.Name,
+		})
+		report(ReportActionWouldDelete)
+		return nil
+	}
+
+	if err := item.sweepable.Delete(withOrchestrated(ctx), ThrottlingRetryTimeout, optFns...); err != nil {
+		entry.Error = err.Error()
+		report(ReportActionFailed)
+		return err
+	}
+
+	report(ReportActionDeleted)
+
+	return nil
+}
+
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+var (
+	// mutatingOperationRegexp matches AWS API operations that change or delete resources.
+	mutatingOperationRegexp = regexp.MustCompile(`^(Cancel|Delete|Deregister|Detach|Disable|Disassociate|Modify|Purge|Put|Reject|Remove|Reset|Revoke|Set|Stop|Terminate|Update)[A-Z]`)
+	// mutatingHelperRegexp matches service package helper functions that change or delete resources.
+	mutatingHelperRegexp = regexp.MustCompile(`^(cancel|delete|deregister|detach|disable|disassociate|empty|modify|purge|put|remove|reset|revoke|stop|terminate|update)[A-Z]`)
+	// resourceDataMethods are schema.ResourceData methods that match mutatingOperationRegexp but only change local state.
+	resourceDataMethods = map[string]bool{
+		"SetId":          true,
+		"SetNewComputed": true,
+		"SetPartial":     true,
+	}
+)
+
+// TestSweepersDryRun walks every sweeper registered by a service package and checks that none
+// changes or deletes resources itself while listing them.
+// Resources must be deleted by Sweepables passed to SweepOrchestrator, which honors dry-run and filtering.
+// Sweepable methods are not checked as they are only called by SweepOrchestrator.
+func TestSweepersDryRun(t *testing.T) {
+	t.Parallel()
+
+	files, err := filepath.Glob(filepath.Join("..", "service", "*", "sweep.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var n int
+
+	for _, file := range files {
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		packageNames := make(map[string]bool)
+		for _, spec := range f.Imports {
+			if spec.Name != nil {
+				packageNames[spec.Name.Name] = true
+				continue
+			}
+			v, _ := strconv.Unquote(spec.Path.Value)
+			packageNames[path.Base(v)] = true
+		}
+
+		funcs := make(map[string]*ast.FuncDecl)
+		for _, decl := range f.Decls {
+			if v, ok := decl.(*ast.FuncDecl); ok && v.Recv == nil {
+				funcs[v.Name.Name] = v
+			}
+		}
+
+		ast.Inspect(f, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+
+			name, root := sweeperRegistration(call)
+			if root == nil {
+				return true
+			}
+
+			n++
+
+			visited := make(map[string]bool)
+			var check func(ast.Node)
+			check = func(node ast.Node) {
+				ast.Inspect(node, func(node ast.Node) bool {
+					call, ok := node.(*ast.CallExpr)
+					if !ok {
+						return true
+					}
+
+					switch fun := call.Fun.(type) {
+					case *ast.SelectorExpr:
+						if x, ok := fun.X.(*ast.Ident); ok && packageNames[x.Name] {
+							return true
+						}
+						if mutatingOperationRegexp.MatchString(fun.Sel.Name) && !resourceDataMethods[fun.Sel.Name] {
+							t.Errorf("%s: sweeper %q calls %s directly; delete resources via SweepOrchestrator", fset.Position(call.Pos()), name, fun.Sel.Name)
+						}
+					case *ast.Ident:
+						if mutatingHelperRegexp.MatchString(fun.Name) {
+							t.Errorf("%s: sweeper %q calls %s directly; delete resources via SweepOrchestrator", fset.Position(call.Pos()), name, fun.Name)
+						} else if v, ok := funcs[fun.Name]; ok && !visited[fun.Name] {
+							visited[fun.Name] = true
+							check(v.Body)
+						}
+					}
+
+					return true
+				})
+			}
+
+			switch root := root.(type) {
+			case *ast.Ident:
+				if v, ok := funcs[root.Name]; ok {
+					visited[root.Name] = true
+					check(v.Body)
+				}
+			case *ast.FuncLit:
+				check(root.Body)
+			}
+
+			return true
+		})
+	}
+
+	if n == 0 {
+		t.Fatal("no sweepers found")
+	}
+}
+
+// sweeperRegistration returns the name and sweeper function of a call to sweep.AddTestSweepers or awsv2.Register.
+func sweeperRegistration(call *ast.CallExpr) (string, ast.Expr) {
+	fun, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || len(call.Args) < 2 {
+		return "", nil
+	}
+
+	x, ok := fun.X.(*ast.Ident)
+	if !ok {
+		return "", nil
+	}
+
+	var name string
+	if v, ok := call.Args[0].(*ast.BasicLit); ok {
+		name, _ = strconv.Unquote(v.Value)
+	}
+
+	switch {
+	case x.Name == "awsv2" && fun.Sel.Name == "Register":
+		return name, call.Args[1]
+	case x.Name == "sweep" && fun.Sel.Name == "AddTestSweepers":
+		v, ok := call.Args[1].(*ast.UnaryExpr)
+		if !ok {
+			return "", nil
+		}
+		lit, ok := v.X.(*ast.CompositeLit)
+		if !ok {
+			return "", nil
+		}
+		for _, elt := range lit.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "F" {
+					return name, kv.Value
+				}
+			}
+		}
+	}
+
+	return "", nil
+}
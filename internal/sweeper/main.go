@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// sweeper deletes resources leaked by acceptance tests using the provider's registered test sweepers.
+// It is a standalone alternative to running the sweepers via `go test -sweep`.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+var (
+	dryRun        = flag.Bool("dry-run", false, "List the resources that would be deleted without deleting them")
+	exclude       = flag.String("exclude", "", "Comma-separated list of sweepers (resource types) not to run")
+	olderThan     = flag.Duration("older-than", 0, "Only delete resources created at least this long ago, e.g. 24h")
+	profile       = flag.String("profile", "", "Named AWS shared configuration profile of the account to sweep")
+	regions       = flag.String("regions", "", "Comma-separated list of AWS Regions to sweep (required)")
+	report        = flag.String("report", "", "Path of a file to which a JSON report of swept resources is written")
+	resourceTypes = flag.String("resource-types", "", "Comma-separated list of sweepers (resource types) to run")
+	services      = flag.String("services", "", "Comma-separated list of service packages whose sweepers are run, e.g. ec2,rds")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tsweeper -regions <regions> [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if *regions == "" || flag.NArg() > 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if *profile != "" {
+		os.Setenv(envvar.Profile, *profile)
+	}
+
+	ctx := context.Background()
+
+	sweep.ServicePackages = servicePackages(ctx)
+	registerSweepers()
+
+	options, err := sweep.OptionsFromEnv()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+
+	options.DryRun = options.DryRun || *dryRun
+	if *olderThan != 0 {
+		options.Filters.MinimumAge = *olderThan
+	}
+	// Always record swept resources for the summary table.
+	if *report != "" || options.Report == nil {
+		options.Report = sweep.NewReport(*report)
+	}
+	sweep.SetDefaultOptions(options)
+
+	names, err := selectSweepers(sweep.Sweepers(), splitList(*services), splitList(*resourceTypes), splitList(*exclude))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+
+	if len(names) == 0 {
+		fmt.Fprintln(os.Stderr, "No sweepers selected")
+		os.Exit(1)
+	}
+
+	results := run(sweep.Sweepers(), names, splitList(*regions), options.Report)
+
+	if failed := printSummary(os.Stdout, results, options.DryRun); failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// selectSweepers returns the names of the sweepers to run, in the order in which they should be run.
+// Dependencies of the selected sweepers are also run unless excluded.
+func selectSweepers(sweepers map[string]sweep.Sweeper, services, resourceTypes, exclude []string) ([]string, error) {
+	for _, name := range slices.Concat(resourceTypes, exclude) {
+		if _, ok := sweepers[name]; !ok {
+			return nil, fmt.Errorf("no sweeper registered for %q", name)
+		}
+	}
+
+	var names []string
+	var add func(string)
+	add = func(name string) {
+		if slices.Contains(names, name) || slices.Contains(exclude, name) {
+			return
+		}
+
+		s, ok := sweepers[name]
+		if !ok {
+			return
+		}
+
+		names = append(names, name)
+		for _, dependency := range s.Dependencies {
+			add(dependency)
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(sweepers)) {
+		s := sweepers[name]
+		if len(services) > 0 && !slices.Contains(services, s.ServicePackage) {
+			continue
+		}
+		if len(resourceTypes) > 0 && !slices.Contains(resourceTypes, name) {
+			continue
+		}
+
+		add(name)
+	}
+
+	return sweep.DeletionOrder(names)
+}
+
+type result struct {
+	region  string
+	sweeper string
+	counts  map[string]int
+	err     error
+}
+
+// run runs the specified sweepers in each Region.
+// Sweepers continue to run after a failure.
+func run(sweepers map[string]sweep.Sweeper, names, regions []string, report *sweep.Report) []result {
+	var results []result
+
+	for _, region := range regions {
+		for _, name := range names {
+			fmt.Fprintf(os.Stderr, "Running sweeper %s in %s\n", name, region)
+
+			n := len(report.Entries())
+			err := sweepers[name].F(region)
+
+			counts := make(map[string]int)
+			for _, entry := range report.Entries()[n:] {
+				counts[entry.Action]++
+			}
+
+			results = append(results, result{
+				region:  region,
+				sweeper: name,
+				counts:  counts,
+				err:     err,
+			})
+		}
+	}
+
+	return results
+}
+
+// printSummary writes a summary table of the results and returns the number of failed sweepers.
+// Sweepers that change resources outside SweepOrchestrator while a dry run or filters are configured
+// are stopped by the sweep client, reported as unsupported and counted as failed.
+func printSummary(w io.Writer, results []result, dryRun bool) int {
+	var failed, unsupported int
+
+	deleted := sweep.ReportActionDeleted
+	if dryRun {
+		deleted = sweep.ReportActionWouldDelete
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "REGION\tSWEEPER\t%s\tSKIPPED\tFAILED\tERROR\n", strings.ToUpper(strings.ReplaceAll(deleted, "_", " ")))
+
+	for _, r := range results {
+		var errMsg string
+		if r.err != nil {
+			failed++
+			// Only show the first line of multi-line errors.
+			errMsg, _, _ = strings.Cut(r.err.Error(), "\n")
+
+			if errors.Is(r.err, sweep.ErrUnsupported) {
+				unsupported++
+				errMsg = "unsupported: " + errMsg
+			}
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\t%s\n", r.region, r.sweeper, r.counts[deleted], r.counts[sweep.ReportActionSkipped], r.counts[sweep.ReportActionFailed], errMsg)
+	}
+
+	tw.Flush()
+
+	fmt.Fprintf(w, "\n%d sweeper runs, %d failed", len(results), failed)
+	if unsupported > 0 {
+		fmt.Fprintf(w, " (%d unsupported)", unsupported)
+	}
+	fmt.Fprintln(w)
+
+	return failed
+}
+
+func splitList(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(s, ",")
+}
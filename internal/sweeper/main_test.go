@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func TestSelectSweepers(t *testing.T) {
+	t.Parallel()
+
+	sweep.RegisterServiceSweepers("testec2", func() {
+		sweep.AddTestSweepers("test_network_interface", &resource.Sweeper{Name: "test_network_interface"})
+		sweep.AddTestSweepers("test_subnet", &resource.Sweeper{Name: "test_subnet", Dependencies: []string{"test_network_interface", "test_lambda_function"}})
+		sweep.AddTestSweepers("test_vpc", &resource.Sweeper{Name: "test_vpc", Dependencies: []string{"test_subnet"}})
+	})
+	sweep.RegisterServiceSweepers("testlambda", func() {
+		sweep.AddTestSweepers("test_lambda_function", &resource.Sweeper{Name: "test_lambda_function"})
+	})
+
+	testCases := map[string]struct {
+		services      []string
+		resourceTypes []string
+		exclude       []string
+		expected      []string
+		expectedError bool
+	}{
+		"all": {
+			expected: []string{"test_network_interface", "test_lambda_function", "test_subnet", "test_vpc"},
+		},
+		"service": {
+			services: []string{"testec2"},
+			expected: []string{"test_network_interface", "test_lambda_function", "test_subnet", "test_vpc"},
+		},
+		"service exclude": {
+			services: []string{"testec2"},
+			exclude:  []string{"test_lambda_function"},
+			expected: []string{"test_network_interface", "test_subnet", "test_vpc"},
+		},
+		"resource type": {
+			resourceTypes: []string{"test_subnet"},
+			expected:      []string{"test_network_interface", "test_lambda_function", "test_subnet"},
+		},
+		"resource type no dependencies": {
+			resourceTypes: []string{"test_lambda_function"},
+			expected:      []string{"test_lambda_function"},
+		},
+		"unknown resource type": {
+			resourceTypes: []string{"test_unknown"},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := selectSweepers(sweep.Sweepers(), testCase.services, testCase.resourceTypes, testCase.exclude)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("error = %v, want error %t", err, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPrintSummary(t *testing.T) {
+	t.Parallel()
+
+	results := []result{
+		{
+			region:  "us-west-2", //lintignore:AWSAT003
+			sweeper: "test_vpc",
+			counts:  map[string]int{sweep.ReportActionWouldDelete: 2, sweep.ReportActionSkipped: 1},
+		},
+		{
+			region:  "us-west-2", //lintignore:AWSAT003
+			sweeper: "test_subnet",
+			counts:  map[string]int{},
+			err:     errors.New("listing: AccessDenied\nmore detail"),
+		},
+		{
+			region:  "us-west-2", //lintignore:AWSAT003
+			sweeper: "test_route_table",
+			counts:  map[string]int{},
+			err:     fmt.Errorf("deleting Route: %w", sweep.ErrUnsupported),
+		},
+	}
+
+	var buf bytes.Buffer
+
+	if got, want := printSummary(&buf, results, true), 2; got != want {
+		t.Errorf("failed = %d, want %d", got, want)
+	}
+
+	out := buf.String()
+	for _, want := range []string{"WOULD DELETE", "test_vpc", "listing: AccessDenied", "unsupported: deleting Route", "3 sweeper runs, 2 failed (1 unsupported)"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("summary does not contain %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "more detail") {
+		t.Errorf("summary contains multi-line error:\n%s", out)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// DiagnosticsConfig contains options for reporting resource tags that conflict with the
+// provider's tag configuration or that have been changed outside of Terraform.
+type DiagnosticsConfig struct {
+	// Error reports findings as errors instead of warnings.
+	Error bool
+}
+
+// Finding is a resource tag conflict or drift reported as a diagnostic.
+type Finding struct {
+	Summary string
+	Detail  string
+}
+
+// ConfigConflicts returns findings for configured resource tags that override a
+// provider default tag with a different value, or that are ignored by the
+// provider's ignore_tags configuration.
+func (tags KeyValueTags) ConfigConflicts(defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig) []Finding {
+	var findings []Finding
+
+	defaultTags := defaultConfig.GetTags()
+	for _, k := range sortedKeys(tags) {
+		if v, ok := defaultTags[k]; ok && !v.Equal(tags[k]) {
+			findings = append(findings, Finding{
+				Summary: "Resource tag overrides provider default tag",
+				Detail: fmt.Sprintf("The tag %q is set to %q in the resource's tags, overriding the value %q from the provider's default_tags.",
+					k, tags[k].ValueString(), v.ValueString()),
+			})
+		}
+	}
+
+	ignored := tags.Removed(tags.IgnoreConfig(ignoreConfig))
+	for _, k := range sortedKeys(ignored) {
+		findings = append(findings, Finding{
+			Summary: "Resource tag is ignored by provider configuration",
+			Detail: fmt.Sprintf("The tag %q is set in the resource's tags but matches the provider's ignore_tags configuration. "+
+				"Changes to its value are not detected and the resource may show a perpetual difference.", k),
+		})
+	}
+
+	return findings
+}
+
+// Drift returns a finding if the resource tags returned from the AWS API (newTags) differ
+// from the tags last applied by Terraform (tags), or nil if they are equal.
+func (tags KeyValueTags) Drift(newTags KeyValueTags) []Finding {
+	var changes []string
+
+	if v := tags.Updated(newTags); len(v) > 0 {
+		var added, changed []string
+		for _, k := range sortedKeys(v) {
+			if tags.KeyExists(k) {
+				changed = append(changed, k)
+			} else {
+				added = append(added, k)
+			}
+		}
+		if len(added) > 0 {
+			changes = append(changes, fmt.Sprintf("added: %s", quotedKeys(added)))
+		}
+		if len(changed) > 0 {
+			changes = append(changes, fmt.Sprintf("changed: %s", quotedKeys(changed)))
+		}
+	}
+	if v := tags.Removed(newTags); len(v) > 0 {
+		changes = append(changes, fmt.Sprintf("removed: %s", quotedKeys(sortedKeys(v))))
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	return []Finding{{
+		Summary: "Resource tags changed outside of Terraform",
+		Detail:  fmt.Sprintf("The resource's tags have been changed outside of Terraform (%s).", strings.Join(changes, "; ")),
+	}}
+}
+
+func sortedKeys(tags KeyValueTags) []string {
+	keys := tags.Keys()
+	slices.Sort(keys)
+
+	return keys
+}
+
+func quotedKeys(keys []string) string {
+	quoted := make([]string, len(keys))
+	for i, k := range keys {
+		quoted[i] = fmt.Sprintf("%q", k)
+	}
+
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestKeyValueTagsConfigConflicts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		ignoreConfig  *IgnoreConfig
+		want          []Finding
+	}{
+		{
+			name: "no config",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+		},
+		{
+			name: "default tag same value",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+			},
+		},
+		{
+			name: "default tag overridden",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key2": "default2",
+					"key3": "default3",
+				}),
+			},
+			want: []Finding{
+				{
+					Summary: "Resource tag overrides provider default tag",
+					Detail:  `The tag "key2" is set to "value2" in the resource's tags, overriding the value "default2" from the provider's default_tags.`,
+				},
+			},
+		},
+		{
+			name: "ignored tags",
+			tags: New(ctx, map[string]string{
+				"key1":    "value1",
+				"prefix1": "value2",
+				"key3":    "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New(ctx, []string{"key1"}),
+				KeyPrefixes: New(ctx, []string{"prefix"}),
+			},
+			want: []Finding{
+				{
+					Summary: "Resource tag is ignored by provider configuration",
+					Detail:  `The tag "key1" is set in the resource's tags but matches the provider's ignore_tags configuration. Changes to its value are not detected and the resource may show a perpetual difference.`,
+				},
+				{
+					Summary: "Resource tag is ignored by provider configuration",
+					Detail:  `The tag "prefix1" is set in the resource's tags but matches the provider's ignore_tags configuration. Changes to its value are not detected and the resource may show a perpetual difference.`,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.tags.ConfigConflicts(testCase.defaultConfig, testCase.ignoreConfig)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsDrift(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name    string
+		oldTags KeyValueTags
+		newTags KeyValueTags
+		want    []Finding
+	}{
+		{
+			name: "no changes",
+			oldTags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			newTags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+		},
+		{
+			name: "all changes",
+			oldTags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			newTags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "updated2",
+				"key4": "value4",
+				"key5": "value5",
+			}),
+			want: []Finding{
+				{
+					Summary: "Resource tags changed outside of Terraform",
+					Detail:  `The resource's tags have been changed outside of Terraform (added: "key4", "key5"; changed: "key2"; removed: "key3").`,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.oldTags.Drift(testCase.newTags)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
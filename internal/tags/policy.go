@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// PolicyConfig contains the provider's tagging policy, evaluated against the tags of all taggable resources.
+type PolicyConfig struct {
+	// AllowedValues maps tag keys to the values allowed for that key.
+	// A value ending in `*` allows any value with that prefix.
+	AllowedValues map[string][]string
+	// CanonicalKeys are tag keys whose capitalization must match exactly.
+	CanonicalKeys []string
+	// Error reports violations as errors instead of warnings.
+	Error bool
+	// KeyPattern, if set, must match all tag keys.
+	KeyPattern *regexp.Regexp
+	// RequiredKeys are tag keys that must be present.
+	RequiredKeys []string
+}
+
+// Merge merges another tagging policy into this one.
+// Allowed values for a tag key in this policy take precedence.
+func (p *PolicyConfig) Merge(other *PolicyConfig) {
+	if p == nil || other == nil {
+		return
+	}
+
+	for k, v := range other.AllowedValues {
+		if _, ok := p.AllowedValues[k]; ok {
+			continue
+		}
+		if p.AllowedValues == nil {
+			p.AllowedValues = make(map[string][]string)
+		}
+		p.AllowedValues[k] = v
+	}
+
+	for _, k := range other.CanonicalKeys {
+		if !slices.Contains(p.CanonicalKeys, k) {
+			p.CanonicalKeys = append(p.CanonicalKeys, k)
+		}
+	}
+
+	for _, k := range other.RequiredKeys {
+		if !slices.Contains(p.RequiredKeys, k) {
+			p.RequiredKeys = append(p.RequiredKeys, k)
+		}
+	}
+}
+
+// PolicyViolations returns findings for resource tags that do not comply with the specified tagging policy.
+func (tags KeyValueTags) PolicyViolations(policy *PolicyConfig) []Finding {
+	if policy == nil {
+		return nil
+	}
+
+	var findings []Finding
+
+	for _, k := range slices.Sorted(slices.Values(policy.RequiredKeys)) {
+		if !tags.KeyExists(k) {
+			findings = append(findings, Finding{
+				Summary: "Missing required tag",
+				Detail:  fmt.Sprintf("The tag %q is required by the provider's tag_policy configuration but is not set in the resource's tags or the provider's default_tags.", k),
+			})
+		}
+	}
+
+	for _, k := range sortedKeys(tags) {
+		v := tags[k].ValueString()
+
+		for _, canonicalKey := range policy.CanonicalKeys {
+			if k != canonicalKey && strings.EqualFold(k, canonicalKey) {
+				findings = append(findings, Finding{
+					Summary: "Non-compliant tag key",
+					Detail:  fmt.Sprintf("The tag %q does not match the capitalization %q required by the provider's tag_policy configuration.", k, canonicalKey),
+				})
+			}
+		}
+
+		if policy.KeyPattern != nil && !policy.KeyPattern.MatchString(k) {
+			findings = append(findings, Finding{
+				Summary: "Non-compliant tag key",
+				Detail:  fmt.Sprintf("The tag %q does not match the key pattern %q required by the provider's tag_policy configuration.", k, policy.KeyPattern.String()),
+			})
+		}
+
+		if allowedValues, ok := policy.AllowedValues[k]; ok && !slices.ContainsFunc(allowedValues, func(allowedValue string) bool {
+			return policyValueMatches(allowedValue, v)
+		}) {
+			findings = append(findings, Finding{
+				Summary: "Non-compliant tag value",
+				Detail: fmt.Sprintf("The tag %q has the value %q, which is not one of the values allowed by the provider's tag_policy configuration (%s).",
+					k, v, quotedKeys(allowedValues)),
+			})
+		}
+	}
+
+	return findings
+}
+
+func policyValueMatches(allowedValue, v string) bool {
+	if prefix, ok := strings.CutSuffix(allowedValue, "*"); ok {
+		return strings.HasPrefix(v, prefix)
+	}
+
+	return allowedValue == v
+}
+
+// An Organizations tag policy document.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_example-tag-policies.html.
+type organizationsTagPolicy struct {
+	Tags map[string]organizationsTagPolicyTag `json:"tags"`
+}
+
+type organizationsTagPolicyTag struct {
+	TagKey   *organizationsTagPolicyAssign[string]   `json:"tag_key"`
+	TagValue *organizationsTagPolicyAssign[[]string] `json:"tag_value"`
+}
+
+type organizationsTagPolicyAssign[T any] struct {
+	Assign T `json:"@@assign"`
+}
+
+// NewPolicyConfigFromOrganizationsDocument returns a tagging policy containing the rules from an
+// AWS Organizations tag policy document.
+// The document's tag keys are required to have the specified capitalization and, if present, one of the specified values.
+// `report_required_tag_for` rules are ignored as they apply to specific AWS resource types, e.g. `ec2:instance`,
+// which do not map to Terraform resource types. Use RequiredKeys to require tag keys.
+func NewPolicyConfigFromOrganizationsDocument(document string) (*PolicyConfig, error) {
+	var policy organizationsTagPolicy
+
+	if err := json.Unmarshal([]byte(document), &policy); err != nil {
+		return nil, fmt.Errorf("parsing Organizations tag policy document: %w", err)
+	}
+
+	config := &PolicyConfig{}
+
+	for name, tag := range policy.Tags {
+		key := name
+		if tag.TagKey != nil && tag.TagKey.Assign != "" {
+			key = tag.TagKey.Assign
+		}
+
+		config.CanonicalKeys = append(config.CanonicalKeys, key)
+
+		if tag.TagValue != nil && len(tag.TagValue.Assign) > 0 {
+			if config.AllowedValues == nil {
+				config.AllowedValues = make(map[string][]string)
+			}
+			config.AllowedValues[key] = tag.TagValue.Assign
+		}
+	}
+
+	slices.Sort(config.CanonicalKeys)
+
+	return config, nil
+}
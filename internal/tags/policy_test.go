@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestKeyValueTagsPolicyViolations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name   string
+		tags   KeyValueTags
+		policy *PolicyConfig
+		want   []Finding
+	}{
+		{
+			name: "no policy",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+		},
+		{
+			name: "compliant",
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "100-1",
+				"Environment": "prod",
+			}),
+			policy: &PolicyConfig{
+				AllowedValues: map[string][]string{
+					"CostCenter":  {"100*", "200"},
+					"Environment": {"dev", "prod"},
+				},
+				CanonicalKeys: []string{"CostCenter"},
+				KeyPattern:    regexp.MustCompile(`^[A-Z][A-Za-z]+$`),
+				RequiredKeys:  []string{"Environment"},
+			},
+		},
+		{
+			name: "missing required tags",
+			tags: New(ctx, map[string]string{
+				"Environment": "prod",
+			}),
+			policy: &PolicyConfig{
+				RequiredKeys: []string{"Owner", "CostCenter", "Environment"},
+			},
+			want: []Finding{
+				{
+					Summary: "Missing required tag",
+					Detail:  `The tag "CostCenter" is required by the provider's tag_policy configuration but is not set in the resource's tags or the provider's default_tags.`,
+				},
+				{
+					Summary: "Missing required tag",
+					Detail:  `The tag "Owner" is required by the provider's tag_policy configuration but is not set in the resource's tags or the provider's default_tags.`,
+				},
+			},
+		},
+		{
+			name: "non-compliant keys",
+			tags: New(ctx, map[string]string{
+				"costcenter": "100",
+				"Owner":      "me",
+			}),
+			policy: &PolicyConfig{
+				CanonicalKeys: []string{"CostCenter"},
+				KeyPattern:    regexp.MustCompile(`^[A-Z]`),
+			},
+			want: []Finding{
+				{
+					Summary: "Non-compliant tag key",
+					Detail:  `The tag "costcenter" does not match the capitalization "CostCenter" required by the provider's tag_policy configuration.`,
+				},
+				{
+					Summary: "Non-compliant tag key",
+					Detail:  `The tag "costcenter" does not match the key pattern "^[A-Z]" required by the provider's tag_policy configuration.`,
+				},
+			},
+		},
+		{
+			name: "non-compliant values",
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "300",
+				"Environment": "test",
+			}),
+			policy: &PolicyConfig{
+				AllowedValues: map[string][]string{
+					"CostCenter":  {"100*", "200"},
+					"Environment": {"dev", "prod"},
+				},
+			},
+			want: []Finding{
+				{
+					Summary: "Non-compliant tag value",
+					Detail:  `The tag "CostCenter" has the value "300", which is not one of the values allowed by the provider's tag_policy configuration ("100*", "200").`,
+				},
+				{
+					Summary: "Non-compliant tag value",
+					Detail:  `The tag "Environment" has the value "test", which is not one of the values allowed by the provider's tag_policy configuration ("dev", "prod").`,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.tags.PolicyViolations(testCase.policy)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewPolicyConfigFromOrganizationsDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		document string
+		want     *PolicyConfig
+		wantErr  bool
+	}{
+		{
+			name:     "invalid JSON",
+			document: `{`,
+			wantErr:  true,
+		},
+		{
+			name:     "empty",
+			document: `{}`,
+			want:     &PolicyConfig{},
+		},
+		{
+			name: "tags",
+			document: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {"@@assign": "CostCenter"},
+      "tag_value": {"@@assign": ["100", "200*"]},
+      "report_required_tag_for": {"@@assign": ["ec2:instance"]}
+    },
+    "owner": {
+      "tag_key": {"@@assign": "Owner"}
+    }
+  }
+}`,
+			want: &PolicyConfig{
+				AllowedValues: map[string][]string{
+					"CostCenter": {"100", "200*"},
+				},
+				CanonicalKeys: []string{"CostCenter", "Owner"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewPolicyConfigFromOrganizationsDocument(testCase.document)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("NewPolicyConfigFromOrganizationsDocument err %t, want %t", got, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyConfigMerge(t *testing.T) {
+	t.Parallel()
+
+	policy := &PolicyConfig{
+		AllowedValues: map[string][]string{
+			"Environment": {"dev"},
+		},
+		RequiredKeys: []string{"Owner"},
+	}
+	policy.Merge(&PolicyConfig{
+		AllowedValues: map[string][]string{
+			"CostCenter":  {"100"},
+			"Environment": {"prod"},
+		},
+		CanonicalKeys: []string{"CostCenter"},
+		RequiredKeys:  []string{"CostCenter", "Owner"},
+	})
+
+	want := &PolicyConfig{
+		AllowedValues: map[string][]string{
+			"CostCenter":  {"100"},
+			"Environment": {"dev"},
+		},
+		CanonicalKeys: []string{"CostCenter"},
+		RequiredKeys:  []string{"Owner", "CostCenter"},
+	}
+
+	if diff := cmp.Diff(policy, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import "testing"
+
+func TestIsAWSRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	for _, tc := range []struct {
+		region string
+		valid  bool
+	}{
+		{"us-west-2", true},      //lintignore:AWSAT003
+		{"us-gov-west-1", true},  //lintignore:AWSAT003
+		{"ap-southeast-2", true}, //lintignore:AWSAT003
+		{"us-west-2a", false},    //lintignore:AWSAT003
+		{"US-WEST-2", false},     //lintignore:AWSAT003
+		{"", false},
+		{"example.com", false},
+	} {
+		ok := IsAWSRegion(tc.region)
+		if got, want := ok, tc.valid; got != want {
+			t.Errorf("IsAWSRegion(%q) = %v, want %v", tc.region, got, want)
+		}
+	}
+}
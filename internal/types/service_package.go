@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/list"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // ServicePackageResourceTags represents resource-level tagging information.
@@ -18,6 +20,91 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceRegion represents resource-level Region information.
+type ServicePackageResourceRegion struct {
+	IsGlobal          bool // Is the resource global?
+	IsOverrideEnabled bool // Is per-resource Region override supported?
+}
+
+// ServicePackageResourceIdentity represents resource-level identity information.
+// A resource's identity consists of the AWS account ID, the AWS Region (for Regional resources)
+// and one or more resource-specific attributes, e.g. a bucket name or an ARN.
+type ServicePackageResourceIdentity struct {
+	IsARN              bool                // Is the identity the resource's ARN?
+	IsGlobalResource   bool                // Is the resource global?
+	IdentityAttributes []IdentityAttribute // Resource-specific identity attributes
+}
+
+// IdentityAttribute represents a resource-specific identity attribute.
+type IdentityAttribute struct {
+	Name     string
+	Required bool
+}
+
+// AttributeNames returns the names of the resource-specific identity attributes.
+func (i ServicePackageResourceIdentity) AttributeNames() []string {
+	v := make([]string, 0, len(i.IdentityAttributes))
+	for _, attr := range i.IdentityAttributes {
+		v = append(v, attr.Name)
+	}
+	return v
+}
+
+// Attributes returns the attributes of the resource's identity schema.
+// The AWS account ID and, for Regional resources, the AWS Region are optional attributes of identities that are not ARNs.
+func (i ServicePackageResourceIdentity) Attributes() []IdentityAttribute {
+	if i.IsARN {
+		return i.IdentityAttributes
+	}
+
+	v := []IdentityAttribute{{Name: names.AttrAccountID}}
+	if !i.IsGlobalResource {
+		v = append(v, IdentityAttribute{Name: names.AttrRegion})
+	}
+
+	return append(v, i.IdentityAttributes...)
+}
+
+// RegionalSingleParameterIdentity returns the identity of a Regional resource identified by a single attribute.
+func RegionalSingleParameterIdentity(name string) *ServicePackageResourceIdentity {
+	return RegionalParameterizedIdentity(IdentityAttribute{Name: name, Required: true})
+}
+
+// GlobalSingleParameterIdentity returns the identity of a global resource identified by a single attribute.
+func GlobalSingleParameterIdentity(name string) *ServicePackageResourceIdentity {
+	return GlobalParameterizedIdentity(IdentityAttribute{Name: name, Required: true})
+}
+
+// RegionalParameterizedIdentity returns the identity of a Regional resource identified by the specified attributes.
+func RegionalParameterizedIdentity(attributes ...IdentityAttribute) *ServicePackageResourceIdentity {
+	return &ServicePackageResourceIdentity{
+		IdentityAttributes: attributes,
+	}
+}
+
+// GlobalParameterizedIdentity returns the identity of a global resource identified by the specified attributes.
+func GlobalParameterizedIdentity(attributes ...IdentityAttribute) *ServicePackageResourceIdentity {
+	v := RegionalParameterizedIdentity(attributes...)
+	v.IsGlobalResource = true
+	return v
+}
+
+// RegionalARNIdentity returns the identity of a Regional resource identified by its ARN.
+// The account ID and Region are part of the ARN.
+func RegionalARNIdentity(name string) *ServicePackageResourceIdentity {
+	v := RegionalSingleParameterIdentity(name)
+	v.IsARN = true
+	return v
+}
+
+// GlobalARNIdentity returns the identity of a global resource identified by its ARN.
+// The account ID is part of the ARN.
+func GlobalARNIdentity(name string) *ServicePackageResourceIdentity {
+	v := GlobalSingleParameterIdentity(name)
+	v.IsARN = true
+	return v
+}
+
 // ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
 // implemented by a service package.
 type ServicePackageEphemeralResource struct {
@@ -26,6 +113,14 @@ type ServicePackageEphemeralResource struct {
 	Name     string
 }
 
+// ServicePackageListResource represents a list resource implemented by a service package.
+// List resources enumerate existing resources of the corresponding managed resource type.
+type ServicePackageListResource struct {
+	List     list.ListFunc
+	TypeName string
+	Name     string
+}
+
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
 // implemented by a service package.
 type ServicePackageFrameworkDataSource struct {
@@ -33,6 +128,7 @@ type ServicePackageFrameworkDataSource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   *ServicePackageResourceRegion
 }
 
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
@@ -42,6 +138,8 @@ type ServicePackageFrameworkResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   *ServicePackageResourceRegion
+	Identity *ServicePackageResourceIdentity
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -51,6 +149,7 @@ type ServicePackageSDKDataSource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   *ServicePackageResourceRegion
 }
 
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
@@ -60,4 +159,6 @@ type ServicePackageSDKResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   *ServicePackageResourceRegion
+	Identity *ServicePackageResourceIdentity
 }
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+	"gopkg.in/dnaeon/go-vcr.v3/recorder"
+)
+
+const (
+	// EnvVarMode is the environment variable used to enable provider-level recording or replaying.
+	// Valid values are RECORDING and REPLAYING.
+	EnvVarMode = "TF_AWS_VCR_MODE"
+	// EnvVarCassette is the environment variable containing the path of the cassette file, without the `.yaml` extension.
+	EnvVarCassette = "TF_AWS_VCR_CASSETTE"
+)
+
+var providerHTTPClients = struct {
+	lock    sync.Mutex
+	clients map[string]*http.Client
+}{
+	clients: make(map[string]*http.Client),
+}
+
+// IsProviderEnabled returns whether provider-level recording or replaying is enabled.
+func IsProviderEnabled() bool {
+	return os.Getenv(EnvVarMode) != "" && os.Getenv(EnvVarCassette) != ""
+}
+
+// ProviderHTTPClient returns the HTTP client used for AWS API calls when provider-level recording or replaying is enabled.
+// In RECORDING mode the cassette is overwritten and is saved after every interaction,
+// as there is no notification when the provider process exits.
+// In REPLAYING mode requests that have no recorded interaction fail without being retried.
+// A single HTTP client is returned for each cassette so that multiple provider configurations in the same process share interactions.
+func ProviderHTTPClient(ctx context.Context) (*http.Client, error) {
+	if !IsProviderEnabled() {
+		return nil, nil
+	}
+
+	cassetteName := os.Getenv(EnvVarCassette)
+
+	providerHTTPClients.lock.Lock()
+	defer providerHTTPClients.lock.Unlock()
+
+	if httpClient, ok := providerHTTPClients.clients[cassetteName]; ok {
+		return httpClient, nil
+	}
+
+	var mode recorder.Mode
+	switch v := os.Getenv(EnvVarMode); v {
+	case ModeRecording:
+		mode = recorder.ModeRecordOnly
+	case ModeReplaying:
+		mode = recorder.ModeReplayOnly
+	default:
+		return nil, fmt.Errorf("unsupported value for %s: %s", EnvVarMode, v)
+	}
+
+	httpClient, r, err := NewHTTPClient(ctx, cassetteName, mode)
+	if err != nil {
+		return nil, fmt.Errorf("creating VCR recorder (%s): %w", cassetteName, err)
+	}
+
+	tflog.Info(ctx, "Recording or replaying AWS API interactions", map[string]any{
+		"tf_aws.vcr.cassette": cassetteName,
+		"tf_aws.vcr.mode":     os.Getenv(EnvVarMode),
+	})
+
+	switch mode {
+	case recorder.ModeRecordOnly:
+		httpClient.Transport = &savingTransport{recorder: r}
+	case recorder.ModeReplayOnly:
+		httpClient.Transport = &replayingTransport{recorder: r}
+	}
+
+	providerHTTPClients.clients[cassetteName] = httpClient
+
+	return httpClient, nil
+}
+
+// savingTransport saves the cassette after every recorded interaction.
+// Interactions are recorded concurrently, but not while the cassette is being saved.
+type savingTransport struct {
+	lock     sync.RWMutex
+	recorder *recorder.Recorder
+}
+
+func (t *savingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.lock.RLock()
+	resp, err := t.recorder.RoundTrip(req)
+	t.lock.RUnlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	// In ModeRecordOnly Stop saves the cassette and the recorder remains usable.
+	if err := t.recorder.Stop(); err != nil {
+		return nil, fmt.Errorf("saving VCR cassette: %w", err)
+	}
+
+	return resp, nil
+}
+
+// replayingTransport fails requests with no recorded interaction without retries.
+type replayingTransport struct {
+	recorder *recorder.Recorder
+}
+
+func (t *replayingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.recorder.RoundTrip(req)
+	if errors.Is(err, cassette.ErrInteractionNotFound) {
+		return nil, &interactionNotFoundError{method: req.Method, url: redactURL(req.URL.String())}
+	}
+
+	return resp, err
+}
+
+// interactionNotFoundError is returned when a request has no recorded interaction.
+// It is treated as a canceled request by AWS SDK for Go v2 retryers so that it is not retried.
+type interactionNotFoundError struct {
+	method string
+	url    string
+}
+
+func (e *interactionNotFoundError) Error() string {
+	return fmt.Sprintf("%s %s: %s", e.method, e.url, cassette.ErrInteractionNotFound)
+}
+
+func (e *interactionNotFoundError) Unwrap() error {
+	return cassette.ErrInteractionNotFound
+}
+
+func (e *interactionNotFoundError) CanceledError() bool {
+	return true
+}
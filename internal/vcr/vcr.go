@@ -0,0 +1,378 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package vcr records AWS API interactions to a cassette and replays them.
+package vcr
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+	"gopkg.in/dnaeon/go-vcr.v3/recorder"
+)
+
+const (
+	// ModeRecording records all interactions with AWS APIs.
+	ModeRecording = "RECORDING"
+	// ModeReplaying replays previously recorded interactions with AWS APIs. No network access is required.
+	ModeReplaying = "REPLAYING"
+)
+
+const (
+	imdsTokenPath = "/latest/api/token"
+	redacted      = "REDACTED"
+)
+
+var (
+	// Sensitive HTTP request headers. These are removed.
+	sensitiveHeaders = []string{
+		"Authorization",
+		"X-Amz-Security-Token",
+		"X-Aws-Ec2-Metadata-Token",
+	}
+
+	// Sensitive URL query parameters, e.g. from presigned URLs.
+	sensitiveQueryParameters = []string{
+		"X-Amz-Credential",
+		"X-Amz-Security-Token",
+		"X-Amz-Signature",
+	}
+
+	// Sensitive request and response body fields, matched case-insensitively.
+	// These are credentials and tokens returned by AWS STS, AWS SSO, Amazon ECR, the EC2 instance metadata service (IMDS),
+	// the ECS container credentials endpoint and similar, and secret values sent to and returned by AWS Secrets Manager, AWS KMS, Amazon RDS, Amazon Redshift and Amazon DocumentDB.
+	// SSM SecureString parameter values are redacted separately as the field name is too general.
+	sensitiveFields = []string{
+		"accessToken",
+		"authorizationToken",
+		"MasterUserPassword",
+		"password",
+		"Plaintext",
+		"RandomPassword",
+		"SAMLAssertion",
+		"SecretAccessKey",
+		"SecretBinary",
+		"SecretString",
+		"SessionToken",
+		"Token",
+		"WebIdentityToken",
+	}
+
+	// Idempotency token request fields, matched case-insensitively.
+	// The AWS SDK generates a random value for each request, so these are ignored when matching requests.
+	idempotencyTokenFields = []string{
+		"ClientRequestToken",
+		"ClientToken",
+		"IdempotencyToken",
+	}
+
+	sensitiveJSONFieldRegexp = regexache.MustCompile(`(?i)("(?:` + strings.Join(sensitiveFields, "|") + `)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	sensitiveXMLFieldRegexp  = regexache.MustCompile(`(?i)<(` + strings.Join(sensitiveFields, "|") + `)>[^<]*</`)
+	sensitiveFormFieldRegexp = regexache.MustCompile(`(?i)(^|&)(` + strings.Join(sensitiveFields, "|") + `)=[^&]*`)
+
+	idempotencyTokenJSONFieldRegexp = regexache.MustCompile(`(?i)("(?:` + strings.Join(idempotencyTokenFields, "|") + `)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	idempotencyTokenXMLFieldRegexp  = regexache.MustCompile(`(?i)<(` + strings.Join(idempotencyTokenFields, "|") + `)>[^<]*</`)
+	idempotencyTokenFormFieldRegexp = regexache.MustCompile(`(?i)(^|&)(` + strings.Join(idempotencyTokenFields, "|") + `)=[^&]*`)
+)
+
+// ParseMode returns the recorder mode corresponding to the specified value.
+func ParseMode(v string) (recorder.Mode, error) {
+	switch v {
+	case ModeRecording:
+		return recorder.ModeRecordOnce, nil
+	case ModeReplaying:
+		return recorder.ModeReplayOnly, nil
+	default:
+		return recorder.ModePassthrough, fmt.Errorf("unsupported VCR mode: %s", v)
+	}
+}
+
+// NewHTTPClient returns a new HTTP client for AWS API calls whose transport is the returned VCR recorder.
+// Sensitive values are redacted from recorded interactions.
+func NewHTTPClient(ctx context.Context, cassetteName string, mode recorder.Mode) (*http.Client, *recorder.Recorder, error) {
+	// Cribbed from aws-sdk-go-base.
+	httpClient := cleanhttp.DefaultPooledClient()
+	transport := httpClient.Transport.(*http.Transport)
+	transport.MaxIdleConnsPerHost = 10
+	tlsConfig := transport.TLSClientConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+		transport.TLSClientConfig = tlsConfig
+	}
+	tlsConfig.MinVersion = tls.VersionTLS12
+
+	// Create a VCR recorder around a default HTTP client.
+	r, err := recorder.NewWithOptions(&recorder.Options{
+		CassetteName:       cassetteName,
+		Mode:               mode,
+		RealTransport:      httpClient.Transport,
+		SkipRequestLatency: mode == recorder.ModeReplayOnly,
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Remove sensitive values from the saved cassette.
+	// Responses to recorded requests are built from the captured interaction, so redacting it after capture
+	// would also hide the values from the AWS SDK, e.g. the IMDS session token needed for subsequent requests.
+	r.AddHook(redactInteraction, recorder.BeforeSaveHook)
+
+	// Defines how VCR will match requests to responses.
+	r.SetMatcher(matcher(ctx))
+
+	httpClient.Transport = r
+
+	return httpClient, r, nil
+}
+
+// redactInteraction removes sensitive values from a captured interaction.
+func redactInteraction(i *cassette.Interaction) error {
+	for _, v := range sensitiveHeaders {
+		i.Request.Headers.Del(v)
+	}
+
+	i.Request.URL = redactURL(i.Request.URL)
+	i.Request.Body = redactBody(i.Request.Body)
+	for k := range i.Request.Form {
+		if isSensitiveField(k) {
+			i.Request.Form[k] = []string{redacted}
+		}
+	}
+
+	i.Response.Body = redactBody(i.Response.Body)
+	// The IMDSv2 session token is the entire response body.
+	if u, err := url.Parse(i.Request.URL); err == nil && u.Path == imdsTokenPath {
+		i.Response.Body = redacted
+	}
+
+	return nil
+}
+
+// redactURL removes sensitive query parameter values from the specified URL.
+func redactURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return s
+	}
+
+	query := u.Query()
+	var found bool
+	for _, v := range sensitiveQueryParameters {
+		if query.Has(v) {
+			query.Set(v, redacted)
+			found = true
+		}
+	}
+
+	if !found {
+		return s
+	}
+
+	u.RawQuery = query.Encode()
+
+	return u.String()
+}
+
+// redactBody removes sensitive field values from the specified JSON, XML or form-encoded body.
+func redactBody(s string) string {
+	s = sensitiveJSONFieldRegexp.ReplaceAllString(s, `$1"`+redacted+`"`)
+	s = sensitiveXMLFieldRegexp.ReplaceAllString(s, `<$1>`+redacted+`</`)
+	s = sensitiveFormFieldRegexp.ReplaceAllString(s, `$1$2=`+redacted)
+	s = redactSecureStringValues(s)
+
+	return s
+}
+
+// redactSecureStringValues removes the values of SSM SecureString parameters from the specified JSON body.
+// A parameter's value is only redacted if its type is in the same JSON object.
+func redactSecureStringValues(s string) string {
+	if !strings.Contains(s, `"SecureString"`) {
+		return s
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return s
+	}
+
+	if !redactSecureStringValue(v) {
+		return s
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return s
+	}
+
+	return string(b)
+}
+
+func redactSecureStringValue(v any) bool {
+	var found bool
+
+	switch v := v.(type) {
+	case map[string]any:
+		if v["Type"] == "SecureString" {
+			if _, ok := v["Value"]; ok {
+				v["Value"] = redacted
+				found = true
+			}
+		}
+		for _, v := range v {
+			found = redactSecureStringValue(v) || found
+		}
+	case []any:
+		for _, v := range v {
+			found = redactSecureStringValue(v) || found
+		}
+	}
+
+	return found
+}
+
+// ignoreIdempotencyTokens removes idempotency token values from the specified JSON, XML or form-encoded body.
+func ignoreIdempotencyTokens(s string) string {
+	s = idempotencyTokenJSONFieldRegexp.ReplaceAllString(s, `$1""`)
+	s = idempotencyTokenXMLFieldRegexp.ReplaceAllString(s, `<$1></`)
+	s = idempotencyTokenFormFieldRegexp.ReplaceAllString(s, `$1$2=`)
+
+	return s
+}
+
+// ignoreIdempotencyTokensInURL removes idempotency token query parameter values from the specified URL.
+func ignoreIdempotencyTokensInURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return s
+	}
+
+	query := u.Query()
+	var found bool
+	for k := range query {
+		if isIdempotencyTokenField(k) {
+			query.Set(k, "")
+			found = true
+		}
+	}
+
+	if !found {
+		return s
+	}
+
+	u.RawQuery = query.Encode()
+
+	return u.String()
+}
+
+func isSensitiveField(s string) bool {
+	for _, v := range sensitiveFields {
+		if strings.EqualFold(s, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isIdempotencyTokenField(s string) bool {
+	for _, v := range idempotencyTokenFields {
+		if strings.EqualFold(s, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matcher returns a function that matches live requests to recorded interactions.
+// Sensitive values are redacted from the live request before matching.
+// Idempotency tokens generated by the AWS SDK are ignored.
+func matcher(ctx context.Context) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method || ignoreIdempotencyTokensInURL(redactURL(r.URL.String())) != ignoreIdempotencyTokensInURL(i.URL) {
+			return false
+		}
+
+		if r.Body == nil {
+			return true
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		r.Body = io.NopCloser(&b)
+		body := ignoreIdempotencyTokens(redactBody(b.String()))
+		recordedBody := ignoreIdempotencyTokens(i.Body)
+		// If body matches identically, we are done.
+		if body == recordedBody {
+			return true
+		}
+
+		// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
+		switch contentType := r.Header.Get("Content-Type"); contentType {
+		case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+			// JSON might be the same, but reordered. Try parsing and comparing.
+			var requestJson, cassetteJson interface{}
+
+			if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			if err := json.Unmarshal([]byte(recordedBody), &cassetteJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			return reflect.DeepEqual(requestJson, cassetteJson)
+
+		case "application/xml":
+			// XML might be the same, but reordered. Try parsing and comparing.
+			var requestXml, cassetteXml interface{}
+
+			if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			if err := xml.Unmarshal([]byte(recordedBody), &cassetteXml); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			return reflect.DeepEqual(requestXml, cassetteXml)
+		}
+
+		return false
+	}
+}
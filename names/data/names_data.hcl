@@ -45,6 +45,7 @@ service "account" {
   provider_package_correct = "account"
   doc_prefix               = ["account_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "acm" {
@@ -1002,6 +1003,7 @@ service "ce" {
   provider_package_correct = "ce"
   doc_prefix               = ["ce_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "chatbot" {
@@ -1378,6 +1380,7 @@ service "cloudfront" {
   provider_package_correct = "cloudfront"
   doc_prefix               = ["cloudfront_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "cloudfrontkeyvaluestore" {
@@ -2465,6 +2468,7 @@ service "costoptimizationhub" {
   provider_package_correct = "costoptimizationhub"
   doc_prefix               = ["costoptimizationhub_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "cur" {
@@ -2499,6 +2503,7 @@ service "cur" {
   provider_package_correct = "cur"
   doc_prefix               = ["cur_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "dataexchange" {
@@ -3956,6 +3961,7 @@ service "globalaccelerator" {
   provider_package_correct = "globalaccelerator"
   doc_prefix               = ["globalaccelerator_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "glue" {
@@ -4159,6 +4165,7 @@ service "iam" {
   provider_package_correct = "iam"
   doc_prefix               = ["iam_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "inspector" {
@@ -6086,6 +6093,7 @@ service "networkmanager" {
   provider_package_correct = "networkmanager"
   doc_prefix               = ["networkmanager_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "nimble" {
@@ -6303,6 +6311,7 @@ service "organizations" {
   provider_package_correct = "organizations"
   doc_prefix               = ["organizations_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "outposts" {
@@ -7266,6 +7275,7 @@ service "route53" {
   provider_package_correct = "route53"
   doc_prefix               = ["route53_cidr_", "route53_delegation_", "route53_health_", "route53_hosted_", "route53_key_", "route53_query_", "route53_record", "route53_traffic_", "route53_vpc_", "route53_zone"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "route53domains" {
@@ -7294,6 +7304,7 @@ service "route53domains" {
   provider_package_correct = "route53domains"
   doc_prefix               = ["route53domains_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "route53profiles" {
@@ -7379,6 +7390,7 @@ service "route53recoverycontrolconfig" {
   provider_package_correct = "route53recoverycontrolconfig"
   doc_prefix               = ["route53recoverycontrolconfig_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "route53recoveryreadiness" {
@@ -7412,6 +7424,7 @@ service "route53recoveryreadiness" {
   provider_package_correct = "route53recoveryreadiness"
   doc_prefix               = ["route53recoveryreadiness_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "route53resolver" {
@@ -8119,6 +8132,7 @@ service "shield" {
   provider_package_correct = "shield"
   doc_prefix               = ["shield_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "signer" {
@@ -8415,7 +8429,6 @@ service "sso" {
   endpoint_info {
     endpoint_api_call   = "ListAccounts"
     endpoint_api_params = "AccessToken: aws.String(\"mock-access-token\")"
-    endpoint_only       = true
   }
 
   resource_prefix {
@@ -8425,7 +8438,6 @@ service "sso" {
   provider_package_correct = "sso"
   doc_prefix               = ["sso_"]
   brand                    = "AWS"
-  not_implemented          = true
 }
 
 service "ssoadmin" {
@@ -8932,6 +8944,7 @@ service "waf" {
   provider_package_correct = "waf"
   doc_prefix               = ["waf_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "wafregional" {
@@ -8984,6 +8997,7 @@ service "budgets" {
   provider_package_correct = "budgets"
   doc_prefix               = ["budgets_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "wellarchitected" {
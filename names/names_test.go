@@ -388,3 +388,49 @@ func TestFullHumanFriendly(t *testing.T) {
 		})
 	}
 }
+
+func TestIsGlobal(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected bool
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Expected: false,
+		},
+		{
+			TestName: IAM,
+			Input:    IAM,
+			Expected: true,
+		},
+		{
+			TestName: Route53,
+			Input:    Route53,
+			Expected: true,
+		},
+		{
+			TestName: SQS,
+			Input:    SQS,
+			Expected: false,
+		},
+		{
+			TestName: "doesnotexist",
+			Input:    "doesnotexist",
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			if got := IsGlobal(testCase.Input); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
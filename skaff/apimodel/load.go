@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimodel
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	requiredMarker     = "This member is required."
+	typesPackagePrefix = "types."
+)
+
+// Package is the API model of an AWS SDK for Go v2 service package.
+type Package struct {
+	// Name is the Go package name, e.g. "neptunegraph".
+	Name string
+
+	structs   map[string]*structType // Keyed by type name, "types." prefixed for the types package.
+	enums     map[string][]EnumValue
+	unions    map[string]bool
+	functions map[string]bool
+}
+
+// EnumValue is a value of an SDK string enum.
+type EnumValue struct {
+	// Name is the Go constant name, e.g. "GraphStatusAvailable".
+	Name string
+	// Value is the enum value, e.g. "AVAILABLE".
+	Value string
+}
+
+type structType struct {
+	name   string
+	fields []*structField
+}
+
+type structField struct {
+	name     string
+	typ      ast.Expr
+	inTypes  bool
+	required bool
+}
+
+// PackageDir returns the directory containing the source of the specified AWS SDK for Go v2 service package,
+// e.g. "neptunegraph", as resolved from the current module.
+func PackageDir(sdkPackage string) (string, error) {
+	modulePath := "github.com/aws/aws-sdk-go-v2/service/" + sdkPackage
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", modulePath)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("locating %s: %w: %s", modulePath, err, strings.TrimSpace(stderr.String()))
+	}
+
+	dir := strings.TrimSpace(stdout.String())
+	if dir == "" {
+		return "", fmt.Errorf("locating %s: module not downloaded", modulePath)
+	}
+
+	return dir, nil
+}
+
+// Load parses the Go source of an AWS SDK for Go v2 service package, and its types sub-package, from the specified directory.
+func Load(dir string) (*Package, error) {
+	pkg := &Package{
+		structs:   make(map[string]*structType),
+		enums:     make(map[string][]EnumValue),
+		unions:    make(map[string]bool),
+		functions: make(map[string]bool),
+	}
+
+	if err := pkg.parseDir(dir, false); err != nil {
+		return nil, err
+	}
+
+	if err := pkg.parseDir(filepath.Join(dir, "types"), true); err != nil {
+		return nil, err
+	}
+
+	if pkg.Name == "" {
+		return nil, fmt.Errorf("no Go source found in %s", dir)
+	}
+
+	return pkg, nil
+}
+
+func (p *Package) parseDir(dir string, inTypes bool) error {
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return err
+	}
+
+	fset := token.NewFileSet()
+
+	for _, filename := range filenames {
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filename, nil, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			return fmt.Errorf("parsing %s: %w", filename, err)
+		}
+
+		if !inTypes {
+			p.Name = file.Name.Name
+		}
+
+		p.parseFile(file, inTypes)
+	}
+
+	return nil
+}
+
+func (p *Package) parseFile(file *ast.File, inTypes bool) {
+	prefix := ""
+	if inTypes {
+		prefix = typesPackagePrefix
+	}
+
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv == nil && !inTypes {
+				p.functions[decl.Name.Name] = true
+			}
+
+		case *ast.GenDecl:
+			switch decl.Tok {
+			case token.TYPE:
+				for _, spec := range decl.Specs {
+					spec := spec.(*ast.TypeSpec)
+					name := prefix + spec.Name.Name
+
+					switch typ := spec.Type.(type) {
+					case *ast.StructType:
+						p.structs[name] = newStructType(name, typ, inTypes)
+					case *ast.Ident:
+						if typ.Name == "string" {
+							if _, ok := p.enums[name]; !ok {
+								p.enums[name] = nil
+							}
+						}
+					case *ast.InterfaceType:
+						p.unions[name] = true
+					}
+				}
+
+			case token.CONST:
+				for _, spec := range decl.Specs {
+					spec := spec.(*ast.ValueSpec)
+					ident, ok := spec.Type.(*ast.Ident)
+					if !ok || len(spec.Values) != len(spec.Names) {
+						continue
+					}
+
+					for i, v := range spec.Values {
+						lit, ok := v.(*ast.BasicLit)
+						if !ok || lit.Kind != token.STRING {
+							continue
+						}
+
+						value, err := strconv.Unquote(lit.Value)
+						if err != nil {
+							continue
+						}
+
+						name := prefix + ident.Name
+						p.enums[name] = append(p.enums[name], EnumValue{Name: spec.Names[i].Name, Value: value})
+					}
+				}
+			}
+		}
+	}
+}
+
+func newStructType(name string, typ *ast.StructType, inTypes bool) *structType {
+	s := &structType{name: name}
+
+	for _, f := range typ.Fields.List {
+		// Skip embedded fields, e.g. noSmithyDocumentSerde.
+		if len(f.Names) == 0 {
+			continue
+		}
+
+		for _, ident := range f.Names {
+			if !ident.IsExported() || ident.Name == "ResultMetadata" {
+				continue
+			}
+
+			s.fields = append(s.fields, &structField{
+				name:     ident.Name,
+				typ:      f.Type,
+				inTypes:  inTypes,
+				required: f.Doc != nil && strings.Contains(f.Doc.Text(), requiredMarker),
+			})
+		}
+	}
+
+	return s
+}
+
+// operation returns the input and output types of the named operation.
+func (p *Package) operation(name string) (*structType, *structType, error) {
+	input, ok := p.structs[name+"Input"]
+	if !ok {
+		return nil, nil, fmt.Errorf("operation %s.%s not found", p.Name, name)
+	}
+
+	output, ok := p.structs[name+"Output"]
+	if !ok {
+		return nil, nil, fmt.Errorf("operation %s.%s not found", p.Name, name)
+	}
+
+	return input, output, nil
+}
+
+// hasPaginator returns whether the package has a paginator for the named operation.
+func (p *Package) hasPaginator(operation string) bool {
+	return p.functions["New"+operation+"Paginator"]
+}
+
+// notFoundError returns the name of the types package error returned when a resource is not found.
+func (p *Package) notFoundError() string {
+	for _, v := range []string{"ResourceNotFoundException", "NotFoundException"} {
+		if _, ok := p.structs[typesPackagePrefix+v]; ok {
+			return v
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,721 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimodel
+
+import (
+	"fmt"
+	"go/ast"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+// maxNestingDepth limits the depth of generated nested models.
+const maxNestingDepth = 5
+
+// Operations names the AWS API operations that implement a resource's lifecycle.
+type Operations struct {
+	Create string
+	Read   string
+	Update string // Optional.
+	Delete string
+	List   string // Optional.
+}
+
+// Resource is the Terraform resource derived from an API model.
+type Resource struct {
+	Operations Operations
+	Object
+
+	// Identifier is the attribute passed to the Read and Delete operations.
+	Identifier *Attribute
+	// IdentifierField is the Read and Delete input field that holds the identifier.
+	IdentifierField string
+	// ARN is the ARN attribute, if any.
+	ARN *Attribute
+	// Tags is whether the Create operation accepts tags.
+	Tags bool
+	// Models are the nested object models, sorted by name.
+	Models []*Model
+
+	// CreateOutputField is the Create output field holding the created resource, or empty if the output is flat.
+	CreateOutputField string
+	// ReadOutputField is the Read output field holding the resource, or empty if the output is flat.
+	ReadOutputField string
+	// ReadOutputType is the finder's return type, e.g. "awstypes.Graph".
+	ReadOutputType string
+	// NotFoundError is the types package error returned when the resource is not found.
+	NotFoundError string
+	// Status is the resource's status attribute, if any.
+	Status *Status
+	// Updatable are the attributes that are passed to the Update operation.
+	Updatable []*Attribute
+
+	// List is the plural data source, if a List operation is named.
+	List *List
+
+	// TODOs are the API model constructs that could not be translated.
+	TODOs []string
+
+	pkg      *Package
+	name     string
+	models   map[string]*Model
+	prefixed bool
+}
+
+// Object is a set of schema attributes and blocks.
+type Object struct {
+	Attributes []*Attribute
+}
+
+// NonBlocks returns the attributes that are not blocks.
+func (o Object) NonBlocks() []*Attribute {
+	return slices.DeleteFunc(slices.Clone(o.Attributes), func(v *Attribute) bool {
+		return v.Block
+	})
+}
+
+// Blocks returns the attributes that are blocks.
+func (o Object) Blocks() []*Attribute {
+	return slices.DeleteFunc(slices.Clone(o.Attributes), func(v *Attribute) bool {
+		return !v.Block
+	})
+}
+
+// Attribute is a schema attribute, or block, and its model field.
+type Attribute struct {
+	// Name is the model field name, e.g. "GraphName".
+	Name string
+	// TFName is the schema attribute name, e.g. "graph_name".
+	TFName string
+	// ModelType is the model field type, e.g. "types.String".
+	ModelType string
+	// SchemaType is the schema attribute type, e.g. "String" for schema.StringAttribute.
+	SchemaType string
+	// CustomType is the schema attribute's custom type expression, if any.
+	CustomType string
+	// ElementType is the schema attribute's element type expression, for collections.
+	ElementType string
+	// GoType is the underlying Go type, e.g. "string".
+	GoType string
+
+	Required           bool
+	Optional           bool
+	Computed           bool
+	RequiresReplace    bool
+	UseStateForUnknown bool
+
+	// Block is whether the attribute is a nested block. A required block must have at least one element.
+	Block bool
+	// MaxItems1 is whether a nested block holds at most one element.
+	MaxItems1 bool
+	// Nested is the block's nested object.
+	Nested *Object
+
+	field string
+}
+
+// PlanModifierPackage returns the name of the plan modifier package for the attribute, e.g. "stringplanmodifier".
+func (a *Attribute) PlanModifierPackage() string {
+	return strings.ToLower(a.SchemaType) + "planmodifier"
+}
+
+// Model is a nested object model.
+type Model struct {
+	// Name is the model type name, e.g. "vectorSearchConfigurationModel".
+	Name string
+	Object
+}
+
+// Status is a resource's status attribute and its values.
+type Status struct {
+	// Field is the status field of the resource, e.g. "Status".
+	Field string
+	// Type is the status enum type, e.g. "awstypes.GraphStatus".
+	Type string
+
+	Creating []EnumValue
+	Updating []EnumValue
+	Deleting []EnumValue
+	Target   []EnumValue
+}
+
+// Slice returns the expression for a slice of the string values of the specified enum values.
+func (s *Status) Slice(values ...[]EnumValue) string {
+	var consts []string
+	for _, v := range values {
+		for _, v := range v {
+			consts = append(consts, v.Name)
+		}
+	}
+
+	if len(consts) == 0 {
+		return "[]string{}"
+	}
+
+	return "enum.Slice(" + strings.Join(consts, ", ") + ")"
+}
+
+// List is a plural data source derived from a List operation.
+type List struct {
+	Object
+
+	// Name is the data source's Go name, e.g. "Graphs".
+	Name string
+	// Paginated is whether the SDK has a paginator for the List operation.
+	Paginated bool
+	// ItemsField is the List output field holding the resources, e.g. "Graphs".
+	ItemsField string
+	// ItemsType is the resource summary type, e.g. "awstypes.GraphSummary".
+	ItemsType string
+	// ItemsModel is the resource summary model, e.g. "graphSummaryModel".
+	ItemsModel string
+	// Items is the data source attribute holding the resources.
+	Items *Attribute
+	// IdentifierField is the resource summary field holding the resource identifier, if any.
+	IdentifierField string
+	// ItemsAreIdentifiers is whether the List output holds resource identifiers rather than resource summaries.
+	ItemsAreIdentifiers bool
+	// Models are the nested object models used only by the data source, sorted by name.
+	Models []*Model
+}
+
+type mode int
+
+const (
+	modeInput mode = iota
+	modeComputed
+)
+
+var (
+	// skippedFields are input and output fields that are never schema attributes.
+	skippedFields = []string{
+		"ClientRequestToken",
+		"ClientToken",
+		"DryRun",
+		"Limit",
+		"MaxResults",
+		"NextToken",
+		"Tags",
+	}
+
+	statusFields = []string{"Status", "State"}
+
+	creatingStatuses = []string{"CREAT", "PENDING", "PROVISION", "START", "IMPORT", "INITIALIZ", "IN_PROGRESS"}
+	updatingStatuses = []string{"UPDAT", "MODIF"}
+	deletingStatuses = []string{"DELET"}
+	targetStatuses   = []string{"ACTIVE", "AVAILABLE", "CREATED", "READY", "RUNNING", "SUCCEEDED", "COMPLETE", "ENABLED", "IN_SERVICE"}
+)
+
+// Resource derives a Terraform resource with the specified Go name, e.g. "Graph", from the named operations.
+func (p *Package) Resource(name string, ops Operations) (*Resource, error) {
+	if ops.Create == "" || ops.Read == "" || ops.Delete == "" {
+		return nil, fmt.Errorf("create, read and delete operations are required")
+	}
+
+	r := &Resource{
+		Operations:    ops,
+		NotFoundError: p.notFoundError(),
+		pkg:           p,
+		name:          name,
+		models:        make(map[string]*Model),
+	}
+
+	createInput, createOutput, err := p.operation(ops.Create)
+	if err != nil {
+		return nil, err
+	}
+
+	readInput, readOutput, err := p.operation(ops.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err := p.operation(ops.Delete); err != nil {
+		return nil, err
+	}
+
+	updateFields := make(map[string]*structField)
+	if ops.Update != "" {
+		updateInput, _, err := p.operation(ops.Update)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, f := range updateInput.fields {
+			updateFields[f.name] = f
+		}
+	}
+
+	readShape, readField := p.outputShape(readOutput)
+	r.ReadOutputField = readField
+	if readField != "" {
+		r.ReadOutputType = "awstypes." + strings.TrimPrefix(readShape.name, typesPackagePrefix)
+	} else {
+		r.ReadOutputType = p.Name + "." + readOutput.name
+	}
+
+	createShape, createField := p.outputShape(createOutput)
+	r.CreateOutputField = createField
+
+	for _, s := range []*structType{createInput, createShape, readShape} {
+		for _, f := range s.fields {
+			if f.name != r.fieldName(f.name) && !strings.EqualFold(f.name, r.fieldName(f.name)) {
+				r.prefixed = true
+			}
+		}
+	}
+
+	// Arguments.
+	for _, f := range createInput.fields {
+		if f.name == "Tags" {
+			r.Tags = true
+		}
+
+		if slices.Contains(skippedFields, f.name) {
+			continue
+		}
+
+		attr := r.attribute(f, modeInput, nil)
+		if attr == nil {
+			continue
+		}
+
+		if _, ok := updateFields[f.name]; !ok {
+			attr.RequiresReplace = true
+		}
+
+		// Optional scalar arguments that are also returned by the Read operation may have a service default.
+		if attr.Optional && !attr.Block && (readShape.field(f.name) != nil || readShape.field(strings.TrimPrefix(f.name, name)) != nil) {
+			attr.Computed = true
+			attr.UseStateForUnknown = attr.RequiresReplace
+		}
+
+		r.add(attr)
+	}
+
+	// Attributes.
+	for _, s := range []*structType{readShape, createShape} {
+		for _, f := range s.fields {
+			if slices.Contains(skippedFields, f.name) || r.lookup(r.fieldName(f.name)) != nil {
+				continue
+			}
+
+			// e.g. Graph.Name is the GraphName argument.
+			if r.lookup(name+f.name) != nil {
+				r.prefixed = true
+				continue
+			}
+
+			attr := r.attribute(f, modeComputed, nil)
+			if attr == nil {
+				continue
+			}
+
+			if slices.Contains(statusFields, strings.TrimPrefix(f.name, name)) && strings.HasPrefix(attr.ModelType, "fwtypes.StringEnum") {
+				r.Status = p.status(f)
+			} else {
+				attr.UseStateForUnknown = true
+			}
+
+			r.add(attr)
+		}
+	}
+
+	// Identifier.
+	for _, f := range readInput.fields {
+		if f.required {
+			r.IdentifierField = f.name
+			break
+		}
+	}
+	if r.IdentifierField == "" {
+		return nil, fmt.Errorf("operation %s.%s has no required input field", p.Name, ops.Read)
+	}
+
+	for _, v := range identifierCandidates(name, r.IdentifierField) {
+		if attr := r.lookup(r.fieldName(v)); attr != nil {
+			r.Identifier = attr
+			break
+		}
+	}
+	if r.Identifier == nil {
+		r.TODOs = append(r.TODOs, fmt.Sprintf("set %s from the %s output", r.IdentifierField, ops.Create))
+		r.Identifier = r.attribute(&structField{name: r.IdentifierField, typ: ast.NewIdent("string")}, modeComputed, nil)
+		r.Identifier.UseStateForUnknown = true
+		r.add(r.Identifier)
+	}
+
+	r.ARN = r.lookup("ARN")
+
+	for _, attr := range r.Attributes {
+		if _, ok := updateFields[attr.field]; ok {
+			r.Updatable = append(r.Updatable, attr)
+		}
+	}
+
+	sortAttributes(r.Attributes)
+	r.Models = r.sortedModels(nil)
+
+	if ops.List != "" {
+		if r.List, err = r.list(ops.List); err != nil {
+			return nil, err
+		}
+
+		r.List.Models = r.sortedModels(r.Models)
+	}
+
+	return r, nil
+}
+
+// IdentifierIsID returns whether the identifier is the "id" attribute.
+func (r *Resource) IdentifierIsID() bool {
+	return r.Identifier.TFName == names.AttrID
+}
+
+// FieldNamePrefix returns the AutoFlex field name prefix, if needed.
+// A prefix is needed if API fields such as "GraphId" map to model fields such as "ID".
+func (r *Resource) FieldNamePrefix() string {
+	if r.prefixed {
+		return r.name
+	}
+
+	return ""
+}
+
+// sortedModels returns the nested object models, excluding those already in the specified models.
+func (r *Resource) sortedModels(exclude []*Model) []*Model {
+	var models []*Model
+
+	for _, v := range r.models {
+		if !slices.Contains(exclude, v) {
+			models = append(models, v)
+		}
+	}
+
+	slices.SortFunc(models, func(a, b *Model) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return models
+}
+
+func (r *Resource) add(attr *Attribute) {
+	r.Attributes = append(r.Attributes, attr)
+}
+
+func (r *Resource) lookup(name string) *Attribute {
+	for _, attr := range r.Attributes {
+		if attr.Name == name {
+			return attr
+		}
+	}
+
+	return nil
+}
+
+// fieldName returns the model field name for a top-level SDK field name.
+// The resource name is removed from identifier fields, e.g. "GraphId" becomes "ID".
+func (r *Resource) fieldName(name string) string {
+	switch strings.TrimPrefix(name, r.name) {
+	case "Arn":
+		return "ARN"
+	case "Id":
+		return "ID"
+	}
+
+	return name
+}
+
+// attribute returns the schema attribute for a struct field, or nil if the field's type is not supported.
+func (r *Resource) attribute(f *structField, m mode, stack []string) *Attribute {
+	name := f.name
+	if stack == nil {
+		name = r.fieldName(name)
+	}
+
+	attr := &Attribute{
+		Name:   name,
+		TFName: names.ToSnakeCase(name),
+		field:  f.name,
+	}
+
+	switch m {
+	case modeInput:
+		attr.Required = f.required
+		attr.Optional = !f.required
+	case modeComputed:
+		attr.Computed = true
+	}
+
+	typ, ok := r.pkg.resolve(f.typ, f.inTypes)
+	if !ok {
+		r.todo(f, stack, r.pkg.unsupported(f.typ, f.inTypes))
+		return nil
+	}
+
+	switch typ.kind {
+	case kindString:
+		attr.GoType = "string"
+		if attr.Name == "ARN" && m == modeComputed {
+			attr.ModelType, attr.SchemaType = "types.String", "String"
+		} else if strings.HasSuffix(f.name, "Arn") {
+			attr.ModelType, attr.SchemaType, attr.CustomType = "fwtypes.ARN", "String", "fwtypes.ARNType"
+		} else {
+			attr.ModelType, attr.SchemaType = "types.String", "String"
+		}
+	case kindBool:
+		attr.GoType, attr.ModelType, attr.SchemaType = "bool", "types.Bool", "Bool"
+	case kindInt32:
+		attr.GoType, attr.ModelType, attr.SchemaType = "int32", "types.Int32", "Int32"
+	case kindInt64:
+		attr.GoType, attr.ModelType, attr.SchemaType = "int64", "types.Int64", "Int64"
+	case kindFloat32:
+		attr.GoType, attr.ModelType, attr.SchemaType = "float32", "types.Float32", "Float32"
+	case kindFloat64:
+		attr.GoType, attr.ModelType, attr.SchemaType = "float64", "types.Float64", "Float64"
+	case kindTime:
+		attr.GoType, attr.ModelType, attr.SchemaType, attr.CustomType = "time.Time", "timetypes.RFC3339", "String", "timetypes.RFC3339Type{}"
+	case kindEnum:
+		enum := "awstypes." + typ.name
+		attr.GoType, attr.ModelType, attr.SchemaType, attr.CustomType = enum, "fwtypes.StringEnum["+enum+"]", "String", "fwtypes.StringEnumType["+enum+"]()"
+	case kindList, kindMap:
+		collection := "List"
+		if typ.kind == kindMap {
+			collection = "Map"
+		}
+
+		switch typ.elem.kind {
+		case kindString:
+			attr.ModelType, attr.SchemaType = "fwtypes."+collection+"ValueOf[types.String]", collection
+			attr.CustomType, attr.ElementType = "fwtypes."+collection+"OfStringType", "types.StringType"
+		case kindEnum:
+			if typ.kind == kindMap {
+				r.todo(f, stack, "unsupported map element type")
+				return nil
+			}
+			enum := "awstypes." + typ.elem.name
+			attr.ModelType, attr.SchemaType = "fwtypes.ListValueOf[fwtypes.StringEnum["+enum+"]]", collection
+			attr.CustomType, attr.ElementType = "fwtypes.ListOfStringEnumType["+enum+"]()", "fwtypes.StringEnumType["+enum+"]()"
+		case kindStruct:
+			if typ.kind == kindMap {
+				r.todo(f, stack, "unsupported map element type")
+				return nil
+			}
+			if !r.nested(attr, f, typ.elem, m, stack) {
+				return nil
+			}
+		default:
+			r.todo(f, stack, "unsupported element type")
+			return nil
+		}
+	case kindStruct:
+		if !r.nested(attr, f, typ, m, stack) {
+			return nil
+		}
+		attr.MaxItems1 = true
+	default:
+		r.todo(f, stack, "unsupported type")
+		return nil
+	}
+
+	return attr
+}
+
+// nested populates a nested object attribute.
+// Arguments are nested blocks. Computed-only nested objects are attributes.
+func (r *Resource) nested(attr *Attribute, f *structField, typ *fieldType, m mode, stack []string) bool {
+	if len(stack) >= maxNestingDepth || slices.Contains(stack, typ.name) {
+		r.todo(f, stack, "recursive or deeply nested type "+typ.name)
+		return false
+	}
+
+	s := r.pkg.structs[typesPackagePrefix+typ.name]
+	model := &Model{Name: convert.ToLowercasePrefix(typ.name) + "Model"}
+	nested := &Object{}
+
+	for _, v := range s.fields {
+		if nestedAttr := r.attribute(v, m, append(stack, typ.name)); nestedAttr != nil {
+			nested.Attributes = append(nested.Attributes, nestedAttr)
+		}
+	}
+	sortAttributes(nested.Attributes)
+
+	model.Attributes = nested.Attributes
+	if _, ok := r.models[model.Name]; !ok {
+		r.models[model.Name] = model
+	}
+
+	attr.GoType = "awstypes." + typ.name
+	attr.ModelType = "fwtypes.ListNestedObjectValueOf[" + model.Name + "]"
+	attr.SchemaType = "List"
+	attr.CustomType = "fwtypes.NewListNestedObjectTypeOf[" + model.Name + "](ctx)"
+	attr.Nested = nested
+
+	if m == modeComputed {
+		attr.ElementType = "fwtypes.NewObjectTypeOf[" + model.Name + "](ctx)"
+	} else {
+		attr.Block = true
+	}
+
+	return true
+}
+
+func (r *Resource) todo(f *structField, stack []string, detail string) {
+	path := append(slices.Clone(stack), f.name)
+	r.TODOs = append(r.TODOs, fmt.Sprintf("%s: %s", strings.Join(path, "."), detail))
+}
+
+// outputShape returns the struct holding an operation's result and the output field that holds it.
+// If the output is flat, the output itself is returned.
+func (p *Package) outputShape(output *structType) (*structType, string) {
+	if len(output.fields) == 1 {
+		f := output.fields[0]
+		if typ, ok := p.resolve(f.typ, f.inTypes); ok && typ.kind == kindStruct {
+			return p.structs[typesPackagePrefix+typ.name], f.name
+		}
+	}
+
+	return output, ""
+}
+
+func (p *Package) status(f *structField) *Status {
+	typ, _ := p.resolve(f.typ, f.inTypes)
+	status := &Status{
+		Field: f.name,
+		Type:  "awstypes." + typ.name,
+	}
+
+	for _, v := range p.enums[typesPackagePrefix+typ.name] {
+		value := strings.ToUpper(v.Value)
+		v.Name = "awstypes." + v.Name
+
+		switch {
+		case containsAny(value, deletingStatuses):
+			status.Deleting = append(status.Deleting, v)
+		case containsAny(value, updatingStatuses):
+			status.Updating = append(status.Updating, v)
+		case containsAny(value, targetStatuses):
+			status.Target = append(status.Target, v)
+		case containsAny(value, creatingStatuses):
+			status.Creating = append(status.Creating, v)
+		}
+	}
+
+	if len(status.Target) == 0 {
+		return nil
+	}
+
+	return status
+}
+
+func (r *Resource) list(operation string) (*List, error) {
+	input, output, err := r.pkg.operation(operation)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &List{
+		Name:      pluralize(r.name),
+		Paginated: r.pkg.hasPaginator(operation),
+	}
+
+	for _, f := range input.fields {
+		if slices.Contains(skippedFields, f.name) {
+			continue
+		}
+
+		if attr := r.attribute(f, modeInput, []string{operation}); attr != nil && !attr.Block {
+			attr.Required, attr.Optional = false, true
+			l.Attributes = append(l.Attributes, attr)
+		}
+	}
+
+	for _, f := range output.fields {
+		typ, ok := r.pkg.resolve(f.typ, f.inTypes)
+		if !ok || typ.kind != kindList || (typ.elem.kind != kindStruct && typ.elem.kind != kindString) {
+			continue
+		}
+
+		l.Items = r.attribute(f, modeComputed, []string{operation})
+		if l.Items == nil {
+			break
+		}
+
+		l.ItemsField = f.name
+		l.Attributes = append(l.Attributes, l.Items)
+
+		if typ.elem.kind == kindString {
+			l.ItemsType = "string"
+			l.ItemsAreIdentifiers = true
+			break
+		}
+
+		l.ItemsType = "awstypes." + typ.elem.name
+		l.ItemsModel = convert.ToLowercasePrefix(typ.elem.name) + "Model"
+		for _, v := range identifierCandidates(r.name, r.IdentifierField) {
+			if r.pkg.structs[typesPackagePrefix+typ.elem.name].field(v) != nil {
+				l.IdentifierField = v
+				break
+			}
+		}
+
+		break
+	}
+
+	if l.Items == nil {
+		return nil, fmt.Errorf("operation %s.%s returns no list of resources", r.pkg.Name, operation)
+	}
+
+	sortAttributes(l.Attributes)
+
+	return l, nil
+}
+
+func (s *structType) field(name string) *structField {
+	for _, f := range s.fields {
+		if f.name == name {
+			return f
+		}
+	}
+
+	return nil
+}
+
+// identifierCandidates returns the field names that may hold a resource identifier passed in the specified field.
+// For example, "GraphIdentifier" may be "GraphIdentifier", "GraphId", "Id", "GraphArn" or "Arn".
+func identifierCandidates(resourceName, field string) []string {
+	candidates := []string{field}
+
+	if base := strings.TrimSuffix(field, "Identifier"); base != field {
+		candidates = append(candidates, base+"Id", base+"Arn", base+"Name")
+	}
+
+	return append(candidates, resourceName+"Id", "Id", resourceName+"Arn", "Arn")
+}
+
+func sortAttributes(attrs []*Attribute) {
+	slices.SortFunc(attrs, func(a, b *Attribute) int {
+		return strings.Compare(a.TFName, b.TFName)
+	})
+}
+
+func containsAny(s string, substrs []string) bool {
+	return slices.ContainsFunc(substrs, func(v string) bool {
+		return strings.Contains(s, v)
+	})
+}
+
+func pluralize(s string) string {
+	switch {
+	case strings.HasSuffix(s, "y") && !strings.HasSuffix(s, "ay") && !strings.HasSuffix(s, "ey") && !strings.HasSuffix(s, "oy"):
+		return strings.TrimSuffix(s, "y") + "ies"
+	case strings.HasSuffix(s, "s"), strings.HasSuffix(s, "x"), strings.HasSuffix(s, "ch"), strings.HasSuffix(s, "sh"):
+		return s + "es"
+	}
+
+	return s + "s"
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimodel
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestResource(t *testing.T) {
+	t.Parallel()
+
+	pkg, err := Load("testdata/widgets")
+	if err != nil {
+		t.Fatalf("loading API model: %s", err)
+	}
+
+	r, err := pkg.Resource("Widget", Operations{
+		Create: "CreateWidget",
+		Read:   "GetWidget",
+		Update: "UpdateWidget",
+		Delete: "DeleteWidget",
+		List:   "ListWidgets",
+	})
+	if err != nil {
+		t.Fatalf("reading API model: %s", err)
+	}
+
+	type flags struct {
+		Required, Optional, Computed, RequiresReplace, UseStateForUnknown, Block bool
+	}
+	wantAttributes := map[string]flags{
+		"arn":         {Computed: true, UseStateForUnknown: true},
+		"create_time": {Computed: true, UseStateForUnknown: true},
+		"description": {Optional: true, Computed: true},
+		"id":          {Computed: true, UseStateForUnknown: true},
+		"settings":    {Optional: true, Block: true},
+		"size":        {Optional: true, Computed: true, RequiresReplace: true, UseStateForUnknown: true},
+		"status":      {Computed: true},
+		"widget_name": {Required: true, RequiresReplace: true},
+	}
+
+	var got []string
+	for _, attr := range r.Attributes {
+		got = append(got, attr.TFName)
+
+		want, ok := wantAttributes[attr.TFName]
+		if !ok {
+			t.Errorf("unexpected attribute %q", attr.TFName)
+			continue
+		}
+
+		if v := (flags{attr.Required, attr.Optional, attr.Computed, attr.RequiresReplace, attr.UseStateForUnknown, attr.Block}); v != want {
+			t.Errorf("attribute %q: got %+v, expected %+v", attr.TFName, v, want)
+		}
+	}
+	if len(got) != len(wantAttributes) {
+		t.Errorf("got attributes %v, expected %d attributes", got, len(wantAttributes))
+	}
+
+	if got, expected := r.Identifier.Name, "ID"; got != expected {
+		t.Errorf("got identifier %q, expected %q", got, expected)
+	}
+	if got, expected := r.IdentifierField, "WidgetId"; got != expected {
+		t.Errorf("got identifier field %q, expected %q", got, expected)
+	}
+	if got, expected := r.FieldNamePrefix(), "Widget"; got != expected {
+		t.Errorf("got field name prefix %q, expected %q", got, expected)
+	}
+	if got, expected := r.ReadOutputType, "awstypes.Widget"; got != expected {
+		t.Errorf("got read output type %q, expected %q", got, expected)
+	}
+	if got, expected := r.NotFoundError, "ResourceNotFoundException"; got != expected {
+		t.Errorf("got not found error %q, expected %q", got, expected)
+	}
+	if !r.Tags {
+		t.Error("expected tags")
+	}
+	if r.ARN == nil {
+		t.Error("expected ARN attribute")
+	}
+
+	if got, expected := r.Status.Slice(r.Status.Creating), "enum.Slice(awstypes.WidgetStatusCreating)"; got != expected {
+		t.Errorf("got creating statuses %q, expected %q", got, expected)
+	}
+	if got, expected := r.Status.Slice(r.Status.Target), "enum.Slice(awstypes.WidgetStatusAvailable)"; got != expected {
+		t.Errorf("got target statuses %q, expected %q", got, expected)
+	}
+
+	var updatable []string
+	for _, attr := range r.Updatable {
+		updatable = append(updatable, attr.Name)
+	}
+	if expected := []string{"Description", "Settings"}; !slices.Equal(updatable, expected) {
+		t.Errorf("got updatable attributes %v, expected %v", updatable, expected)
+	}
+
+	if len(r.Models) != 1 || r.Models[0].Name != "widgetSettingsModel" {
+		t.Errorf("got %d models, expected widgetSettingsModel", len(r.Models))
+	}
+
+	if r.List == nil {
+		t.Fatal("expected list data source")
+	}
+	if got, expected := r.List.Name, "Widgets"; got != expected {
+		t.Errorf("got list name %q, expected %q", got, expected)
+	}
+	if !r.List.Paginated {
+		t.Error("expected paginated List operation")
+	}
+	if got, expected := r.List.ItemsModel, "widgetSummaryModel"; got != expected {
+		t.Errorf("got list items model %q, expected %q", got, expected)
+	}
+	if got, expected := r.List.IdentifierField, "Id"; got != expected {
+		t.Errorf("got list identifier field %q, expected %q", got, expected)
+	}
+}
+
+func TestResourceOperationNotFound(t *testing.T) {
+	t.Parallel()
+
+	pkg, err := Load("testdata/widgets")
+	if err != nil {
+		t.Fatalf("loading API model: %s", err)
+	}
+
+	_, err = pkg.Resource("Widget", Operations{
+		Create: "CreateWidget",
+		Read:   "DescribeWidget",
+		Delete: "DeleteWidget",
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
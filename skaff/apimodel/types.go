@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimodel
+
+import (
+	"go/ast"
+)
+
+type kind int
+
+const (
+	kindString kind = iota
+	kindBool
+	kindInt32
+	kindInt64
+	kindFloat32
+	kindFloat64
+	kindTime
+	kindEnum
+	kindStruct
+	kindList
+	kindMap
+)
+
+type fieldType struct {
+	kind kind
+	name string     // The types package type name, for enums and structs.
+	elem *fieldType // The element type, for lists and maps.
+}
+
+// resolve returns the type of a struct field.
+// Unions, documents and other unsupported types are not resolved.
+func (p *Package) resolve(expr ast.Expr, inTypes bool) (*fieldType, bool) {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return p.resolve(expr.X, inTypes)
+
+	case *ast.Ident:
+		switch expr.Name {
+		case "string":
+			return &fieldType{kind: kindString}, true
+		case "bool":
+			return &fieldType{kind: kindBool}, true
+		case "int32":
+			return &fieldType{kind: kindInt32}, true
+		case "int64":
+			return &fieldType{kind: kindInt64}, true
+		case "float32":
+			return &fieldType{kind: kindFloat32}, true
+		case "float64":
+			return &fieldType{kind: kindFloat64}, true
+		}
+
+		if inTypes {
+			return p.resolveNamed(expr.Name)
+		}
+
+	case *ast.SelectorExpr:
+		pkg, ok := expr.X.(*ast.Ident)
+		if !ok {
+			break
+		}
+
+		switch {
+		case pkg.Name == "types":
+			return p.resolveNamed(expr.Sel.Name)
+		case pkg.Name == "time" && expr.Sel.Name == "Time":
+			return &fieldType{kind: kindTime}, true
+		}
+
+	case *ast.ArrayType:
+		if expr.Len != nil {
+			break
+		}
+
+		if elem, ok := p.resolve(expr.Elt, inTypes); ok && elem.kind != kindList && elem.kind != kindMap {
+			return &fieldType{kind: kindList, elem: elem}, true
+		}
+
+	case *ast.MapType:
+		if key, ok := expr.Key.(*ast.Ident); !ok || key.Name != "string" {
+			break
+		}
+
+		if elem, ok := p.resolve(expr.Value, inTypes); ok && elem.kind != kindList && elem.kind != kindMap {
+			return &fieldType{kind: kindMap, elem: elem}, true
+		}
+	}
+
+	return nil, false
+}
+
+func (p *Package) resolveNamed(name string) (*fieldType, bool) {
+	if _, ok := p.enums[typesPackagePrefix+name]; ok {
+		return &fieldType{kind: kindEnum, name: name}, true
+	}
+
+	if _, ok := p.structs[typesPackagePrefix+name]; ok {
+		return &fieldType{kind: kindStruct, name: name}, true
+	}
+
+	return nil, false
+}
+
+// unsupported describes a struct field type that cannot be resolved.
+func (p *Package) unsupported(expr ast.Expr, inTypes bool) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return p.unsupported(expr.X, inTypes)
+	case *ast.ArrayType:
+		if _, ok := p.resolve(expr.Elt, inTypes); ok {
+			return "nested collection"
+		}
+		return "list of " + p.unsupported(expr.Elt, inTypes)
+	case *ast.MapType:
+		if _, ok := p.resolve(expr.Value, inTypes); ok {
+			return "nested collection"
+		}
+		return "map of " + p.unsupported(expr.Value, inTypes)
+	case *ast.Ident:
+		if inTypes && p.unions[typesPackagePrefix+expr.Name] {
+			return "union " + expr.Name
+		}
+	case *ast.SelectorExpr:
+		if pkg, ok := expr.X.(*ast.Ident); ok {
+			if pkg.Name == "types" && p.unions[typesPackagePrefix+expr.Sel.Name] {
+				return "union " + expr.Sel.Name
+			}
+			if pkg.Name == "document" {
+				return "document"
+			}
+		}
+	}
+
+	return "unsupported type"
+}
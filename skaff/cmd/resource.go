@@ -4,6 +4,9 @@
 package cmd
 
 import (
+	"errors"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
 	"github.com/hashicorp/terraform-provider-aws/skaff/resource"
 	"github.com/spf13/cobra"
 )
@@ -15,12 +18,22 @@ var (
 	force         bool
 	pluginSDKV2   bool
 	includeTags   bool
+	operations    apimodel.Operations
+	sdkDir        string
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if operations != (apimodel.Operations{}) {
+			if pluginSDKV2 {
+				return errors.New("generating from an API model is only supported for Terraform Plugin Framework")
+			}
+
+			return resource.CreateFromAPIModel(name, snakeName, !clearComments, force, operations, sdkDir)
+		}
+
 		return resource.Create(name, snakeName, !clearComments, force, !pluginSDKV2, includeTags)
 	},
 }
@@ -33,4 +46,10 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
 	resourceCmd.Flags().BoolVarP(&pluginSDKV2, "plugin-sdkv2", "p", false, "generate for Terraform Plugin SDK V2")
 	resourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	resourceCmd.Flags().StringVar(&operations.Create, "create-operation", "", "generate from the AWS SDK for Go v2 API model, using the named create operation (e.g., CreateGraph)")
+	resourceCmd.Flags().StringVar(&operations.Read, "read-operation", "", "API model read operation (e.g., GetGraph)")
+	resourceCmd.Flags().StringVar(&operations.Update, "update-operation", "", "API model update operation, if any (e.g., UpdateGraph)")
+	resourceCmd.Flags().StringVar(&operations.Delete, "delete-operation", "", "API model delete operation (e.g., DeleteGraph)")
+	resourceCmd.Flags().StringVar(&operations.List, "list-operation", "", "API model list operation, if any; generates a plural data source and a sweeper (e.g., ListGraphs)")
+	resourceCmd.Flags().StringVar(&sdkDir, "sdk-dir", "", "directory containing the AWS SDK for Go v2 service package source (default: located from the current module)")
 }
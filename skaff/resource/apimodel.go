@@ -0,0 +1,351 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+//go:embed resourceapi.gtpl
+var resourceAPITmpl string
+
+//go:embed resourceapitest.gtpl
+var resourceAPITestTmpl string
+
+//go:embed datasourceapi.gtpl
+var dataSourceAPITmpl string
+
+//go:embed sweepapi.gtpl
+var sweepAPITmpl string
+
+const sweepFile = "sweep.go"
+
+// CreateFromAPIModel generates a Plugin Framework resource from the AWS SDK for Go v2 API model of the named operations.
+// If a List operation is named, a plural data source and a sweeper are also generated.
+// sdkDir is the directory containing the SDK service package source; if empty it is located from the current module.
+func CreateFromAPIModel(resName, snakeName string, comments, force bool, ops apimodel.Operations, sdkDir string) error {
+	templateData, err := newTemplateData(resName, snakeName, comments, true, false)
+	if err != nil {
+		return err
+	}
+
+	if sdkDir == "" {
+		if sdkDir, err = apimodel.PackageDir(templateData.SDKPackage); err != nil {
+			return err
+		}
+	}
+
+	pkg, err := apimodel.Load(sdkDir)
+	if err != nil {
+		return fmt.Errorf("loading API model: %w", err)
+	}
+
+	api, err := pkg.Resource(resName, ops)
+	if err != nil {
+		return fmt.Errorf("reading API model: %w", err)
+	}
+
+	templateData.setAPIModel(api)
+
+	f := fmt.Sprintf("%s.go", templateData.ResourceSnake)
+	if err = writeGoTemplate("newres", f, resourceAPITmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", templateData.ResourceSnake)
+	if err = writeGoTemplate("restest", tf, resourceAPITestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", templateData.ServicePackage, templateData.ResourceSnake)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	if api.List == nil {
+		return nil
+	}
+
+	df := fmt.Sprintf("%s_data_source.go", names.ToSnakeCase(api.List.Name))
+	if err = writeGoTemplate("newds", df, dataSourceAPITmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing data source template: %w", err)
+	}
+
+	if err = writeSweeper(sweepFile, templateData); err != nil {
+		return fmt.Errorf("writing sweeper: %w", err)
+	}
+
+	return nil
+}
+
+func (td *TemplateData) setAPIModel(api *apimodel.Resource) {
+	td.API = api
+	td.IncludeTags = api.Tags
+
+	if v := api.FieldNamePrefix(); v != "" {
+		td.FieldNamePrefixOption = fmt.Sprintf(", flex.WithFieldNamePrefix(%q)", v)
+	}
+
+	td.TagsIdentifierAttribute = api.Identifier.TFName
+	if api.ARN != nil {
+		td.TagsIdentifierAttribute = api.ARN.TFName
+	}
+
+	for _, attr := range api.NonBlocks() {
+		if !attr.Required {
+			continue
+		}
+
+		if attr.GoType == "string" {
+			td.TestConfig = append(td.TestConfig, fmt.Sprintf("  %s = %%[1]q", attr.TFName))
+		} else {
+			td.TestConfig = append(td.TestConfig, fmt.Sprintf("  %s = null # TODO %s", attr.TFName, attr.GoType))
+		}
+	}
+	for _, attr := range api.Blocks() {
+		if attr.Required {
+			td.TestConfig = append(td.TestConfig, "", fmt.Sprintf("  %s {", attr.TFName), "    # TODO", "  }")
+		}
+	}
+	if !slices.ContainsFunc(td.TestConfig, func(v string) bool {
+		return strings.Contains(v, "%[1]q")
+	}) {
+		if td.IncludeTags {
+			td.TestConfig = append(td.TestConfig, "", "  tags = {", "    Name = %[1]q", "  }")
+		} else {
+			td.TestConfig = append(td.TestConfig, "  # TODO Use %[1]q")
+		}
+	}
+
+	if list := api.List; list != nil {
+		td.HumanDataSourceName = convert.ToHumanResName(list.Name)
+		td.ProviderDataSourceName = convert.ToProviderResourceName(td.ServicePackage, names.ToSnakeCase(list.Name))
+
+		switch {
+		case list.ItemsAreIdentifiers:
+			td.SweepIdentifier = "v"
+		case list.IdentifierField != "":
+			td.SweepIdentifier = fmt.Sprintf("aws.ToString(v.%s)", list.IdentifierField)
+		default:
+			td.SweepIdentifier = `"" /* TODO */`
+		}
+	}
+}
+
+// writeSweeper adds the resource's sweeper to the service package's sweep.go, creating the file if necessary.
+func writeSweeper(filename string, td TemplateData) error {
+	tmpl, err := template.New("sweep").Parse(sweepAPITmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	b, err := os.ReadFile(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		var buffer bytes.Buffer
+		if err := tmpl.Execute(&buffer, td); err != nil {
+			return fmt.Errorf("error executing template: %s", err)
+		}
+
+		return writeGoFile(filename, buffer.Bytes())
+	}
+	if err != nil {
+		return err
+	}
+
+	src := string(b)
+	if strings.Contains(src, fmt.Sprintf("func sweep%s(", td.API.List.Name)) {
+		return nil
+	}
+
+	const registerFunc = "func RegisterSweepers() {\n"
+	i := strings.Index(src, registerFunc)
+	if i < 0 {
+		return fmt.Errorf("%s: RegisterSweepers not found", filename)
+	}
+
+	var register, sweeper bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&register, "register", td); err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+	if err := tmpl.ExecuteTemplate(&sweeper, "sweeper", td); err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	i += len(registerFunc)
+	src = src[:i] + "\t" + register.String() + "\n\n" + src[i:] + sweeper.String() + "\n"
+
+	src, err = addImports(src, sweeperImports(td))
+	if err != nil {
+		return fmt.Errorf("%s: %w", filename, err)
+	}
+
+	return writeGoFile(filename, []byte(src))
+}
+
+func sweeperImports(td TemplateData) []string {
+	return []string{
+		"context",
+		"github.com/aws/aws-sdk-go-v2/aws",
+		"github.com/aws/aws-sdk-go-v2/service/" + td.SDKPackage,
+		"github.com/hashicorp/terraform-provider-aws/internal/conns",
+		"github.com/hashicorp/terraform-provider-aws/internal/sweep",
+		"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2",
+		"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework",
+		"github.com/hashicorp/terraform-provider-aws/names",
+	}
+}
+
+// addImports adds any of the specified import paths not already imported to a Go source file's import declaration.
+func addImports(src string, paths []string) (string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ImportsOnly)
+	if err != nil {
+		return "", err
+	}
+
+	var decl *ast.GenDecl
+	for _, v := range file.Decls {
+		if v, ok := v.(*ast.GenDecl); ok && v.Tok == token.IMPORT && v.Rparen.IsValid() {
+			decl = v
+			break
+		}
+	}
+	if decl == nil {
+		return "", errors.New("import declaration not found")
+	}
+
+	// Each new import is placed after the last existing import of the same kind (standard library or not)
+	// so that it ends up in the right group once the file is formatted.
+	isStd := func(v string) bool {
+		return !strings.Contains(strings.Split(v, "/")[0], ".")
+	}
+	inserts := make(map[int][]string)
+	for _, v := range paths {
+		quoted := strconv.Quote(v)
+		if slices.ContainsFunc(file.Imports, func(spec *ast.ImportSpec) bool {
+			return spec.Path.Value == quoted
+		}) {
+			continue
+		}
+
+		i, line := fset.Position(decl.Rparen).Offset, "\t"+quoted+"\n"
+		for _, spec := range file.Imports {
+			if p, err := strconv.Unquote(spec.Path.Value); err == nil && isStd(p) == isStd(v) {
+				i, line = fset.Position(spec.End()).Offset, "\n\t"+quoted
+			}
+		}
+		inserts[i] = append(inserts[i], line)
+	}
+
+	for _, i := range slices.Backward(slices.Sorted(maps.Keys(inserts))) {
+		src = src[:i] + strings.Join(inserts[i], "") + src[i:]
+	}
+
+	return src, nil
+}
+
+// removeUnusedImports removes imports that are not referenced from a Go source file.
+func removeUnusedImports(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	used := make(map[string]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		if n, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := n.X.(*ast.Ident); ok {
+				used[x.Name] = true
+			}
+		}
+		return true
+	})
+
+	// Remove in reverse order so that offsets remain valid.
+	for _, spec := range slices.Backward(file.Imports) {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		name := path.Base(importPath)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+
+		if name == "_" || used[name] {
+			continue
+		}
+
+		// Remove the whole line so that no empty lines are left in the import group.
+		start, end := fset.Position(spec.Pos()).Offset, fset.Position(spec.End()).Offset
+		start = bytes.LastIndexByte(src[:start], '\n') + 1
+		if i := bytes.IndexByte(src[end:], '\n'); i >= 0 {
+			end += i + 1
+		}
+		src = append(src[:start:start], src[end:]...)
+	}
+
+	return src, nil
+}
+
+func writeGoTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, td)
+	if err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	return writeGoFile(filename, buffer.Bytes())
+}
+
+// writeGoFile removes unused imports from, formats and writes generated Go source.
+func writeGoFile(filename string, src []byte) error {
+	src, err := removeUnusedImports(src)
+	if err != nil {
+		return fmt.Errorf("error parsing generated file (%s): %s", filename, err)
+	}
+
+	contents, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("error formatting generated file (%s): %s", filename, err)
+	}
+
+	if err := os.WriteFile(filename, contents, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	return nil
+}
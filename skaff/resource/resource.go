@@ -16,6 +16,7 @@ import (
 
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
 	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
 )
 
@@ -46,26 +47,64 @@ type TemplateData struct {
 	PluginFramework      bool
 	HumanResourceName    string
 	ProviderResourceName string
+
+	// API model fields.
+	API                     *apimodel.Resource
+	FieldNamePrefixOption   string
+	TagsIdentifierAttribute string
+	SweepIdentifier         string
+	TestConfig              []string
+	HumanDataSourceName     string
+	ProviderDataSourceName  string
 }
 
 func Create(resName, snakeName string, comments, force, pluginFramework, tags bool) error {
+	templateData, err := newTemplateData(resName, snakeName, comments, pluginFramework, tags)
+	if err != nil {
+		return err
+	}
+
+	tmpl := resourceTmpl
+	if pluginFramework {
+		tmpl = resourceFrameworkTmpl
+	}
+	f := fmt.Sprintf("%s.go", templateData.ResourceSnake)
+	if err = writeTemplate("newres", f, tmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", templateData.ResourceSnake)
+	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", templateData.ServicePackage, templateData.ResourceSnake)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	return nil
+}
+
+func newTemplateData(resName, snakeName string, comments, pluginFramework, tags bool) (TemplateData, error) {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
-		return fmt.Errorf("error reading working directory: %s", err)
+		return TemplateData{}, fmt.Errorf("error reading working directory: %s", err)
 	}
 
 	servicePackage := filepath.Base(wd)
 
 	if resName == "" {
-		return fmt.Errorf("error checking: no name given")
+		return TemplateData{}, fmt.Errorf("error checking: no name given")
 	}
 
 	if resName == strings.ToLower(resName) {
-		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+		return TemplateData{}, fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
 	}
 
 	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
-		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+		return TemplateData{}, fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
 	}
 
 	if snakeName == "" {
@@ -74,7 +113,7 @@ func Create(resName, snakeName string, comments, force, pluginFramework, tags bo
 
 	service, err := data.LookupService(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error looking up service package data for %q: %w", servicePackage, err)
+		return TemplateData{}, fmt.Errorf("error looking up service package data for %q: %w", servicePackage, err)
 	}
 
 	templateData := TemplateData{
@@ -94,27 +133,7 @@ func Create(resName, snakeName string, comments, force, pluginFramework, tags bo
 		ProviderResourceName: convert.ToProviderResourceName(servicePackage, snakeName),
 	}
 
-	tmpl := resourceTmpl
-	if pluginFramework {
-		tmpl = resourceFrameworkTmpl
-	}
-	f := fmt.Sprintf("%s.go", snakeName)
-	if err = writeTemplate("newres", f, tmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource template: %w", err)
-	}
-
-	tf := fmt.Sprintf("%s_test.go", snakeName)
-	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource test template: %w", err)
-	}
-
-	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
-	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
-	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource website doc template: %w", err)
-	}
-
-	return nil
+	return templateData, nil
 }
 
 func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
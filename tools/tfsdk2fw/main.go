@@ -12,6 +12,8 @@ import (
 	"os"
 	"path"
 	"strings"
+	"text/template"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
@@ -22,12 +24,14 @@ import (
 
 var (
 	dataSourceType = flag.String("data-source", "", "Data Source type")
+	reportFile     = flag.String("report", "", "File to which the report of constructs that could not be translated is written (default stdout)")
 	resourceType   = flag.String("resource", "", "Resource type")
+	sourceDir      = flag.String("source", "", "Directory containing the Plugin SDK V2 resource's source code (default the generated file's directory)")
 )
 
 func usage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n")
-	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] <package-name> <name> <generated-file>\n\n")
+	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] [-source <directory>] [-report <report-file>] <package-name> <name> <generated-file>\n\n")
 }
 
 func main() {
@@ -55,6 +59,11 @@ func main() {
 		Generator:   g,
 		Name:        name,
 		PackageName: packageName,
+		SourceDir:   *sourceDir,
+	}
+
+	if migrator.SourceDir == "" {
+		migrator.SourceDir = path.Dir(outputFilename)
 	}
 
 	p, err := provider.New(context.Background())
@@ -87,7 +96,24 @@ func main() {
 	}
 
 	if err := migrator.migrate(outputFilename); err != nil {
-		g.Fatalf("error migrating Terraform %s schema: %s", *resourceType, err)
+		g.Fatalf("error migrating Terraform %s schema: %s", migrator.TFTypeName, err)
+	}
+
+	var w io.Writer = os.Stdout
+	if v := *reportFile; v != "" {
+		f, err := os.Create(v)
+
+		if err != nil {
+			g.Fatalf("creating report file %s: %s", v, err)
+		}
+
+		defer f.Close()
+
+		w = f
+	}
+
+	if err := writeReport(w, migrator.TFTypeName, migrator.Untranslated); err != nil {
+		g.Fatalf("writing report: %s", err)
 	}
 }
 
@@ -97,11 +123,13 @@ type migrator struct {
 	Name         string
 	PackageName  string
 	Resource     *schema.Resource
+	SourceDir    string
 	Template     string
 	TFTypeName   string
+	Untranslated []untranslated
 }
 
-// migrate generates an identical schema into the specified output file.
+// migrate generates an identical schema, and for resources a CRUD skeleton, into the specified output file.
 func (m *migrator) migrate(outputFilename string) error {
 	m.infof("generating into %[1]q", outputFilename)
 
@@ -121,7 +149,7 @@ func (m *migrator) migrate(outputFilename string) error {
 
 	d := m.Generator.NewGoFileDestination(outputFilename)
 
-	if err := d.BufferTemplate("schema", m.Template, templateData); err != nil {
+	if err := d.BufferTemplate("schema", m.Template, templateData, templateFuncMap); err != nil {
 		return err
 	}
 
@@ -129,13 +157,22 @@ func (m *migrator) migrate(outputFilename string) error {
 }
 
 func (m *migrator) generateTemplateData() (*templateData, error) {
+	sbModels := strings.Builder{}
 	sbSchema := strings.Builder{}
 	sbStruct := strings.Builder{}
 	emitter := &emitter{
 		Generator:    m.Generator,
 		IsDataSource: m.IsDataSource,
+		ModelWriter:  &sbModels,
 		SchemaWriter: &sbSchema,
 		StructWriter: &sbStruct,
+		modelNames:   make(map[string]struct{}),
+	}
+
+	if m.IsDataSource {
+		emitter.modelNames[fmt.Sprintf("dataSource%sModel", m.Name)] = struct{}{}
+	} else {
+		emitter.modelNames[fmt.Sprintf("resource%sModel", m.Name)] = struct{}{}
 	}
 
 	err := emitter.emitSchemaForResource(m.Resource)
@@ -144,6 +181,16 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		return nil, fmt.Errorf("emitting schema code: %w", err)
 	}
 
+	m.Untranslated = append(m.Untranslated, emitter.Untranslated...)
+
+	var resourceTODOs []string
+	if !m.IsDataSource {
+		for _, v := range untranslatedResourceConstructs(m.Resource) {
+			m.Untranslated = append(m.Untranslated, untranslated{Construct: v})
+			resourceTODOs = append(resourceTODOs, v)
+		}
+	}
+
 	templateData := &templateData{
 		DefaultCreateTimeout:         emitter.DefaultCreateTimeout,
 		DefaultReadTimeout:           emitter.DefaultReadTimeout,
@@ -152,16 +199,41 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		EmitResourceImportState:      m.Resource.Importer != nil,
 		EmitResourceModifyPlan:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
 		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
+		HasName:                      emitter.HasTopLevelName,
+		HasTags:                      emitter.HasTopLevelTagsMap || emitter.HasTopLevelTagsAllMap,
 		HasTimeouts:                  emitter.HasTimeouts,
-		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
 		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
+		Models:                       sbModels.String(),
 		Name:                         m.Name,
 		PackageName:                  m.PackageName,
+		ResourceTODOs:                resourceTODOs,
 		Schema:                       sbSchema.String(),
+		SchemaVersion:                int64(m.Resource.SchemaVersion),
 		Struct:                       sbStruct.String(),
 		TFTypeName:                   m.TFTypeName,
 	}
 
+	if !m.IsDataSource {
+		source, err := analyzeSource(m.SourceDir, m.TFTypeName)
+
+		if err != nil {
+			m.Generator.Warnf("analyzing Plugin SDK V2 source code in %s: %s", m.SourceDir, err)
+			m.Untranslated = append(m.Untranslated, untranslated{Construct: "CRUD handlers (source code not analyzed)"})
+		} else {
+			templateData.Source = source
+
+			if source.SDKImport != nil {
+				templateData.SDKPackage = path.Base(source.SDKImport.Path)
+				if source.SDKImport.Alias != "" {
+					templateData.SDKPackage = source.SDKImport.Alias
+				}
+				templateData.GoImports = append(templateData.GoImports, *source.SDKImport)
+			}
+
+			m.Untranslated = append(m.Untranslated, untranslatedCalls(source)...)
+		}
+	}
+
 	for _, v := range emitter.FrameworkPlanModifierPackages {
 		if !slices.Contains(templateData.FrameworkPlanModifierPackages, v) {
 			templateData.FrameworkPlanModifierPackages = append(templateData.FrameworkPlanModifierPackages, v)
@@ -181,27 +253,55 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 	return templateData, nil
 }
 
+// untranslatedCalls returns the API calls of the Plugin SDK V2 CRUD handlers that are not translated.
+// Only the first non-tagging API call in the Create, Update and Delete handlers is translated.
+func untranslatedCalls(source *sourceInfo) []untranslated {
+	var items []untranslated
+
+	for _, f := range []*sourceFunction{source.Create, source.Update, source.Delete} {
+		if f == nil {
+			continue
+		}
+
+		apiCall := f.APICall()
+		for _, v := range f.APICalls {
+			if v != apiCall && !v.IsTagging() {
+				items = append(items, untranslated{Construct: fmt.Sprintf("%s: call to %s", f.Name, v.Name)})
+			}
+		}
+	}
+
+	if f := source.Read; f != nil && len(f.Finders) == 0 {
+		items = append(items, untranslated{Construct: fmt.Sprintf("%s: no finder function", f.Name)})
+	}
+
+	return items
+}
+
 func (m *migrator) infof(format string, a ...interface{}) {
 	m.Generator.Infof(format, a...)
 }
 
 type emitter struct {
-	DefaultCreateTimeout          int64
-	DefaultReadTimeout            int64
-	DefaultUpdateTimeout          int64
-	DefaultDeleteTimeout          int64
+	DefaultCreateTimeout          time.Duration
+	DefaultReadTimeout            time.Duration
+	DefaultUpdateTimeout          time.Duration
+	DefaultDeleteTimeout          time.Duration
 	Generator                     *common.Generator
 	FrameworkPlanModifierPackages []string // Package names for any terraform-plugin-framework plan modifiers. May contain duplicates.
 	FrameworkValidatorsPackages   []string // Package names for any terraform-plugin-framework-validators validators. May contain duplicates.
 	GoImports                     []goImport
 	HasTimeouts                   bool
+	HasTopLevelName               bool
 	HasTopLevelTagsAllMap         bool
 	HasTopLevelTagsMap            bool
-	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
 	IsDataSource                  bool
+	ModelWriter                   io.Writer // Nested model struct declarations.
 	SchemaWriter                  io.Writer
-	StructWriter                  io.Writer
+	StructWriter                  io.Writer // Fields of the model currently being emitted.
+	Untranslated                  []untranslated
+	modelNames                    map[string]struct{}
 }
 
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
@@ -220,16 +320,16 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 		e.HasTimeouts = true
 
 		if v := v.Create; v != nil {
-			e.DefaultCreateTimeout = int64(*v)
+			e.DefaultCreateTimeout = *v
 		}
 		if v := v.Read; v != nil {
-			e.DefaultReadTimeout = int64(*v)
+			e.DefaultReadTimeout = *v
 		}
 		if v := v.Update; v != nil {
-			e.DefaultUpdateTimeout = int64(*v)
+			e.DefaultUpdateTimeout = *v
 		}
 		if v := v.Delete; v != nil {
-			e.DefaultDeleteTimeout = int64(*v)
+			e.DefaultDeleteTimeout = *v
 		}
 	}
 
@@ -241,8 +341,9 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 		return err
 	}
 
-	if version := resource.SchemaVersion; version > 0 {
-		fprintf(e.SchemaWriter, "Version:%d,\n", version)
+	// State is upgraded from the last Plugin SDK V2 schema version.
+	if !e.IsDataSource {
+		fprintf(e.SchemaWriter, "Version:%d,\n", resource.SchemaVersion+1)
 	}
 
 	if description := resource.Description; description != "" {
@@ -260,6 +361,7 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 
 // emitAttributesAndBlocks generates the Plugin Framework code for a set of Plugin SDK Attributes and Blocks
 // and emits the generated code to the emitter's Writer.
+// The corresponding model fields are emitted to the emitter's StructWriter.
 // Property names are sorted prior to code generation to reduce diffs.
 func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*schema.Schema) error {
 	isTopLevelAttribute := len(path) == 0
@@ -295,22 +397,25 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 		fprintf(e.SchemaWriter, "%q:", name)
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
-
+		var goType string
 		if name == "id" && isTopLevelAttribute {
 			fprintf(e.SchemaWriter, "framework.IDAttribute()")
+			goType = "types.String"
+		} else if isTopLevelAttribute && isTagsMap(name, property) && (!e.IsDataSource || !property.Optional) {
+			goType = e.emitTagsAttribute(name, property)
 		} else {
-			if err := e.emitAttributeProperty(append(path, name), property); err != nil {
+			var err error
+			if goType, err = e.emitAttributeProperty(append(path, name), property); err != nil {
 				return err
 			}
 		}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
+		if name == "name" && isTopLevelAttribute {
+			e.HasTopLevelName = true
 		}
 
+		fprintf(e.StructWriter, "%s %s `tfsdk:%q`\n", naming.ToCamelCase(name), goType, name)
+
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -332,12 +437,14 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 
 		fprintf(e.SchemaWriter, "%q:", name)
 
-		err := e.emitBlockProperty(append(path, name), property)
+		goType, err := e.emitBlockProperty(append(path, name), property)
 
 		if err != nil {
 			return err
 		}
 
+		fprintf(e.StructWriter, "%s %s `tfsdk:%q`\n", naming.ToCamelCase(name), goType, name)
+
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -347,14 +454,32 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 	return nil
 }
 
+// emitTagsAttribute generates the Plugin Framework code for a top-level 'tags' or 'tags_all' Attribute
+// and returns the model field's Go type.
+func (e *emitter) emitTagsAttribute(name string, property *schema.Schema) string {
+	if name == "tags" {
+		e.HasTopLevelTagsMap = true
+	} else {
+		e.HasTopLevelTagsAllMap = true
+	}
+
+	if name == "tags" && property.Optional {
+		fprintf(e.SchemaWriter, "tftags.TagsAttribute()")
+	} else {
+		fprintf(e.SchemaWriter, "tftags.TagsAttributeComputedOnly()")
+	}
+
+	return "tftags.Map"
+}
+
 // emitAttributeProperty generates the Plugin Framework code for a Plugin SDK Attribute's property
 // and emits the generated code to the emitter's Writer.
-func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema) error {
+// The model field's Go type is returned.
+func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema) (string, error) {
 	attributeName := path[len(path)-1]
 	isComputedOnly := property.Computed && !property.Optional
-	isTopLevelAttribute := len(path) == 1
 	var planModifiers []string
-	var defaultSpec string
+	var defaultSpec, goType string
 	var fwPlanModifierPackage, fwPlanModifierType, fwValidatorsPackage, fwValidatorType string
 
 	// At this point we are emitting code for the values of a schema.Schema's Attributes (map[string]schema.Attribute).
@@ -364,30 +489,21 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	//
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		goType = "types.Bool"
 
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
 
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		goType = "types.Float64"
 
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
 
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		goType = "types.Int64"
 
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
@@ -399,16 +515,10 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			goType = "fwtypes.ARN"
 		} else {
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			goType = "types.String"
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -425,10 +535,6 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.ListAttribute{"
 			typeName = "list"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
-
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
 			fwValidatorsPackage = "listvalidator"
@@ -438,10 +544,6 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.MapAttribute{"
 			typeName = "map"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
-
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
 			fwValidatorsPackage = "mapvalidator"
@@ -451,10 +553,6 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.SetAttribute{"
 			typeName = "set"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
-
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
 			fwValidatorsPackage = "setvalidator"
@@ -463,59 +561,51 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 		switch v := property.Elem.(type) {
 		case *schema.Schema:
-			var elementType string
-
-			switch v := v.Type; v {
-			case schema.TypeBool:
-				elementType = "types.BoolType"
-
-			case schema.TypeFloat:
-				elementType = "types.Float64Type"
-
-			case schema.TypeInt:
-				elementType = "types.Int64Type"
-
-			case schema.TypeString:
-				elementType = "types.StringType"
-				// Special handling for 'tags' and 'tags_all'.
-				if typeName == "map" && isTopLevelAttribute {
-					if attributeName == "tags" {
-						e.HasTopLevelTagsMap = true
-						if property.Optional {
-							fprintf(e.SchemaWriter, "// TODO tftags.TagsAttribute()\n")
-						} else if property.Computed {
-							fprintf(e.SchemaWriter, "// TODO tftags.TagsAttributeComputedOnly()\n")
-						}
-					} else if attributeName == "tags_all" {
-						e.HasTopLevelTagsAllMap = true
-						fprintf(e.SchemaWriter, "// TODO tftags.TagsAttributeComputedOnly()\n")
-					}
-				}
+			elementType, err := elementType(path, typeName, v)
 
-			default:
-				return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %s", typeName, v.String()))
+			if err != nil {
+				return "", err
 			}
 
 			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
+
+			// AutoFlex-compatible custom types are only available for collections of strings.
+			if v.Type == schema.TypeString {
+				e.ImportProviderFrameworkTypes = true
+
+				fprintf(e.SchemaWriter, "CustomType:fwtypes.%sOfStringType,\n", fwPlanModifierType)
+				goType = fmt.Sprintf("fwtypes.%sValueOf[types.String]", fwPlanModifierType)
+			} else {
+				goType = "types." + fwPlanModifierType
+			}
+
 			fprintf(e.SchemaWriter, "ElementType:%s,\n", elementType)
 
 		case *schema.Resource:
 			// We get here for Computed-only nested blocks or when ConfigMode is SchemaConfigModeBlock.
-			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
-			fprintf(e.SchemaWriter, "ElementType:")
+			if typeName == "map" {
+				return "", unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %T", typeName, v))
+			}
 
-			if err := e.emitComputedOnlyBlock(path, v.Schema); err != nil {
-				return err
+			modelName, err := e.emitNestedObjectModel(path, v.Schema)
+
+			if err != nil {
+				return "", err
 			}
 
-			fprintf(e.SchemaWriter, ",\n")
+			e.ImportProviderFrameworkTypes = true
+
+			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.New%sNestedObjectTypeOf[%s](ctx),\n", fwPlanModifierType, modelName)
+			fprintf(e.SchemaWriter, "ElementType:fwtypes.NewObjectTypeOf[%s](ctx),\n", modelName)
+			goType = fmt.Sprintf("fwtypes.%sNestedObjectValueOf[%s]", fwPlanModifierType, modelName)
 
 		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %T", typeName, v))
+			return "", unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %T", typeName, v))
 		}
 
 	default:
-		return unsupportedTypeError(path, v.String())
+		return "", unsupportedTypeError(path, v.String())
 	}
 
 	if property.Required {
@@ -588,6 +678,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			defaultSpec = fmt.Sprintf("stringdefault.StaticString(%q)", v)
 		default:
 			fprintf(e.SchemaWriter, "// TODO Default: %#[1]v (%[1]T),\n", def)
+			e.untranslated(path, "Default")
 		}
 	}
 
@@ -605,19 +696,22 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 	// Features that we can't (yet) migrate:
 
-	if property.ValidateFunc != nil || property.ValidateDiagFunc != nil {
-		fprintf(e.SchemaWriter, "// TODO Validate,\n")
+	for _, v := range untranslatedAttributeConstructs(property) {
+		fprintf(e.SchemaWriter, "// TODO %s,\n", v)
+		e.untranslated(path, v)
 	}
 
 	fprintf(e.SchemaWriter, "}")
 
-	return nil
+	return goType, nil
 }
 
 // emitBlockProperty generates the Plugin Framework code for a Plugin SDK Block's property
 // and emits the generated code to the emitter's Writer.
-func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) error {
+// The model field's Go type is returned.
+func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) (string, error) {
 	var planModifiers []string
+	var goType string
 	var fwPlanModifierPackage, fwPlanModifierType, fwValidatorsPackage, fwValidatorType string
 
 	// At this point we are emitting code for the values of a schema.Block or Schema's Blocks (map[string]schema.Block).
@@ -625,54 +719,50 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 	//
 	// Complex types.
 	//
-	case schema.TypeList:
-		switch v := property.Elem.(type) {
-		case *schema.Resource:
+	case schema.TypeList, schema.TypeSet:
+		v, ok := property.Elem.(*schema.Resource)
+
+		if !ok {
+			return "", unsupportedTypeError(path, fmt.Sprintf("(Block) %s of %T", strings.ToLower(property.Type.String()[len("Type"):]), property.Elem))
+		}
+
+		if property.Type == schema.TypeList {
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
 			fwValidatorsPackage = "listvalidator"
 			fwValidatorType = "List"
-
-			fprintf(e.SchemaWriter, "schema.ListNestedBlock{\n")
-			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
-
-			err := e.emitAttributesAndBlocks(path, v.Schema)
-
-			if err != nil {
-				return err
-			}
-
-			fprintf(e.SchemaWriter, "},\n")
-
-		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(Block) list of %T", v))
-		}
-
-	case schema.TypeSet:
-		switch v := property.Elem.(type) {
-		case *schema.Resource:
+		} else {
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
 			fwValidatorsPackage = "setvalidator"
 			fwValidatorType = "Set"
+		}
 
-			fprintf(e.SchemaWriter, "schema.SetNestedBlock{\n")
-			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
+		modelName := e.newModelName(path)
+		e.ImportProviderFrameworkTypes = true
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+		fprintf(e.SchemaWriter, "schema.%sNestedBlock{\n", fwPlanModifierType)
+		fprintf(e.SchemaWriter, "CustomType:fwtypes.New%sNestedObjectTypeOf[%s](ctx),\n", fwPlanModifierType, modelName)
+		fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			if err != nil {
-				return err
-			}
+		sbStruct := strings.Builder{}
+		structWriter := e.StructWriter
+		e.StructWriter = &sbStruct
 
-			fprintf(e.SchemaWriter, "},\n")
+		err := e.emitAttributesAndBlocks(path, v.Schema)
 
-		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(Block) set of %T", v))
+		e.StructWriter = structWriter
+
+		if err != nil {
+			return "", err
 		}
 
+		fprintf(e.SchemaWriter, "},\n")
+		fprintf(e.ModelWriter, "type %s struct {\n%s}\n\n", modelName, sbStruct.String())
+		goType = fmt.Sprintf("fwtypes.%sNestedObjectValueOf[%s]", fwPlanModifierType, modelName)
+
 	default:
-		return unsupportedTypeError(path, v.String())
+		return "", unsupportedTypeError(path, v.String())
 	}
 
 	// Compatibility hacks.
@@ -726,132 +816,113 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 		e.warnf("Block %s has non-nil Default: %v", strings.Join(path, "/"), def)
 	}
 
+	for _, v := range untranslatedAttributeConstructs(property) {
+		fprintf(e.SchemaWriter, "// TODO %s,\n", v)
+		e.untranslated(path, v)
+	}
+
 	fprintf(e.SchemaWriter, "}")
 
-	return nil
+	return goType, nil
 }
 
-// emitComputedOnlyBlock generates the Plugin Framework code for a Plugin SDK Computed-only nested block
-// and emits the generated code to the emitter's Writer.
-// See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
+// emitNestedObjectModel emits the model struct for a Plugin SDK Computed-only nested block
+// (or one whose ConfigMode is SchemaConfigModeAttr) to the emitter's ModelWriter and returns the model's name.
 // Property names are sorted prior to code generation to reduce diffs.
-func (e *emitter) emitComputedOnlyBlock(path []string, schema map[string]*schema.Schema) error {
+func (e *emitter) emitNestedObjectModel(path []string, schema map[string]*schema.Schema) (string, error) {
 	names := make([]string, 0)
 	for name := range schema {
 		names = append(names, name)
 	}
 	slices.Sort(names)
 
-	fprintf(e.SchemaWriter, "types.ObjectType{\n")
+	modelName := e.newModelName(path)
+	sbStruct := strings.Builder{}
 
-	emittedFieldName := false
 	for _, name := range names {
-		property := schema[name]
-
-		if !emittedFieldName {
-			fprintf(e.SchemaWriter, "AttrTypes: map[string]attr.Type{\n")
-			emittedFieldName = true
-			e.ImportFrameworkAttr = true
-		}
-
-		fprintf(e.SchemaWriter, "%q:", name)
-
-		err := e.emitComputedOnlyBlockProperty(append(path, name), property)
+		goType, err := e.nestedObjectFieldType(append(path, name), schema[name])
 
 		if err != nil {
-			return err
+			return "", err
 		}
-	}
-	if emittedFieldName {
-		fprintf(e.SchemaWriter, "},\n")
+
+		fprintf(&sbStruct, "%s %s `tfsdk:%q`\n", naming.ToCamelCase(name), goType, name)
 	}
 
-	fprintf(e.SchemaWriter, "}")
+	fprintf(e.ModelWriter, "type %s struct {\n%s}\n\n", modelName, sbStruct.String())
 
-	return nil
+	return modelName, nil
 }
 
-// emitComputedOnlyBlockProperty generates the Plugin Framework code for a Plugin SDK Computed-only nested block's property
-// and emits the generated code to the emitter's Writer.
-// See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
-func (e *emitter) emitComputedOnlyBlockProperty(path []string, property *schema.Schema) error {
-	// At this point we are emitting code for the values of a types.ObjectType's AttrMap (map[string]attr.Type).
+// nestedObjectFieldType returns the Go type of a nested object model field.
+// The attribute types of a nested object are derived from its model's field types.
+func (e *emitter) nestedObjectFieldType(path []string, property *schema.Schema) (string, error) {
 	switch v := property.Type; v {
-	//
-	// Primitive types.
-	//
 	case schema.TypeBool:
-		fprintf(e.SchemaWriter, "types.BoolType,\n")
+		return "types.Bool", nil
 
 	case schema.TypeFloat:
-		fprintf(e.SchemaWriter, "types.Float64Type,\n")
+		return "types.Float64", nil
 
 	case schema.TypeInt:
-		fprintf(e.SchemaWriter, "types.Int64Type,\n")
+		return "types.Int64", nil
 
 	case schema.TypeString:
-		fprintf(e.SchemaWriter, "types.StringType,\n")
+		return "types.String", nil
 
-	//
-	// Complex types.
-	//
 	case schema.TypeList, schema.TypeMap, schema.TypeSet:
-		var aggregateType, typeName string
+		typeName := strings.TrimPrefix(v.String(), "Type")
 
-		switch v {
-		case schema.TypeList:
-			aggregateType = "types.ListType"
-			typeName = "list"
-		case schema.TypeMap:
-			aggregateType = "types.MapType"
-			typeName = "map"
-		case schema.TypeSet:
-			aggregateType = "types.SetType"
-			typeName = "set"
-		}
-
-		switch v := property.Elem.(type) {
+		switch elem := property.Elem.(type) {
 		case *schema.Schema:
-			var elementType string
-
-			switch v := v.Type; v {
-			case schema.TypeBool:
-				elementType = "types.BoolType"
-
-			case schema.TypeFloat:
-				elementType = "types.Float64Type"
-
-			case schema.TypeInt:
-				elementType = "types.Int64Type"
-
-			case schema.TypeString:
-				elementType = "types.StringType"
-
-			default:
-				return unsupportedTypeError(path, fmt.Sprintf("(ComputedOnlyBlockProperty) %s of %s", typeName, v.String()))
+			if elem.Type != schema.TypeString {
+				return "", unsupportedTypeError(path, fmt.Sprintf("(NestedObjectProperty) %s of %s", strings.ToLower(typeName), elem.Type.String()))
 			}
 
-			fprintf(e.SchemaWriter, "%s{ElemType:%s},\n", aggregateType, elementType)
+			return fmt.Sprintf("fwtypes.%sValueOf[types.String]", typeName), nil
 
 		case *schema.Resource:
-			// We get here for Computed-only nested blocks or when ConfigMode is SchemaConfigModeBlock.
-			fprintf(e.SchemaWriter, "%s{ElemType:", aggregateType)
+			if v == schema.TypeMap {
+				return "", unsupportedTypeError(path, fmt.Sprintf("(NestedObjectProperty) map of %T", elem))
+			}
 
-			if err := e.emitComputedOnlyBlock(path, v.Schema); err != nil {
-				return err
+			modelName, err := e.emitNestedObjectModel(path, elem.Schema)
+
+			if err != nil {
+				return "", err
 			}
 
-			fprintf(e.SchemaWriter, "},\n")
+			return fmt.Sprintf("fwtypes.%sNestedObjectValueOf[%s]", typeName, modelName), nil
 
 		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(ComputedOnlyBlockProperty) %s of %T", typeName, v))
+			return "", unsupportedTypeError(path, fmt.Sprintf("(NestedObjectProperty) %s of %T", strings.ToLower(typeName), elem))
 		}
 
 	default:
-		return unsupportedTypeError(path, v.String())
+		return "", unsupportedTypeError(path, v.String())
 	}
+}
 
-	return nil
+// newModelName returns a unique name for the model of the nested object at the specified path.
+func (e *emitter) newModelName(path []string) string {
+	name := naming.ToCamelCase(path[len(path)-1])
+
+	if _, ok := e.modelNames[lowerFirst(name)+"Model"]; ok {
+		name = naming.ToCamelCase(strings.Join(path, "_"))
+	}
+
+	name = lowerFirst(name) + "Model"
+	e.modelNames[name] = struct{}{}
+
+	return name
+}
+
+// untranslated records a construct that could not be translated.
+func (e *emitter) untranslated(path []string, construct string) {
+	e.Untranslated = append(e.Untranslated, untranslated{
+		Path:      strings.Join(path, "/"),
+		Construct: construct,
+	})
 }
 
 // warnf emits a formatted warning message to the UI.
@@ -859,6 +930,26 @@ func (e *emitter) warnf(format string, a ...interface{}) {
 	e.Generator.Warnf(format, a...)
 }
 
+// elementType returns the Plugin Framework element type of a Plugin SDK collection of primitives.
+func elementType(path []string, typeName string, elem *schema.Schema) (string, error) {
+	switch v := elem.Type; v {
+	case schema.TypeBool:
+		return "types.BoolType", nil
+
+	case schema.TypeFloat:
+		return "types.Float64Type", nil
+
+	case schema.TypeInt:
+		return "types.Int64Type", nil
+
+	case schema.TypeString:
+		return "types.StringType", nil
+
+	default:
+		return "", unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %s", typeName, v.String()))
+	}
+}
+
 // fprintf writes a formatted string to a Writer.
 func fprintf(w io.Writer, format string, a ...interface{}) (int, error) {
 	return io.WriteString(w, fmt.Sprintf(format, a...))
@@ -897,31 +988,87 @@ func isAttribute(property *schema.Schema) bool {
 	return false
 }
 
+// isTagsMap returns whether or not the specified top-level property is the resource's 'tags' or 'tags_all' map.
+func isTagsMap(name string, property *schema.Schema) bool {
+	if name != "tags" && name != "tags_all" {
+		return false
+	}
+
+	if property.Type != schema.TypeMap {
+		return false
+	}
+
+	elem, ok := property.Elem.(*schema.Schema)
+
+	return ok && elem.Type == schema.TypeString
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
 func unsupportedTypeError(path []string, typ string) error {
 	return fmt.Errorf("%s is of unsupported type: %s", strings.Join(path, "/"), typ)
 }
 
 type templateData struct {
-	DefaultCreateTimeout          int64
-	DefaultReadTimeout            int64
-	DefaultUpdateTimeout          int64
-	DefaultDeleteTimeout          int64
+	DefaultCreateTimeout          time.Duration
+	DefaultReadTimeout            time.Duration
+	DefaultUpdateTimeout          time.Duration
+	DefaultDeleteTimeout          time.Duration
 	EmitResourceImportState       bool
 	EmitResourceModifyPlan        bool
 	EmitResourceUpdateSkeleton    bool
 	FrameworkPlanModifierPackages []string
 	FrameworkValidatorsPackages   []string
 	GoImports                     []goImport
+	HasName                       bool
+	HasTags                       bool
 	HasTimeouts                   bool
-	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
+	Models                        string // Nested model struct declarations.
 	Name                          string // e.g. Instance
 	PackageName                   string // e.g. ec2
+	ResourceTODOs                 []string
 	Schema                        string
+	SchemaVersion                 int64  // Last Plugin SDK V2 schema version.
+	SDKPackage                    string // e.g. sqs
+	Source                        *sourceInfo
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
 }
 
+var templateFuncMap = template.FuncMap{
+	"Duration": durationExpr,
+	"Replace":  strings.ReplaceAll,
+}
+
+// durationExpr returns a human-friendly Go expression for the specified duration.
+func durationExpr(d time.Duration) string {
+	for _, v := range []struct {
+		unit time.Duration
+		name string
+	}{
+		{time.Hour, "time.Hour"},
+		{time.Minute, "time.Minute"},
+		{time.Second, "time.Second"},
+	} {
+		if d%v.unit == 0 {
+			if d == v.unit {
+				return v.name
+			}
+
+			return fmt.Sprintf("%d * %s", d/v.unit, v.name)
+		}
+	}
+
+	return fmt.Sprintf("%d * time.Nanosecond", d)
+}
+
 //go:embed datasource.gtpl
 var datasourceImpl string
 
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// untranslated is a Plugin SDK V2 construct that could not be translated to the Plugin Framework.
+type untranslated struct {
+	Path      string // Attribute path, empty for resource-level constructs.
+	Construct string // e.g. DiffSuppressFunc
+}
+
+// untranslatedAttributeConstructs returns the constructs of a Plugin SDK V2 Attribute or Block that cannot be translated.
+func untranslatedAttributeConstructs(property *schema.Schema) []string {
+	var constructs []string
+
+	if property.AtLeastOneOf != nil {
+		constructs = append(constructs, "AtLeastOneOf")
+	}
+	if property.ConflictsWith != nil {
+		constructs = append(constructs, "ConflictsWith")
+	}
+	if property.DefaultFunc != nil {
+		constructs = append(constructs, "DefaultFunc")
+	}
+	if property.DiffSuppressFunc != nil {
+		constructs = append(constructs, "DiffSuppressFunc")
+	}
+	if property.DiffSuppressOnRefresh {
+		constructs = append(constructs, "DiffSuppressOnRefresh")
+	}
+	if property.ExactlyOneOf != nil {
+		constructs = append(constructs, "ExactlyOneOf")
+	}
+	if property.RequiredWith != nil {
+		constructs = append(constructs, "RequiredWith")
+	}
+	if property.Set != nil {
+		constructs = append(constructs, "Set")
+	}
+	if property.StateFunc != nil {
+		constructs = append(constructs, "StateFunc")
+	}
+	if property.ValidateFunc != nil || property.ValidateDiagFunc != nil {
+		constructs = append(constructs, "Validate")
+	}
+
+	return constructs
+}
+
+// untranslatedResourceConstructs returns the constructs of a Plugin SDK V2 Resource that cannot be translated.
+func untranslatedResourceConstructs(resource *schema.Resource) []string {
+	var constructs []string
+
+	if resource.CustomizeDiff != nil {
+		constructs = append(constructs, "CustomizeDiff")
+	}
+	if v := resource.Importer; v != nil && (v.State != nil || (v.StateContext != nil && !sameFunc(v.StateContext, schema.ImportStatePassthroughContext))) {
+		constructs = append(constructs, "Importer")
+	}
+	if resource.MigrateState != nil {
+		constructs = append(constructs, "MigrateState")
+	}
+	if len(resource.StateUpgraders) > 0 {
+		constructs = append(constructs, "StateUpgraders")
+	}
+
+	return constructs
+}
+
+func sameFunc(f1, f2 any) bool {
+	return reflect.ValueOf(f1).Pointer() == reflect.ValueOf(f2).Pointer()
+}
+
+// writeReport writes a report of the constructs that could not be translated.
+func writeReport(w io.Writer, tfTypeName string, items []untranslated) error {
+	if len(items) == 0 {
+		_, err := fmt.Fprintf(w, "All constructs of %s were translated.\n", tfTypeName)
+		return err
+	}
+
+	items = slices.Clone(items)
+	slices.SortStableFunc(items, func(a, b untranslated) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "The following constructs of %s could not be translated and are marked with TODO comments:\n\n", tfTypeName)
+	for _, v := range items {
+		path := v.Path
+		if path == "" {
+			path = "(resource)"
+		}
+		fmt.Fprintf(&sb, "* %s: %s\n", path, v.Construct)
+	}
+
+	_, err := io.WriteString(w, sb.String())
+
+	return err
+}
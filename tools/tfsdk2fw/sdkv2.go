@@ -0,0 +1,416 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// sourceInfo is information about a Plugin SDK V2 resource gleaned from its Go source code.
+type sourceInfo struct {
+	Annotations  []string // Annotations other than @SDKResource, e.g. `@Tags(identifierAttribute="arn")`.
+	ClientMethod string   // e.g. SQSClient
+	SDKImport    *goImport
+	Create       *sourceFunction
+	Read         *sourceFunction
+	Update       *sourceFunction
+	Delete       *sourceFunction
+}
+
+// sourceFunction is information about a Plugin SDK V2 CRUD handler.
+type sourceFunction struct {
+	Name           string
+	APICalls       []*sourceCall
+	Finders        []*sourceCall
+	Waiters        []*sourceCall
+	SetID          string // Argument to d.SetId(), if any.
+	NotFound       string // Condition under which an error is ignored, if any.
+	CallsRead      bool
+	UsesGetTagsIn  bool
+	UsesSetTagsOut bool
+}
+
+// sourceCall is a call to an AWS API operation or to a finder or waiter function.
+type sourceCall struct {
+	Name         string   // Function or API operation name.
+	Args         []string // Arguments, translated where possible.
+	ErrorMessage string   // Error message format from the following call to sdkdiag.AppendErrorf, without the trailing ": %s".
+	ErrorArgs    []string // Error message arguments, translated where possible, without the trailing error.
+	Results      int      // Number of results of finder or waiter functions.
+}
+
+// ArgList returns the call's arguments as a comma-separated list.
+func (c *sourceCall) ArgList() string {
+	return strings.Join(c.Args, ", ")
+}
+
+// ErrorSummary returns a Go expression for the summary of the diagnostic added when the call fails.
+func (c *sourceCall) ErrorSummary() string {
+	if c.ErrorMessage == "" {
+		return strconv.Quote(fmt.Sprintf("calling %s", c.Name))
+	}
+
+	if len(c.ErrorArgs) == 0 {
+		return strconv.Quote(c.ErrorMessage)
+	}
+
+	return fmt.Sprintf("fmt.Sprintf(%q, %s)", c.ErrorMessage, strings.Join(c.ErrorArgs, ", "))
+}
+
+// IsTagging returns whether the call is to a tagging API operation.
+// Tagging is handled by transparent tagging.
+func (c *sourceCall) IsTagging() bool {
+	return strings.Contains(c.Name, "Tag")
+}
+
+// APICall returns the first call to a non-tagging AWS API operation, if any.
+// Only this call is translated.
+func (f *sourceFunction) APICall() *sourceCall {
+	for _, v := range f.APICalls {
+		if !v.IsTagging() {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// UsesOutput returns whether the resource ID is set from the API call's output.
+func (f *sourceFunction) UsesOutput() bool {
+	return strings.Contains(f.SetID, "output")
+}
+
+// argReplacer translates common Plugin SDK V2 expressions to their Plugin Framework equivalents.
+var argReplacer = strings.NewReplacer(
+	"d.Id()", "data.ID.ValueString()",
+	"d.Timeout(schema.TimeoutCreate)", "createTimeout",
+	"d.Timeout(schema.TimeoutRead)", "readTimeout",
+	"d.Timeout(schema.TimeoutUpdate)", "updateTimeout",
+	"d.Timeout(schema.TimeoutDelete)", "deleteTimeout",
+	"!d.IsNewResource() && ", "",
+)
+
+// analyzeSource gleans information about the specified Plugin SDK V2 resource or data source
+// from the Go source code in the specified directory.
+func analyzeSource(dir, tfTypeName string) (*sourceInfo, error) {
+	fset := token.NewFileSet()
+	entries, err := os.ReadDir(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*ast.File
+	for _, entry := range entries {
+		if name := entry.Name(); entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dir, entry.Name()), nil, parser.ParseComments)
+
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, file)
+	}
+
+	a := &analyzer{
+		fset:  fset,
+		funcs: make(map[string]*ast.FuncDecl),
+		files: make(map[string]*ast.File),
+	}
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			if v, ok := decl.(*ast.FuncDecl); ok && v.Recv == nil {
+				a.funcs[v.Name.Name] = v
+				a.files[v.Name.Name] = file
+			}
+		}
+	}
+
+	return a.analyze(tfTypeName)
+}
+
+type analyzer struct {
+	fset  *token.FileSet
+	files map[string]*ast.File     // File containing each function, by name.
+	funcs map[string]*ast.FuncDecl // Package-level functions, by name.
+}
+
+func (a *analyzer) analyze(tfTypeName string) (*sourceInfo, error) {
+	var factory *ast.FuncDecl
+	info := &sourceInfo{}
+
+	for _, decl := range a.funcs {
+		if decl.Doc == nil {
+			continue
+		}
+
+		var found bool
+		var annotations []string
+		for _, line := range decl.Doc.List {
+			text := strings.TrimSpace(strings.TrimPrefix(line.Text, "//"))
+			if !strings.HasPrefix(text, "@") {
+				continue
+			}
+
+			if strings.HasPrefix(text, fmt.Sprintf(`@SDKResource(%q`, tfTypeName)) || strings.HasPrefix(text, fmt.Sprintf(`@SDKDataSource(%q`, tfTypeName)) {
+				found = true
+				continue
+			}
+
+			annotations = append(annotations, text)
+		}
+
+		if found {
+			factory = decl
+			info.Annotations = annotations
+			break
+		}
+	}
+
+	if factory == nil {
+		return nil, fmt.Errorf("no @SDKResource or @SDKDataSource annotation for %s", tfTypeName)
+	}
+
+	handlers := make(map[string]string)
+	ast.Inspect(factory.Body, func(n ast.Node) bool {
+		v, ok := n.(*ast.CompositeLit)
+		if !ok || !isSelector(v.Type, "schema", "Resource") || len(handlers) > 0 {
+			return true
+		}
+
+		for _, elt := range v.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+
+			key, ok := kv.Key.(*ast.Ident)
+			if !ok {
+				continue
+			}
+
+			if value, ok := kv.Value.(*ast.Ident); ok {
+				for _, operation := range []string{"Create", "Read", "Update", "Delete"} {
+					switch key.Name {
+					case operation, operation + "Context", operation + "WithoutTimeout":
+						handlers[operation] = value.Name
+					}
+				}
+			}
+		}
+
+		return false
+	})
+
+	if v, ok := handlers["Read"]; ok {
+		info.Read = a.analyzeFunction(info, v, "")
+	}
+	if v, ok := handlers["Create"]; ok {
+		info.Create = a.analyzeFunction(info, v, handlers["Read"])
+	}
+	if v, ok := handlers["Update"]; ok {
+		info.Update = a.analyzeFunction(info, v, handlers["Read"])
+	}
+	if v, ok := handlers["Delete"]; ok {
+		info.Delete = a.analyzeFunction(info, v, "")
+	}
+
+	return info, nil
+}
+
+// analyzeFunction gleans information about the specified CRUD handler.
+func (a *analyzer) analyzeFunction(info *sourceInfo, name, readFuncName string) *sourceFunction {
+	decl, ok := a.funcs[name]
+	if !ok || decl.Body == nil {
+		return nil
+	}
+
+	if info.SDKImport == nil {
+		info.SDKImport = sdkImport(a.files[name])
+	}
+
+	f := &sourceFunction{Name: name}
+	var lastCall *sourceCall
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch v := n.(type) {
+		case *ast.IfStmt:
+			if n := len(v.Body.List); f.NotFound == "" && isNotFoundCondition(v.Cond) && n > 0 {
+				if _, ok := v.Body.List[n-1].(*ast.ReturnStmt); ok {
+					f.NotFound = a.render(v.Cond)
+				}
+			}
+
+		case *ast.CallExpr:
+			switch fun := v.Fun.(type) {
+			case *ast.SelectorExpr:
+				x, isIdent := fun.X.(*ast.Ident)
+
+				switch {
+				case strings.HasSuffix(fun.Sel.Name, "Client") && !isIdent:
+					if _, ok := fun.X.(*ast.TypeAssertExpr); ok && info.ClientMethod == "" {
+						info.ClientMethod = fun.Sel.Name
+					}
+
+				case isIdent && x.Name == "conn":
+					// The same API operation may be called more than once, e.g. on retry.
+					lastCall = nil
+					for _, call := range f.APICalls {
+						if call.Name == fun.Sel.Name {
+							lastCall = call
+						}
+					}
+
+					if lastCall == nil {
+						lastCall = &sourceCall{Name: fun.Sel.Name, Args: a.renderArgs(v.Args)}
+						f.APICalls = append(f.APICalls, lastCall)
+					}
+
+				case isIdent && x.Name == "sdkdiag" && fun.Sel.Name == "AppendErrorf" && len(v.Args) > 1:
+					if lastCall != nil && lastCall.ErrorMessage == "" {
+						if lit, ok := v.Args[1].(*ast.BasicLit); ok {
+							if s, err := strconv.Unquote(lit.Value); err == nil {
+								lastCall.ErrorMessage = strings.TrimSuffix(s, ": %s")
+								lastCall.ErrorArgs = a.renderArgs(v.Args[2 : len(v.Args)-1])
+							}
+						}
+					}
+
+				case isIdent && x.Name == "d" && fun.Sel.Name == "SetId" && len(v.Args) == 1 && f.SetID == "":
+					f.SetID = a.render(v.Args[0])
+				}
+
+			case *ast.Ident:
+				switch {
+				case strings.HasPrefix(fun.Name, "find"):
+					lastCall = &sourceCall{Name: fun.Name, Args: a.renderArgs(v.Args), Results: a.results(fun.Name)}
+					f.Finders = append(f.Finders, lastCall)
+
+				case strings.HasPrefix(fun.Name, "wait"):
+					lastCall = &sourceCall{Name: fun.Name, Args: a.renderArgs(v.Args), Results: a.results(fun.Name)}
+					f.Waiters = append(f.Waiters, lastCall)
+
+				case fun.Name == "getTagsIn":
+					f.UsesGetTagsIn = true
+
+				case fun.Name == "setTagsOut":
+					f.UsesSetTagsOut = true
+
+				case fun.Name == readFuncName:
+					f.CallsRead = true
+				}
+			}
+		}
+
+		return true
+	})
+
+	return f
+}
+
+// results returns the number of results of the specified package-level function.
+func (a *analyzer) results(name string) int {
+	decl, ok := a.funcs[name]
+	if !ok || decl.Type.Results == nil {
+		return 0
+	}
+
+	var n int
+	for _, field := range decl.Type.Results.List {
+		n += max(len(field.Names), 1)
+	}
+
+	return n
+}
+
+func (a *analyzer) render(node ast.Node) string {
+	var sb strings.Builder
+
+	if err := printer.Fprint(&sb, a.fset, node); err != nil {
+		return fmt.Sprintf("/* %s */", err)
+	}
+
+	return argReplacer.Replace(sb.String())
+}
+
+func (a *analyzer) renderArgs(args []ast.Expr) []string {
+	var rendered []string
+
+	for _, arg := range args {
+		rendered = append(rendered, a.render(arg))
+	}
+
+	return rendered
+}
+
+// sdkImport returns the AWS SDK for Go v2 service package imported by the specified file.
+func sdkImport(file *ast.File) *goImport {
+	const prefix = "github.com/aws/aws-sdk-go-v2/service/"
+
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || !strings.HasPrefix(path, prefix) || strings.Contains(strings.TrimPrefix(path, prefix), "/") {
+			continue
+		}
+
+		v := &goImport{Path: path}
+		if spec.Name != nil {
+			v.Alias = spec.Name.Name
+		}
+
+		return v
+	}
+
+	return nil
+}
+
+// isNotFoundCondition returns whether the specified expression tests for an AWS API error.
+func isNotFoundCondition(expr ast.Expr) bool {
+	var found bool
+
+	ast.Inspect(expr, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		fun := call.Fun
+		// errs.IsA[*awstypes.ResourceNotFoundException](err).
+		if v, ok := fun.(*ast.IndexExpr); ok {
+			fun = v.X
+		}
+
+		if isSelector(fun, "tfawserr", "") || isSelector(fun, "errs", "") || isSelector(fun, "tfresource", "NotFound") {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// isSelector returns whether the specified expression is a selector expression `x.sel`.
+// An empty sel matches any selector.
+func isSelector(expr ast.Expr, x, sel string) bool {
+	v, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+
+	ident, ok := v.X.(*ast.Ident)
+
+	return ok && ident.Name == x && (sel == "" || v.Sel.Name == sel)
+}